@@ -3,8 +3,12 @@ package main
 import (
 	"encoding/hex"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 	"time"
 
+	terminal "github.com/lightninglabs/lightning-terminal"
 	"github.com/lightninglabs/lightning-terminal/litrpc"
 	"github.com/urfave/cli"
 )
@@ -24,7 +28,27 @@ var sessionCommands = []cli.Command{
 		Subcommands: []cli.Command{
 			addSessionCommand,
 			listSessionCommand,
+			getSessionCommand,
 			revokeSessionCommand,
+			revokeSessionGroupCommand,
+			quarantineSessionCommand,
+			unquarantineSessionCommand,
+			renewSessionsCommand,
+			restoreSessionCommand,
+			sessionPermissionsCommand,
+			exportSessionEventsCommand,
+			approveSessionCommand,
+			denySessionCommand,
+			sessionsCalendarCommand,
+			addSessionCaveatCommand,
+			changeSessionTypeCommand,
+			prepareRevokeSessionCommand,
+			resetSessionCommand,
+			expiryHistogramCommand,
+			restartSessionCommand,
+			testSessionAccessCommand,
+			updateSessionsMetadataCommand,
+			previewSessionMacaroonCommand,
 		},
 	},
 }
@@ -40,30 +64,157 @@ var addSessionCommand = cli.Command{
 			Name:  "label",
 			Usage: "session label",
 		},
+		cli.StringFlag{
+			Name:  "description",
+			Usage: "optional longer description of the session",
+		},
 		cli.Uint64Flag{
 			Name: "expiry",
 			Usage: "number of seconds that the session should " +
 				"remain active",
 			Value: uint64(defaultSessionExpiry.Seconds()),
 		},
+		cli.Uint64Flag{
+			Name: "harddeadline",
+			Usage: "optional number of seconds after which the " +
+				"session expires at the latest, renewals " +
+				"can't extend the expiry past it",
+		},
+		cli.Uint64Flag{
+			Name: "activationdelay",
+			Usage: "number of seconds after which the session " +
+				"can first be connected to, must be less " +
+				"than the expiry",
+		},
 		cli.StringFlag{
 			Name:  "mailboxserveraddr",
 			Usage: "the host:port of the mailbox server to be used",
 			Value: "mailbox.terminal.lightning.today:443",
 		},
+		cli.StringSliceFlag{
+			Name: "fallbackmailboxserveraddr",
+			Usage: "the host:port of a mailbox server to try if " +
+				"the main one can't be reached, can be " +
+				"specified multiple times",
+		},
 		cli.BoolFlag{
 			Name: "devserver",
+			Usage: "set to true if the mailbox server is a dev " +
+				"server.",
+		},
+		cli.BoolFlag{
+			Name: "insecureskipverify",
 			Usage: "set to true to skip verification of the " +
-				"server's tls cert.",
+				"server's tls cert, only allowed in " +
+				"combination with --devserver.",
+		},
+		cli.BoolFlag{
+			Name: "suppresspairingsecret",
+			Usage: "set to true to not return the pairing secret " +
+				"of the new session, it then needs to be " +
+				"revealed explicitly.",
+		},
+		cli.BoolFlag{
+			Name: "singleuse",
+			Usage: "set to true to revoke the session as soon as " +
+				"its first connection ends.",
 		},
 		cli.StringFlag{
 			Name: "type",
 			Usage: "session type to be created which will " +
 				"determine the permissions a user has when " +
 				"connecting with the session. Options " +
-				"include readonly|admin",
+				"include readonly|admin|custom|password",
 			Value: "readonly",
 		},
+		cli.Uint64Flag{
+			Name: "macaroonrootkeyid",
+			Usage: "an explicit macaroon root key ID to bake the " +
+				"macaroon of a custom session with",
+		},
+		cli.StringFlag{
+			Name: "localkeyseed",
+			Usage: "a hex encoded secret seed of at least 32 " +
+				"bytes the local key of a custom session is " +
+				"derived from",
+		},
+		cli.StringFlag{
+			Name: "permissiontemplate",
+			Usage: "the name of a permission template configured " +
+				"on the server the macaroon of a custom " +
+				"session is restricted to",
+		},
+		cli.StringSliceFlag{
+			Name: "permission",
+			Usage: "a permission the macaroon of a custom " +
+				"session is restricted to, in the form " +
+				"entity:action, for example info:read, can " +
+				"be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: "subservermethod",
+			Usage: "a method of a LiT subserver the macaroon " +
+				"of a custom session may call, in the form " +
+				"subserver:method, for example loop:LoopOut, " +
+				"can be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: "accesswindow",
+			Usage: "a weekly window during which the session " +
+				"can be used, in the form day HH:MM-HH:MM, " +
+				"for example \"mon 09:00-17:00\", can be " +
+				"specified multiple times",
+		},
+		cli.StringFlag{
+			Name: "accesstimezone",
+			Usage: "the IANA time zone of the access windows, " +
+				"for example Europe/Zurich",
+			Value: "UTC",
+		},
+		cli.UintFlag{
+			Name: "keepalive",
+			Usage: "the interval in seconds in which keepalive " +
+				"pings are sent to the mailbox server",
+		},
+		cli.UintFlag{
+			Name: "handshaketimeout",
+			Usage: "the maximum number of seconds to wait for " +
+				"the connection to a mailbox server",
+		},
+		cli.StringFlag{
+			Name: "groupid",
+			Usage: "an optional ID of the group the session " +
+				"belongs to, all sessions of a group can be " +
+				"revoked together",
+		},
+		cli.Uint64Flag{
+			Name: "macaroonexpiry",
+			Usage: "the number of seconds the macaroon of a " +
+				"macaroon session stays valid, must not " +
+				"exceed the session expiry",
+		},
+		cli.StringFlag{
+			Name: "remotename",
+			Usage: "an optional name of the remote party that " +
+				"is shown instead of its public key",
+		},
+		cli.UintFlag{
+			Name: "maxstreams",
+			Usage: "the maximum number of requests the remote " +
+				"can have in flight at the same time, 0 " +
+				"means unlimited",
+		},
+		cli.BoolFlag{
+			Name: "requireapproval",
+			Usage: "don't serve the remote when it connects " +
+				"until the session was approved",
+		},
+		cli.UintFlag{
+			Name: "priority",
+			Usage: "the priority of the session on startup, " +
+				"sessions with a higher priority are resumed " +
+				"first",
+		},
 	},
 }
 
@@ -80,21 +231,88 @@ func addSession(ctx *cli.Context) error {
 	}
 
 	sessTypeStr := ctx.String("type")
-	sessType, err := parseSessionType(sessTypeStr)
+	sessType, err := terminal.ParseSessionTypeString(sessTypeStr)
 	if err != nil {
 		return err
 	}
 
+	localKeySeed, err := hex.DecodeString(ctx.String("localkeyseed"))
+	if err != nil {
+		return fmt.Errorf("invalid local key seed: %v", err)
+	}
+
 	sessionLength := time.Second * time.Duration(ctx.Uint64("expiry"))
 	sessionExpiry := time.Now().Add(sessionLength).Unix()
 
+	var activation int64
+	if ctx.IsSet("activationdelay") {
+		delay := time.Second * time.Duration(
+			ctx.Uint64("activationdelay"),
+		)
+		activation = time.Now().Add(delay).Unix()
+	}
+	var hardDeadline int64
+	if ctx.IsSet("harddeadline") {
+		deadline := time.Second * time.Duration(
+			ctx.Uint64("harddeadline"),
+		)
+		hardDeadline = time.Now().Add(deadline).Unix()
+	}
+	fallbackAddrs := ctx.StringSlice("fallbackmailboxserveraddr")
+	permissionTemplate := ctx.String("permissiontemplate")
+	customPerms, err := parseMacaroonPermissions(
+		ctx.StringSlice("permission"),
+	)
+	if err != nil {
+		return err
+	}
+	subserverMethods, err := parseSubserverMethods(
+		ctx.StringSlice("subservermethod"),
+	)
+	if err != nil {
+		return err
+	}
+	accessSchedule, err := parseAccessSchedule(
+		ctx.String("accesstimezone"), ctx.StringSlice("accesswindow"),
+	)
+	if err != nil {
+		return err
+	}
+	keepalive := uint32(ctx.Uint("keepalive"))
+	handshakeTimeout := uint32(ctx.Uint("handshaketimeout"))
+	maxStreams := uint32(ctx.Uint("maxstreams"))
+	priority := uint32(ctx.Uint("priority"))
+	macaroonExpiry := ctx.Uint64("macaroonexpiry")
+
 	resp, err := client.AddSession(
 		getAuthContext(ctx), &litrpc.AddSessionRequest{
 			Label:                  label,
+			Description:            ctx.String("description"),
 			SessionType:            sessType,
 			ExpiryTimestampSeconds: uint64(sessionExpiry),
 			MailboxServerAddr:      ctx.String("mailboxserveraddr"),
+			MailboxServerAddrs:     fallbackAddrs,
 			DevServer:              ctx.Bool("devserver"),
+			InsecureSkipVerify:     ctx.Bool("insecureskipverify"),
+			SuppressPairingSecret:  ctx.Bool("suppresspairingsecret"),
+			SingleUse:              ctx.Bool("singleuse"),
+			MacaroonRootKeyId:      ctx.Uint64("macaroonrootkeyid"),
+
+			ActivationTimestampSeconds: uint64(activation),
+			LocalKeySeed:               localKeySeed,
+			MacaroonCustomPermissions:  customPerms,
+			PermissionTemplate:         permissionTemplate,
+			GroupId:                    ctx.String("groupid"),
+			KeepaliveIntervalSeconds:   keepalive,
+			HandshakeTimeoutSeconds:    handshakeTimeout,
+			MacaroonExpirySeconds:      macaroonExpiry,
+			RemoteDisplayName:          ctx.String("remotename"),
+			MaxConcurrentStreams:       maxStreams,
+			RequireApproval:            ctx.Bool("requireapproval"),
+			Priority:                   priority,
+			LitSubserverMethods:        subserverMethods,
+			AccessSchedule:             accessSchedule,
+			HardDeadline:               uint64(hardDeadline),
 		},
 	)
 	if err != nil {
@@ -106,15 +324,133 @@ func addSession(ctx *cli.Context) error {
 	return nil
 }
 
-func parseSessionType(sessionType string) (litrpc.SessionType, error) {
-	switch sessionType {
-	case "admin":
-		return litrpc.SessionType_TYPE_MACAROON_ADMIN, nil
-	case "readonly":
-		return litrpc.SessionType_TYPE_MACAROON_READONLY, nil
-	default:
-		return 0, fmt.Errorf("unsupported session type %s", sessionType)
+// parseMacaroonPermissions parses the given entity:action strings into macaroon
+// permissions.
+func parseMacaroonPermissions(
+	perms []string) ([]*litrpc.MacaroonPermission, error) {
+
+	result := make([]*litrpc.MacaroonPermission, 0, len(perms))
+	for _, perm := range perms {
+		parts := strings.SplitN(perm, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid permission %q, "+
+				"expected entity:action", perm)
+		}
+
+		result = append(result, &litrpc.MacaroonPermission{
+			Entity: parts[0],
+			Action: parts[1],
+		})
+	}
+
+	return result, nil
+}
+
+// parseSubserverMethods groups the given subserver:method strings by their
+// subserver.
+func parseSubserverMethods(
+	methods []string) (map[string]*litrpc.SubserverMethods, error) {
+
+	if len(methods) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]*litrpc.SubserverMethods)
+	for _, method := range methods {
+		parts := strings.SplitN(method, ":", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid subserver method %q, "+
+				"expected subserver:method", method)
+		}
+
+		subserver := parts[0]
+		if result[subserver] == nil {
+			result[subserver] = &litrpc.SubserverMethods{}
+		}
+		result[subserver].Methods = append(
+			result[subserver].Methods, parts[1],
+		)
+	}
+
+	return result, nil
+}
+
+// accessWindowDays maps the abbreviated names of the days of the week to the
+// weekdays of the access windows.
+var accessWindowDays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// parseAccessSchedule parses the given access windows in the form
+// day HH:MM-HH:MM into an access schedule in the given time zone. Nil is
+// returned if no windows are given.
+func parseAccessSchedule(timezone string,
+	windows []string) (*litrpc.AccessSchedule, error) {
+
+	if len(windows) == 0 {
+		return nil, nil
+	}
+
+	schedule := &litrpc.AccessSchedule{
+		Timezone: timezone,
+	}
+	for _, window := range windows {
+		fields := strings.Fields(window)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid access window %q, "+
+				"expected day HH:MM-HH:MM", window)
+		}
+
+		day, ok := accessWindowDays[strings.ToLower(fields[0])]
+		if !ok {
+			return nil, fmt.Errorf("invalid day %q of access "+
+				"window", fields[0])
+		}
+
+		times := strings.SplitN(fields[1], "-", 2)
+		if len(times) != 2 {
+			return nil, fmt.Errorf("invalid access window %q, "+
+				"expected day HH:MM-HH:MM", window)
+		}
+
+		start, err := parseClockTime(times[0])
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseClockTime(times[1])
+		if err != nil {
+			return nil, err
+		}
+
+		schedule.Windows = append(
+			schedule.Windows, &litrpc.AccessWindow{
+				Weekday:      uint32(day),
+				StartSeconds: start,
+				EndSeconds:   end,
+			},
+		)
+	}
+
+	return schedule, nil
+}
+
+// parseClockTime parses a time of the day in the form HH:MM into the number of
+// seconds since midnight. 24:00 is midnight at the end of the day.
+func parseClockTime(clock string) (uint32, error) {
+	var hour, minute uint32
+	_, err := fmt.Sscanf(clock, "%d:%d", &hour, &minute)
+	if err != nil || minute >= 60 || hour*60+minute > 24*60 {
+		return 0, fmt.Errorf("invalid time of day %q, expected HH:MM",
+			clock)
 	}
+
+	return (hour*60 + minute) * 60, nil
 }
 
 var listSessionCommand = cli.Command{
@@ -128,6 +464,10 @@ var listSessionCommand = cli.Command{
 		listInUseSessions,
 		listExpiredSessions,
 		listCreatedSessions,
+		listQuarantinedSessions,
+		listPendingApprovalSessions,
+		listSuspendedSessions,
+		listFailedSessions,
 	},
 }
 
@@ -136,6 +476,7 @@ var listAllSessionsCommand = cli.Command{
 	ShortName:   "a",
 	Usage:       "list all Terminal Web sessions",
 	Description: "List all sessions.",
+	Flags:       listSessionFlags,
 	Action:      listSessions(sessionFilterAll),
 }
 
@@ -144,6 +485,7 @@ var listRevokedSessions = cli.Command{
 	ShortName:   "r",
 	Usage:       "list revoked Terminal Web sessions",
 	Description: "List revoked sessions.",
+	Flags:       listSessionFlags,
 	Action:      listSessions(sessionFilterRevoked),
 }
 
@@ -152,6 +494,7 @@ var listInUseSessions = cli.Command{
 	ShortName:   "u",
 	Usage:       "list in-use Terminal Web sessions",
 	Description: "List in-use sessions.",
+	Flags:       listSessionFlags,
 	Action:      listSessions(sessionFilterInUse),
 }
 
@@ -160,6 +503,7 @@ var listExpiredSessions = cli.Command{
 	ShortName:   "e",
 	Usage:       "list expired Terminal Web sessions",
 	Description: "List expired sessions.",
+	Flags:       listSessionFlags,
 	Action:      listSessions(sessionFilterExpired),
 }
 
@@ -168,9 +512,106 @@ var listCreatedSessions = cli.Command{
 	ShortName:   "c",
 	Usage:       "list created Terminal Web sessions",
 	Description: "List created sessions.",
+	Flags:       listSessionFlags,
 	Action:      listSessions(sessionFilterCreated),
 }
 
+var listQuarantinedSessions = cli.Command{
+	Name:        "quarantined",
+	ShortName:   "q",
+	Usage:       "list quarantined Terminal Web sessions",
+	Description: "List quarantined sessions.",
+	Flags:       listSessionFlags,
+	Action:      listSessions(sessionFilterQuarantined),
+}
+
+var listPendingApprovalSessions = cli.Command{
+	Name:        "pending",
+	ShortName:   "p",
+	Usage:       "list Terminal Web sessions waiting for approval",
+	Description: "List sessions whose remote waits for approval.",
+	Flags:       listSessionFlags,
+	Action:      listSessions(sessionFilterPending),
+}
+
+var listSuspendedSessions = cli.Command{
+	Name:        "suspended",
+	ShortName:   "s",
+	Usage:       "list suspended Terminal Web sessions",
+	Description: "List sessions that are outside of their access windows.",
+	Flags:       listSessionFlags,
+	Action:      listSessions(sessionFilterSuspended),
+}
+
+var listFailedSessions = cli.Command{
+	Name:      "failed",
+	ShortName: "f",
+	Usage:     "list Terminal Web sessions that failed to resume",
+	Description: "List sessions whose last resume attempt failed, " +
+		"together with the reason and the time of the attempt.",
+	Action: listFailed,
+}
+
+func listFailed(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.ListFailedSessions(
+		getAuthContext(ctx), &litrpc.ListFailedSessionsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// listSessionFlags are the flags shared by all list commands.
+var listSessionFlags = []cli.Flag{
+	labelFilterFlag, groupFilterFlag, includeRecipeFlag, runningOnlyFlag,
+	createdWithinFlag,
+}
+
+// labelFilterFlag restricts the listed sessions to the ones with a matching
+// label.
+var labelFilterFlag = cli.StringFlag{
+	Name: "filter",
+	Usage: "only list sessions whose label contains the given text, " +
+		"ignoring case",
+}
+
+// groupFilterFlag restricts the listed sessions to the ones of a group.
+var groupFilterFlag = cli.StringFlag{
+	Name:  "groupid",
+	Usage: "only list sessions of the group with the given ID",
+}
+
+// includeRecipeFlag adds the stored macaroon recipe to each listed macaroon
+// session.
+var includeRecipeFlag = cli.BoolFlag{
+	Name:  "includerecipe",
+	Usage: "include the permissions and caveats of macaroon sessions",
+}
+
+// runningOnlyFlag restricts the listed sessions to the ones that are in use
+// and currently connected.
+var runningOnlyFlag = cli.BoolFlag{
+	Name:  "runningonly",
+	Usage: "only list sessions that are in use and currently connected",
+}
+
+// createdWithinFlag restricts the listed sessions to the ones created within
+// the given time before now.
+var createdWithinFlag = cli.DurationFlag{
+	Name: "createdwithin",
+	Usage: "only list sessions created within the given duration " +
+		"before now, for example 24h",
+}
+
 type sessionFilter uint32
 
 const (
@@ -179,13 +620,21 @@ const (
 	sessionFilterInUse
 	sessionFilterRevoked
 	sessionFilterCreated
+	sessionFilterQuarantined
+	sessionFilterPending
+	sessionFilterSuspended
 )
 
 var sessionStateMap = map[litrpc.SessionState]sessionFilter{
-	litrpc.SessionState_STATE_CREATED: sessionFilterCreated,
-	litrpc.SessionState_STATE_EXPIRED: sessionFilterExpired,
-	litrpc.SessionState_STATE_IN_USE:  sessionFilterInUse,
-	litrpc.SessionState_STATE_REVOKED: sessionFilterRevoked,
+	litrpc.SessionState_STATE_CREATED:          sessionFilterCreated,
+	litrpc.SessionState_STATE_EXPIRED:          sessionFilterExpired,
+	litrpc.SessionState_STATE_IN_USE:           sessionFilterInUse,
+	litrpc.SessionState_STATE_REVOKED:          sessionFilterRevoked,
+	litrpc.SessionState_STATE_STARTING:         sessionFilterCreated,
+	litrpc.SessionState_STATE_SCHEDULED:        sessionFilterCreated,
+	litrpc.SessionState_STATE_QUARANTINED:      sessionFilterQuarantined,
+	litrpc.SessionState_STATE_PENDING_APPROVAL: sessionFilterPending,
+	litrpc.SessionState_STATE_SUSPENDED:        sessionFilterSuspended,
 }
 
 func listSessions(filter sessionFilter) func(ctx *cli.Context) error {
@@ -196,8 +645,19 @@ func listSessions(filter sessionFilter) func(ctx *cli.Context) error {
 		}
 		defer cleanup()
 
+		var createdAfter uint64
+		if within := ctx.Duration("createdwithin"); within > 0 {
+			createdAfter = uint64(time.Now().Add(-within).Unix())
+		}
+
 		resp, err := client.ListSessions(
-			getAuthContext(ctx), &litrpc.ListSessionsRequest{},
+			getAuthContext(ctx), &litrpc.ListSessionsRequest{
+				LabelQuery:    ctx.String("filter"),
+				IncludeRecipe: ctx.Bool("includerecipe"),
+				GroupId:       ctx.String("groupid"),
+				RunningOnly:   ctx.Bool("runningonly"),
+				CreatedAfter:  createdAfter,
+			},
 		)
 		if err != nil {
 			return err
@@ -217,11 +677,67 @@ func listSessions(filter sessionFilter) func(ctx *cli.Context) error {
 			sessions = append(sessions, session)
 		}
 
-		printRespJSON(&litrpc.ListSessionsResponse{Sessions: sessions})
+		printRespJSON(&litrpc.ListSessionsResponse{
+			Sessions: sessions,
+			Total:    uint32(len(sessions)),
+		})
 		return nil
 	}
 }
 
+var getSessionCommand = cli.Command{
+	Name:      "get",
+	ShortName: "g",
+	Usage:     "show a single Terminal Web session",
+	Description: "Show a session, optionally with the details of its " +
+		"live connection.",
+	Action: getSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to show",
+		},
+		cli.StringFlag{
+			Name: "sessionid",
+			Usage: "short ID of the session to show, can be used " +
+				"instead of localpubkey",
+		},
+		cli.BoolFlag{
+			Name: "connectioninfo",
+			Usage: "include the details of the live mailbox " +
+				"connection if the session is running",
+		},
+	},
+}
+
+func getSession(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSession(
+		getAuthContext(ctx), &litrpc.GetSessionRequest{
+			LocalPublicKey:        pubkey,
+			IncludeConnectionInfo: ctx.Bool("connectioninfo"),
+			SessionId:             ctx.String("sessionid"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var revokeSessionCommand = cli.Command{
 	Name:        "revoke",
 	ShortName:   "r",
@@ -233,6 +749,26 @@ var revokeSessionCommand = cli.Command{
 			Name:  "localpubkey",
 			Usage: "local pubkey of the session to revoke",
 		},
+		cli.StringFlag{
+			Name: "sessionid",
+			Usage: "short ID of the session to revoke, can be " +
+				"used instead of localpubkey",
+		},
+		cli.StringFlag{
+			Name: "reason",
+			Usage: "an optional reason that is recorded with " +
+				"the revocation",
+		},
+		cli.BoolFlag{
+			Name: "soft",
+			Usage: "keep the session restorable with the restore " +
+				"command during the configured restore window",
+		},
+		cli.StringFlag{
+			Name: "confirmationtoken",
+			Usage: "the hex encoded token returned by the " +
+				"preparerevoke command for the session",
+		},
 	},
 }
 
@@ -248,8 +784,98 @@ func revokeSession(ctx *cli.Context) error {
 		return err
 	}
 
+	token, err := hex.DecodeString(ctx.String("confirmationtoken"))
+	if err != nil {
+		return err
+	}
+
 	resp, err := client.RevokeSession(
 		getAuthContext(ctx), &litrpc.RevokeSessionRequest{
+			LocalPublicKey:    pubkey,
+			Reason:            ctx.String("reason"),
+			Soft:              ctx.Bool("soft"),
+			ConfirmationToken: token,
+			SessionId:         ctx.String("sessionid"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var revokeSessionGroupCommand = cli.Command{
+	Name:        "revokegroup",
+	Usage:       "revoke all Terminal Web sessions of a group",
+	Description: "Revoke all sessions that share the given group ID.",
+	Action:      revokeSessionGroup,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "groupid",
+			Usage: "the ID of the group to revoke",
+		},
+		cli.StringFlag{
+			Name: "reason",
+			Usage: "an optional reason that is recorded with " +
+				"each revocation",
+		},
+	},
+}
+
+func revokeSessionGroup(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.RevokeSessionGroup(
+		getAuthContext(ctx), &litrpc.RevokeSessionGroupRequest{
+			GroupId: ctx.String("groupid"),
+			Reason:  ctx.String("reason"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var quarantineSessionCommand = cli.Command{
+	Name:  "quarantine",
+	Usage: "quarantine a Terminal Web session",
+	Description: "Stop a session and prevent it from being resumed " +
+		"without revoking it, so it can be inspected and " +
+		"restored later.",
+	Action: quarantineSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to quarantine",
+		},
+	},
+}
+
+func quarantineSession(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.QuarantineSession(
+		getAuthContext(ctx), &litrpc.QuarantineSessionRequest{
 			LocalPublicKey: pubkey,
 		},
 	)
@@ -261,3 +887,871 @@ func revokeSession(ctx *cli.Context) error {
 
 	return nil
 }
+
+var unquarantineSessionCommand = cli.Command{
+	Name:        "unquarantine",
+	Usage:       "restore a quarantined Terminal Web session",
+	Description: "Restore a quarantined session and resume it.",
+	Action:      unquarantineSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to restore",
+		},
+	},
+}
+
+func unquarantineSession(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.UnquarantineSession(
+		getAuthContext(ctx), &litrpc.UnquarantineSessionRequest{
+			LocalPublicKey: pubkey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var renewSessionsCommand = cli.Command{
+	Name:  "renew",
+	Usage: "extend the expiry of multiple Terminal Web sessions",
+	Description: "Extend the expiry of all non-revoked sessions that " +
+		"match the given filter, either to a new absolute expiry or " +
+		"by a fixed duration.",
+	Action: renewSessions,
+	Flags: []cli.Flag{
+		labelFilterFlag, groupFilterFlag, runningOnlyFlag,
+		cli.Uint64Flag{
+			Name: "newexpiry",
+			Usage: "the new expiry of the sessions as a unix " +
+				"timestamp in seconds",
+		},
+		cli.Uint64Flag{
+			Name:  "extend",
+			Usage: "number of seconds to extend each session by",
+		},
+	},
+}
+
+func renewSessions(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.RenewSessions(
+		getAuthContext(ctx), &litrpc.RenewSessionsRequest{
+			Filter: &litrpc.ListSessionsRequest{
+				LabelQuery:  ctx.String("filter"),
+				GroupId:     ctx.String("groupid"),
+				RunningOnly: ctx.Bool("runningonly"),
+			},
+			NewExpiryTimestampSeconds: ctx.Uint64("newexpiry"),
+			ExtensionSeconds:          ctx.Uint64("extend"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var restoreSessionCommand = cli.Command{
+	Name:  "restore",
+	Usage: "restore a softly revoked Terminal Web session",
+	Description: "Restore a session that was revoked with the soft " +
+		"option within its restore window and resume it.",
+	Action: restoreSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to restore",
+		},
+	},
+}
+
+func restoreSession(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.RestoreSession(
+		getAuthContext(ctx), &litrpc.RestoreSessionRequest{
+			LocalPublicKey: pubkey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var approveSessionCommand = cli.Command{
+	Name:  "approve",
+	Usage: "approve the remote of a Terminal Web session",
+	Description: "Approve the remote of a session that waits for " +
+		"approval, so it is served from now on.",
+	Action: approveSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to approve",
+		},
+	},
+}
+
+func approveSession(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ApproveSession(
+		getAuthContext(ctx), &litrpc.ApproveSessionRequest{
+			LocalPublicKey: pubkey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var denySessionCommand = cli.Command{
+	Name:  "deny",
+	Usage: "deny the remote of a Terminal Web session",
+	Description: "Reject the remote of a session that requires " +
+		"approval and revoke the session.",
+	Action: denySession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to deny",
+		},
+		cli.StringFlag{
+			Name: "reason",
+			Usage: "optional reason that is recorded on the " +
+				"session",
+		},
+	},
+}
+
+func denySession(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.DenySession(
+		getAuthContext(ctx), &litrpc.DenySessionRequest{
+			LocalPublicKey: pubkey,
+			Reason:         ctx.String("reason"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var sessionPermissionsCommand = cli.Command{
+	Name:  "permissions",
+	Usage: "show the permissions a Terminal Web session grants",
+	Description: "Show the fully resolved permissions and caveats the " +
+		"macaroon of a session grants.",
+	Action: sessionPermissions,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to inspect",
+		},
+	},
+}
+
+func sessionPermissions(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSessionPermissions(
+		getAuthContext(ctx), &litrpc.GetSessionPermissionsRequest{
+			LocalPublicKey: pubkey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var exportSessionEventsCommand = cli.Command{
+	Name:  "export",
+	Usage: "export the audit events of Terminal Web sessions",
+	Description: "Write the recorded audit events of all or a single " +
+		"session to stdout, either as CSV or as one JSON object " +
+		"per line.",
+	Action: exportSessionEvents,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "localpubkey",
+			Usage: "only export the events of the session " +
+				"with this local pubkey",
+		},
+		cli.Uint64Flag{
+			Name: "start",
+			Usage: "only export events recorded at or after " +
+				"this unix timestamp",
+		},
+		cli.Uint64Flag{
+			Name: "end",
+			Usage: "only export events recorded at or before " +
+				"this unix timestamp",
+		},
+		cli.StringFlag{
+			Name:  "format",
+			Usage: "the export format, either csv or json",
+			Value: "csv",
+		},
+	},
+}
+
+func exportSessionEvents(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	var format litrpc.EventExportFormat
+	switch ctx.String("format") {
+	case "csv":
+		format = litrpc.EventExportFormat_EXPORT_FORMAT_CSV
+
+	case "json":
+		format = litrpc.EventExportFormat_EXPORT_FORMAT_JSON
+
+	default:
+		return fmt.Errorf("unknown export format %q, expected csv or "+
+			"json", ctx.String("format"))
+	}
+
+	stream, err := client.ExportSessionEvents(
+		getAuthContext(ctx), &litrpc.ExportSessionEventsRequest{
+			LocalPublicKey:        pubkey,
+			StartTimestampSeconds: ctx.Uint64("start"),
+			EndTimestampSeconds:   ctx.Uint64("end"),
+			Format:                format,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := os.Stdout.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+}
+
+var sessionsCalendarCommand = cli.Command{
+	Name:  "calendar",
+	Usage: "export the expiry of Terminal Web sessions as a calendar",
+	Description: "Write an iCalendar (ICS) document with an event at " +
+		"the expiry of each non-revoked session to stdout.",
+	Action: sessionsCalendar,
+	Flags: []cli.Flag{
+		labelFilterFlag, groupFilterFlag, runningOnlyFlag,
+	},
+}
+
+func sessionsCalendar(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	resp, err := client.GetSessionsCalendar(
+		getAuthContext(ctx), &litrpc.GetSessionsCalendarRequest{
+			Filter: &litrpc.ListSessionsRequest{
+				LabelQuery:  ctx.String("filter"),
+				GroupId:     ctx.String("groupid"),
+				RunningOnly: ctx.Bool("runningonly"),
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.WriteString(resp.Calendar)
+	return err
+}
+
+var addSessionCaveatCommand = cli.Command{
+	Name:  "addcaveat",
+	Usage: "restrict the macaroon of a Terminal Web session",
+	Description: "Add a first-party caveat to the macaroon of an " +
+		"existing session. A running session hands out the " +
+		"restricted macaroon from its next connection on.",
+	Action: addSessionCaveat,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to restrict",
+		},
+		cli.StringFlag{
+			Name: "caveat",
+			Usage: "the caveat condition to add, for example " +
+				"'time-before 2030-01-01T00:00:00Z'",
+		},
+	},
+}
+
+func addSessionCaveat(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AddSessionCaveat(
+		getAuthContext(ctx), &litrpc.AddSessionCaveatRequest{
+			LocalPublicKey: pubkey,
+			Caveat:         ctx.String("caveat"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var changeSessionTypeCommand = cli.Command{
+	Name:  "changetype",
+	Usage: "change the type of a Terminal Web session",
+	Description: "Change an admin session into a readonly session or " +
+		"the other way around without pairing it again. A running " +
+		"session hands out a macaroon with the permissions of the " +
+		"new type from its next connection on.",
+	Action: changeSessionType,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to change",
+		},
+		cli.StringFlag{
+			Name: "type",
+			Usage: "the new type of the session. Options include " +
+				"readonly|admin",
+		},
+	},
+}
+
+func changeSessionType(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	sessType, err := terminal.ParseSessionTypeString(ctx.String("type"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ChangeSessionType(
+		getAuthContext(ctx), &litrpc.ChangeSessionTypeRequest{
+			LocalPublicKey: pubkey,
+			SessionType:    sessType,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var prepareRevokeSessionCommand = cli.Command{
+	Name:  "preparerevoke",
+	Usage: "prepare the revocation of a Terminal Web session",
+	Description: "Show the label and type of a session together with a " +
+		"short-lived token that confirms its revocation when passed " +
+		"to the revoke command.",
+	Action: prepareRevokeSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to revoke",
+		},
+	},
+}
+
+func prepareRevokeSession(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PrepareRevokeSession(
+		getAuthContext(ctx), &litrpc.PrepareRevokeSessionRequest{
+			LocalPublicKey: pubkey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var resetSessionCommand = cli.Command{
+	Name:  "reset",
+	Usage: "reset a Terminal Web session whose pairing failed",
+	Description: "Tear down the mailbox connection of a session that " +
+		"isn't paired yet and restart it, so the pairing can be " +
+		"retried with the same pairing phrase.",
+	Action: resetSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to reset",
+		},
+	},
+}
+
+func resetSession(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ResetSession(
+		getAuthContext(ctx), &litrpc.ResetSessionRequest{
+			LocalPublicKey: pubkey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var expiryHistogramCommand = cli.Command{
+	Name:  "expiryhistogram",
+	Usage: "count sessions by how soon they expire",
+	Description: "Count the non-revoked sessions that expire within each " +
+		"of a set of time ranges from now, as well as those that " +
+		"already expired.",
+	Action: expiryHistogram,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "boundary",
+			Usage: "a bucket boundary as a duration from " +
+				"now, for example 168h; can be specified " +
+				"multiple times in increasing order, " +
+				"defaults to 1, 7, 30 and 90 days",
+		},
+	},
+}
+
+func expiryHistogram(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	var boundaries []uint64
+	for _, boundary := range ctx.StringSlice("boundary") {
+		duration, err := time.ParseDuration(boundary)
+		if err != nil {
+			return fmt.Errorf("invalid bucket boundary %q: %v",
+				boundary, err)
+		}
+		if duration <= 0 {
+			return fmt.Errorf("bucket boundary %q must be positive",
+				boundary)
+		}
+
+		boundaries = append(boundaries, uint64(duration.Seconds()))
+	}
+
+	resp, err := client.GetExpiryHistogram(
+		getAuthContext(ctx), &litrpc.GetExpiryHistogramRequest{
+			BucketBoundariesSeconds: boundaries,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var restartSessionCommand = cli.Command{
+	Name:  "restart",
+	Usage: "restart the connection of a running Terminal Web session",
+	Description: "Stop the mailbox connection of a running session and " +
+		"start it again, keeping the session and its pairing phrase.",
+	Action: restartSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to restart",
+		},
+	},
+}
+
+func restartSession(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.RestartSession(
+		getAuthContext(ctx), &litrpc.RestartSessionRequest{
+			LocalPublicKey: pubkey,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var testSessionAccessCommand = cli.Command{
+	Name:  "testaccess",
+	Usage: "check whether a session may call an RPC",
+	Description: "Check whether the macaroon of a session permits a call " +
+		"to the given RPC URI, without calling it.",
+	Action: testSessionAccess,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "localpubkey",
+			Usage: "local pubkey of the session to check",
+		},
+		cli.StringFlag{
+			Name: "uri",
+			Usage: "the full URI of the RPC to check, for " +
+				"example /lnrpc.Lightning/SendCoins",
+		},
+	},
+}
+
+func testSessionAccess(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	pubkey, err := hex.DecodeString(ctx.String("localpubkey"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.TestSessionAccess(
+		getAuthContext(ctx), &litrpc.TestSessionAccessRequest{
+			LocalPublicKey: pubkey,
+			Uri:            ctx.String("uri"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var updateSessionsMetadataCommand = cli.Command{
+	Name:  "updatemetadata",
+	Usage: "set or delete metadata of multiple Terminal Web sessions",
+	Description: "Set and delete metadata keys of all sessions that " +
+		"match the given filter in one call.",
+	Action: updateSessionsMetadata,
+	Flags: []cli.Flag{
+		labelFilterFlag, groupFilterFlag, runningOnlyFlag,
+		cli.StringSliceFlag{
+			Name: "match",
+			Usage: "only update sessions with the given metadata " +
+				"in the form key=value, can be specified " +
+				"multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: "set",
+			Usage: "a metadata key to set in the form key=value, " +
+				"can be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: "delete",
+			Usage: "a metadata key to delete, can be specified " +
+				"multiple times",
+		},
+	},
+}
+
+// parseMetadata parses the given key=value strings into a metadata map.
+func parseMetadata(pairs []string) (map[string]string, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	metadata := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid metadata %q, expected "+
+				"key=value", pair)
+		}
+
+		metadata[parts[0]] = parts[1]
+	}
+
+	return metadata, nil
+}
+
+func updateSessionsMetadata(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	match, err := parseMetadata(ctx.StringSlice("match"))
+	if err != nil {
+		return err
+	}
+
+	set, err := parseMetadata(ctx.StringSlice("set"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.UpdateSessionsMetadata(
+		getAuthContext(ctx), &litrpc.UpdateSessionsMetadataRequest{
+			Filter: &litrpc.ListSessionsRequest{
+				LabelQuery:  ctx.String("filter"),
+				GroupId:     ctx.String("groupid"),
+				RunningOnly: ctx.Bool("runningonly"),
+				Metadata:    match,
+			},
+			Set:        set,
+			DeleteKeys: ctx.StringSlice("delete"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var previewSessionMacaroonCommand = cli.Command{
+	Name:  "previewmacaroon",
+	Usage: "preview the macaroon of a new Terminal Web session",
+	Description: "Show the permissions and caveats the macaroon of a " +
+		"session added with the given options would be baked with, " +
+		"without adding the session.",
+	Action: previewSessionMacaroon,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "type",
+			Usage: "session type to preview. Options include " +
+				"readonly|admin|custom",
+			Value: "readonly",
+		},
+		cli.StringFlag{
+			Name: "permissiontemplate",
+			Usage: "the name of a permission template configured " +
+				"on the server the macaroon of a custom " +
+				"session is restricted to",
+		},
+		cli.StringSliceFlag{
+			Name: "permission",
+			Usage: "a permission the macaroon of a custom " +
+				"session is restricted to, in the form " +
+				"entity:action, for example info:read, can " +
+				"be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: "subservermethod",
+			Usage: "a method of a LiT subserver the macaroon " +
+				"of a custom session may call, in the form " +
+				"subserver:method, for example loop:LoopOut, " +
+				"can be specified multiple times",
+		},
+		cli.BoolFlag{
+			Name: "bake",
+			Usage: "also bake a macaroon with the previewed " +
+				"recipe under a throwaway root key",
+		},
+	},
+}
+
+func previewSessionMacaroon(ctx *cli.Context) error {
+	client, cleanup, err := getClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	sessType, err := terminal.ParseSessionTypeString(ctx.String("type"))
+	if err != nil {
+		return err
+	}
+
+	customPerms, err := parseMacaroonPermissions(
+		ctx.StringSlice("permission"),
+	)
+	if err != nil {
+		return err
+	}
+
+	subserverMethods, err := parseSubserverMethods(
+		ctx.StringSlice("subservermethod"),
+	)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.PreviewSessionMacaroon(
+		getAuthContext(ctx), &litrpc.PreviewSessionMacaroonRequest{
+			Session: &litrpc.AddSessionRequest{
+				SessionType:               sessType,
+				MacaroonCustomPermissions: customPerms,
+				PermissionTemplate: ctx.String(
+					"permissiontemplate",
+				),
+				LitSubserverMethods: subserverMethods,
+			},
+			Bake: ctx.Bool("bake"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -2,14 +2,17 @@ package terminal
 
 import (
 	"crypto/tls"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
@@ -18,6 +21,7 @@ import (
 	"github.com/lightninglabs/faraday"
 	"github.com/lightninglabs/faraday/chain"
 	"github.com/lightninglabs/faraday/frdrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopd"
 	"github.com/lightninglabs/pool"
@@ -29,6 +33,7 @@ import (
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/mwitkow/go-conntrack/connhelpers"
 	"golang.org/x/crypto/acme/autocert"
+	"gopkg.in/macaroon-bakery.v2/bakery"
 )
 
 const (
@@ -73,6 +78,76 @@ const (
 	// certificate. The value corresponds to 14 months
 	// (14 months * 30 days * 24 hours).
 	DefaultAutogenValidity = 14 * 30 * 24 * time.Hour
+
+	// defaultSessionWebhookTimeout is the default maximum time a single
+	// session webhook request may take.
+	defaultSessionWebhookTimeout = 10 * time.Second
+
+	// defaultSessionWebhookRetries is the default number of times a failed
+	// session webhook request is retried.
+	defaultSessionWebhookRetries = 3
+
+	// defaultSessionStartTimeout is the default maximum time we wait for
+	// the mailbox connection of a session to be started.
+	defaultSessionStartTimeout = 30 * time.Second
+
+	// defaultMaxLabelLength is the default maximum number of characters of
+	// a session label.
+	defaultMaxLabelLength = 256
+
+	// defaultMaxDescriptionLength is the default maximum number of
+	// characters of a session description.
+	defaultMaxDescriptionLength = 2048
+
+	// defaultSessionStartupConcurrency is the default maximum number of
+	// sessions that are resumed at the same time on startup.
+	defaultSessionStartupConcurrency = 10
+
+	// defaultSessionExpiryDrainPeriod is the default maximum time the
+	// client of an expired session may keep its connection with the drain
+	// expiry policy.
+	defaultSessionExpiryDrainPeriod = time.Minute
+
+	// defaultSessionRestoreWindow is the default time during which a softly
+	// revoked session can be restored.
+	defaultSessionRestoreWindow = 24 * time.Hour
+
+	// defaultSessionSubscriberBuffer is the default number of state changes
+	// that are buffered for a single state change subscriber.
+	defaultSessionSubscriberBuffer = 100
+
+	// expiryPolicyHard stops and revokes a running session as soon as it
+	// expires.
+	expiryPolicyHard = "hard"
+
+	// expiryPolicyDrain stops accepting new clients once a running session
+	// expires and only stops and revokes it once its connected clients are
+	// gone or the drain period is over.
+	expiryPolicyDrain = "drain"
+
+	// expiryPolicyWarnOnly only logs a warning once a running session
+	// expires and keeps it running until its connected clients are gone.
+	expiryPolicyWarnOnly = "warn-only"
+
+	// logRedactionFingerprint replaces secrets in log messages with a
+	// stable fingerprint.
+	logRedactionFingerprint = "fingerprint"
+
+	// logRedactionFull replaces secrets in log messages with a fixed
+	// placeholder.
+	logRedactionFull = "full"
+
+	// autoLabelNone leaves the label of sessions that are added without
+	// one empty.
+	autoLabelNone = "none"
+
+	// autoLabelPubKey labels sessions that are added without a label after
+	// the start of their local public key.
+	autoLabelPubKey = "pubkey"
+
+	// autoLabelCounter labels sessions that are added without a label with
+	// an increasing number.
+	autoLabelCounter = "counter"
 )
 
 var (
@@ -149,6 +224,8 @@ type Config struct {
 
 	Remote *RemoteConfig `group:"Remote mode options (use when lnd-mode=remote)" namespace:"remote"`
 
+	Session *SessionConfig `group:"Session options" namespace:"session"`
+
 	// LndMode is the selected mode to run lnd in. The supported modes are
 	// 'integrated' and 'remote'. We only use a string instead of a bool
 	// here (and for all the other daemons) to make the CLI more user
@@ -221,6 +298,347 @@ type RemoteDaemonConfig struct {
 	TLSCertPath string `long:"tlscertpath" description:"The full path to the remote daemon's TLS cert to use for RPC connection verification."`
 }
 
+// SessionConfig holds the configuration parameters of the Terminal Connect
+// session server.
+type SessionConfig struct {
+	MinDuration time.Duration `long:"minduration" description:"The minimum duration a new session must be valid for. A value of 0 disables the lower bound."`
+	MaxDuration time.Duration `long:"maxduration" description:"The maximum duration a new session may be valid for. A value of 0 disables the upper bound."`
+
+	MaxAbsoluteExpiry string `long:"maxabsoluteexpiry" description:"An optional wall-clock time in the RFC3339 format, like 2030-01-01T00:00:00Z, that no session may expire after, for example because the node is known to be decommissioned by then. It applies regardless of the requested expiry or duration."`
+
+	ExpiryGracePeriod time.Duration `long:"expirygraceperiod" description:"Sessions that are found to be expired on startup but expired less than this duration ago are only marked as expired instead of being revoked. A value of 0 revokes all expired sessions."`
+
+	ExpiryJitter time.Duration `long:"expiryjitter" description:"The maximum random delay that is added to the expiry of a running session before it is revoked. This spreads out the revocation of sessions that expire at the same time. A value of 0 disables the jitter."`
+
+	ExpiryWarning time.Duration `long:"expirywarning" description:"The time before the expiry of a running session at which subscribers and the webhook are notified that the session expires soon, so clients can renew it in time. A value of 0 disables the notification."`
+
+	ExpiryPolicy      string        `long:"expirypolicy" description:"What happens to a running session with connected clients once it expires. 'hard' stops and revokes it right away, 'drain' stops accepting new clients and revokes it once the connected clients are gone or the drain period is over, 'warn-only' logs a warning and revokes it once the connected clients are gone." choice:"hard" choice:"drain" choice:"warn-only"`
+	ExpiryDrainPeriod time.Duration `long:"expirydrainperiod" description:"The maximum time the connected clients of an expired session may keep using it with the drain expiry policy."`
+
+	UnpairedTimeout time.Duration `long:"unpairedtimeout" description:"Sessions that are still waiting to be paired for longer than this duration after they were created are revoked automatically, so unused pairing secrets don't linger until the session expires. Sessions that were paired once are never affected. A value of 0 disables the timeout."`
+
+	RestoreWindow time.Duration `long:"restorewindow" description:"The time during which a session that was revoked with the soft option can still be restored. After this window the revocation is permanent. A value of 0 disables soft revocations."`
+
+	RequireRevokeConfirmation bool `long:"requirerevokeconfirmation" description:"If set, a session can only be revoked with a short-lived confirmation token that PrepareRevokeSession returns together with a summary of the session, which protects against revoking the wrong session by accident."`
+
+	MaxActiveSessions uint32 `long:"maxactive" description:"The maximum number of sessions that are neither revoked nor expired at the same time. New sessions are rejected once the limit is reached. A value of 0 disables the limit."`
+
+	StartTimeout time.Duration `long:"starttimeout" description:"The maximum time we wait for the mailbox connection of a session to be started before giving up. Can be overwritten for each new session. A value of 0 disables the timeout."`
+
+	StartupConcurrency uint32 `long:"startupconcurrency" description:"The maximum number of sessions that are resumed at the same time on startup. A value of 0 resumes all sessions at once."`
+
+	LazyMacaroons bool `long:"lazymacaroons" description:"If set, the macaroon of a macaroon session is only baked once a client first connects to it instead of when the session is started. This saves work on startup for nodes with many idle sessions."`
+
+	AllowDevSessions bool `long:"allowdevsessions" description:"If set, sessions may use a mailbox server that is marked as a development server, which allows insecure behaviors like skipping the TLS verification. This should only be enabled for testing."`
+
+	MaxLabelLength       uint32 `long:"maxlabellength" description:"The maximum number of characters of a session label. A value of 0 disables the limit."`
+	MaxDescriptionLength uint32 `long:"maxdescriptionlength" description:"The maximum number of characters of a session description. A value of 0 disables the limit."`
+
+	LabelPattern string `long:"labelpattern" description:"A regular expression that the whole label of each new session must match. An empty pattern allows any label."`
+
+	AutoLabel string `long:"autolabel" description:"How sessions that are added without a label are labeled. 'none' leaves the label empty, 'pubkey' uses session-<start of the local public key>, 'counter' uses session-<n> with an increasing number. A number is appended to a generated label if it is taken already, so generated labels are unique." choice:"none" choice:"pubkey" choice:"counter"`
+
+	LogRedaction string `long:"logredaction" description:"How pairing secrets and macaroons are shown in log messages. 'fingerprint' replaces them with a short stable hash so the same secret can be recognized across messages, 'full' replaces them with a placeholder. They are never logged as is." choice:"fingerprint" choice:"full"`
+
+	EncryptionKeyFile string `long:"encryptionkeyfile" description:"The path of a file that contains a hex encoded 32 byte key. If set, the pairing secrets, private keys and macaroon root keys of sessions are encrypted with this key before they are stored in the session database. The same key must be configured on every start once it was used. If not set, the secrets are stored in plaintext."`
+
+	AddRateLimit float64 `long:"addratelimit" description:"The number of sessions a single caller may add per second on average. Callers without an identity share a single limit. A value of 0 disables the rate limit."`
+	AddRateBurst uint32  `long:"addrateburst" description:"The number of sessions a single caller may add at once before the rate limit applies."`
+
+	SubscriberBuffer uint32 `long:"subscriberbuffer" description:"The maximum number of session state changes that are buffered for a single state change subscriber. A subscriber that falls further behind is disconnected with a ResourceExhausted error and can resubscribe with a fresh snapshot. A value of 0 disables the limit."`
+
+	WebhookURL     string        `long:"webhookurl" description:"If set, a JSON payload is POSTed to this URL each time a session is created or changes its state."`
+	WebhookTimeout time.Duration `long:"webhooktimeout" description:"The maximum time a single webhook request may take."`
+	WebhookRetries uint32        `long:"webhookretries" description:"The number of times a failed webhook request is retried before the notification is dropped."`
+
+	ReadOnlyAddPerms    []string `long:"readonlyaddperm" description:"A permission in the form entity:action that is granted to readonly sessions in addition to the default read permissions. Can be specified multiple times."`
+	ReadOnlyRemovePerms []string `long:"readonlyremoveperm" description:"A permission in the form entity:action that is removed from the default permissions of readonly sessions. Can be specified multiple times."`
+	AdminAddPerms       []string `long:"adminaddperm" description:"A permission in the form entity:action that is granted to admin sessions in addition to the default permissions. Can be specified multiple times."`
+	AdminRemovePerms    []string `long:"adminremoveperm" description:"A permission in the form entity:action that is removed from the default permissions of admin sessions. Can be specified multiple times."`
+
+	PermissionTemplates []string `long:"permissiontemplate" description:"A named set of permissions in the form name=entity:action,entity:action that custom sessions can be restricted to by referencing the name. Can be specified multiple times."`
+
+	MaxCustomPerms  uint32   `long:"maxcustomperms" description:"The maximum number of permissions the macaroon of a custom session may grant. A value of 0 disables the limit."`
+	CustomDenyPerms []string `long:"customdenyperm" description:"A permission in the form entity:action that the macaroon of a custom session must not grant. Can be specified multiple times."`
+
+	// readOnlyAdd, readOnlyRemove, adminAdd and adminRemove are the parsed
+	// permission overrides. They are set by validate.
+	readOnlyAdd    []bakery.Op
+	readOnlyRemove []bakery.Op
+	adminAdd       []bakery.Op
+	adminRemove    []bakery.Op
+
+	// permissionTemplates maps the name of each configured permission
+	// template to its permissions. It is set by validate.
+	permissionTemplates map[string][]bakery.Op
+
+	// customDeny are the parsed permissions that custom sessions must not
+	// grant. They are set by validate.
+	customDeny []bakery.Op
+
+	// maxAbsoluteExpiry is the parsed MaxAbsoluteExpiry. It is zero if no
+	// absolute expiry is configured and set by validate.
+	maxAbsoluteExpiry time.Time
+
+	// labelPattern is the compiled LabelPattern. It is nil if no pattern
+	// is configured and set by validate.
+	labelPattern *regexp.Regexp
+
+	// logRedaction is the parsed LogRedaction. It is set by validate.
+	logRedaction session.RedactionMode
+
+	// encryptionKey is the key read from EncryptionKeyFile. It is nil if
+	// no key file is configured and set by validate.
+	encryptionKey []byte
+}
+
+// validate checks that the session configuration is sane.
+func (c *SessionConfig) validate() error {
+	if c.MinDuration < 0 || c.MaxDuration < 0 || c.ExpiryGracePeriod < 0 ||
+		c.ExpiryJitter < 0 || c.ExpiryWarning < 0 ||
+		c.StartTimeout < 0 || c.RestoreWindow < 0 ||
+		c.UnpairedTimeout < 0 {
+
+		return fmt.Errorf("session durations must not be negative")
+	}
+
+	switch c.ExpiryPolicy {
+	case "", expiryPolicyHard, expiryPolicyWarnOnly:
+
+	case expiryPolicyDrain:
+		if c.ExpiryDrainPeriod <= 0 {
+			return fmt.Errorf("session expiry drain period must " +
+				"be positive with the drain expiry policy")
+		}
+
+	default:
+		return fmt.Errorf("invalid session expiry policy %q",
+			c.ExpiryPolicy)
+	}
+
+	if c.AddRateLimit < 0 {
+		return fmt.Errorf("session add rate limit must not be negative")
+	}
+
+	if c.AddRateLimit > 0 && c.AddRateBurst == 0 {
+		return fmt.Errorf("session add rate burst must be positive " +
+			"if the rate limit is enabled")
+	}
+
+	if c.WebhookURL != "" {
+		webhookURL, err := url.Parse(c.WebhookURL)
+		if err != nil {
+			return fmt.Errorf("invalid session webhook URL: %v",
+				err)
+		}
+
+		if webhookURL.Scheme != "http" && webhookURL.Scheme != "https" {
+			return fmt.Errorf("session webhook URL must use http " +
+				"or https")
+		}
+
+		if c.WebhookTimeout <= 0 {
+			return fmt.Errorf("session webhook timeout must be " +
+				"positive")
+		}
+	}
+
+	if c.MaxDuration != 0 && c.MinDuration > c.MaxDuration {
+		return fmt.Errorf("minimum session duration %v must not be "+
+			"larger than maximum session duration %v",
+			c.MinDuration, c.MaxDuration)
+	}
+
+	var err error
+	if c.MaxAbsoluteExpiry != "" {
+		c.maxAbsoluteExpiry, err = time.Parse(
+			time.RFC3339, c.MaxAbsoluteExpiry,
+		)
+		if err != nil {
+			return fmt.Errorf("invalid session max absolute "+
+				"expiry: %v", err)
+		}
+	}
+
+	c.readOnlyAdd, err = parsePermissions(c.ReadOnlyAddPerms)
+	if err != nil {
+		return err
+	}
+	c.readOnlyRemove, err = parsePermissions(c.ReadOnlyRemovePerms)
+	if err != nil {
+		return err
+	}
+	c.adminAdd, err = parsePermissions(c.AdminAddPerms)
+	if err != nil {
+		return err
+	}
+	c.adminRemove, err = parsePermissions(c.AdminRemovePerms)
+	if err != nil {
+		return err
+	}
+	c.permissionTemplates, err = parsePermissionTemplates(
+		c.PermissionTemplates,
+	)
+	if err != nil {
+		return err
+	}
+	c.customDeny, err = parsePermissions(c.CustomDenyPerms)
+	if err != nil {
+		return err
+	}
+	c.labelPattern, err = parseLabelPattern(c.LabelPattern)
+	if err != nil {
+		return err
+	}
+
+	switch c.AutoLabel {
+	case "", autoLabelNone, autoLabelPubKey, autoLabelCounter:
+
+	default:
+		return fmt.Errorf("invalid session auto label scheme %q",
+			c.AutoLabel)
+	}
+
+	switch c.LogRedaction {
+	case "", logRedactionFingerprint:
+		c.logRedaction = session.RedactFingerprint
+
+	case logRedactionFull:
+		c.logRedaction = session.RedactFull
+
+	default:
+		return fmt.Errorf("invalid session log redaction %q",
+			c.LogRedaction)
+	}
+
+	if c.EncryptionKeyFile != "" {
+		key, err := readEncryptionKey(c.EncryptionKeyFile)
+		if err != nil {
+			return err
+		}
+		c.encryptionKey = key
+	}
+
+	return nil
+}
+
+// readEncryptionKey reads the hex encoded session encryption key from the file
+// at the given path.
+func readEncryptionKey(keyFile string) ([]byte, error) {
+	content, err := ioutil.ReadFile(lncfg.CleanAndExpandPath(keyFile))
+	if err != nil {
+		return nil, fmt.Errorf("error reading session encryption key "+
+			"file: %v", err)
+	}
+
+	key, err := hex.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("session encryption key must be hex "+
+			"encoded: %v", err)
+	}
+
+	if len(key) != session.EncryptionKeySize {
+		return nil, fmt.Errorf("session encryption key must be %d "+
+			"bytes, got %d", session.EncryptionKeySize, len(key))
+	}
+
+	return key, nil
+}
+
+// parseLabelPattern compiles the given session label pattern so that it only
+// matches whole labels. An empty pattern results in a nil regular expression.
+func parseLabelPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+
+	labelPattern, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid session label pattern: %v", err)
+	}
+
+	return labelPattern, nil
+}
+
+// permissions returns the permissions of a readonly or admin session. These
+// are the default permissions with the configured overrides applied.
+func (c *SessionConfig) permissions(readOnly bool) []bakery.Op {
+	add, remove := c.adminAdd, c.adminRemove
+	if readOnly {
+		add, remove = c.readOnlyAdd, c.readOnlyRemove
+	}
+
+	removed := make(map[bakery.Op]bool, len(remove))
+	for _, op := range remove {
+		removed[op] = true
+	}
+
+	perms := GetAllPermissions(readOnly)
+	result := make([]bakery.Op, 0, len(perms)+len(add))
+	seen := make(map[bakery.Op]bool, len(perms)+len(add))
+	for _, op := range append(perms, add...) {
+		if removed[op] || seen[op] {
+			continue
+		}
+
+		seen[op] = true
+		result = append(result, op)
+	}
+
+	return result
+}
+
+// parsePermissions parses a list of permissions in the form entity:action.
+func parsePermissions(perms []string) ([]bakery.Op, error) {
+	ops := make([]bakery.Op, 0, len(perms))
+	for _, perm := range perms {
+		parts := strings.Split(perm, ":")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid permission %q, must be "+
+				"in the form entity:action", perm)
+		}
+
+		ops = append(ops, bakery.Op{
+			Entity: parts[0],
+			Action: parts[1],
+		})
+	}
+
+	return ops, nil
+}
+
+// parsePermissionTemplates parses permission templates in the form
+// name=entity:action,entity:action into a map from name to permissions.
+func parsePermissionTemplates(templates []string) (map[string][]bakery.Op,
+	error) {
+
+	result := make(map[string][]bakery.Op, len(templates))
+	for _, template := range templates {
+		parts := strings.SplitN(template, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid permission template "+
+				"%q, must be in the form "+
+				"name=entity:action,entity:action", template)
+		}
+
+		name := parts[0]
+		if _, ok := result[name]; ok {
+			return nil, fmt.Errorf("duplicate permission "+
+				"template %q", name)
+		}
+
+		perms, err := parsePermissions(strings.Split(parts[1], ","))
+		if err != nil {
+			return nil, fmt.Errorf("invalid permission template "+
+				"%q: %v", name, err)
+		}
+
+		result[name] = perms
+	}
+
+	return result, nil
+}
+
 // lndConnectParams returns the connection parameters to connect to the local
 // lnd instance.
 func (c *Config) lndConnectParams() (string, lndclient.Network, string,
@@ -290,6 +708,24 @@ func defaultConfig() *Config {
 				TLSCertPath:  poolDefaultConfig.TLSCertPath,
 			},
 		},
+		Session: &SessionConfig{
+			WebhookTimeout: defaultSessionWebhookTimeout,
+			WebhookRetries: defaultSessionWebhookRetries,
+			StartTimeout:   defaultSessionStartTimeout,
+
+			StartupConcurrency: defaultSessionStartupConcurrency,
+
+			ExpiryPolicy:      expiryPolicyHard,
+			LogRedaction:      logRedactionFingerprint,
+			AutoLabel:         autoLabelNone,
+			ExpiryDrainPeriod: defaultSessionExpiryDrainPeriod,
+			RestoreWindow:     defaultSessionRestoreWindow,
+
+			SubscriberBuffer: defaultSessionSubscriberBuffer,
+
+			MaxLabelLength:       defaultMaxLabelLength,
+			MaxDescriptionLength: defaultMaxDescriptionLength,
+		},
 		Network:           DefaultNetwork,
 		LndMode:           DefaultLndMode,
 		Lnd:               &lndDefaultConfig,
@@ -394,6 +830,10 @@ func loadAndValidateConfig(interceptor signal.Interceptor) (*Config, error) {
 			"UI, at least %d characters long", uiPasswordMinLength)
 	}
 
+	if err := cfg.Session.validate(); err != nil {
+		return nil, fmt.Errorf("invalid session config: %v", err)
+	}
+
 	// Initiate our listeners. For now, we only support listening on one
 	// port at a time because we can only pass in one pre-configured RPC
 	// listener into lnd.
@@ -19,8 +19,54 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SessionsClient interface {
 	AddSession(ctx context.Context, in *AddSessionRequest, opts ...grpc.CallOption) (*AddSessionResponse, error)
+	AddSessions(ctx context.Context, in *AddSessionsRequest, opts ...grpc.CallOption) (*AddSessionsResponse, error)
 	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
 	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	UpdateSessionDescription(ctx context.Context, in *UpdateSessionDescriptionRequest, opts ...grpc.CallOption) (*UpdateSessionDescriptionResponse, error)
+	CloneSession(ctx context.Context, in *CloneSessionRequest, opts ...grpc.CallOption) (*CloneSessionResponse, error)
+	PauseAllSessions(ctx context.Context, in *PauseAllSessionsRequest, opts ...grpc.CallOption) (*PauseAllSessionsResponse, error)
+	ResumeAllSessions(ctx context.Context, in *ResumeAllSessionsRequest, opts ...grpc.CallOption) (*ResumeAllSessionsResponse, error)
+	ListSessionTypes(ctx context.Context, in *ListSessionTypesRequest, opts ...grpc.CallOption) (*ListSessionTypesResponse, error)
+	RevealPairingSecret(ctx context.Context, in *RevealPairingSecretRequest, opts ...grpc.CallOption) (*RevealPairingSecretResponse, error)
+	ReplaceSession(ctx context.Context, in *ReplaceSessionRequest, opts ...grpc.CallOption) (*ReplaceSessionResponse, error)
+	CompactDB(ctx context.Context, in *CompactDBRequest, opts ...grpc.CallOption) (*CompactDBResponse, error)
+	GetSessionMnemonic(ctx context.Context, in *GetSessionMnemonicRequest, opts ...grpc.CallOption) (*GetSessionMnemonicResponse, error)
+	ListSessionEvents(ctx context.Context, in *ListSessionEventsRequest, opts ...grpc.CallOption) (*ListSessionEventsResponse, error)
+	ValidatePermissions(ctx context.Context, in *ValidatePermissionsRequest, opts ...grpc.CallOption) (*ValidatePermissionsResponse, error)
+	RefreshSessionMacaroon(ctx context.Context, in *RefreshSessionMacaroonRequest, opts ...grpc.CallOption) (*RefreshSessionMacaroonResponse, error)
+	GetSessionConnectURI(ctx context.Context, in *GetSessionConnectURIRequest, opts ...grpc.CallOption) (*GetSessionConnectURIResponse, error)
+	GetServerStatus(ctx context.Context, in *GetServerStatusRequest, opts ...grpc.CallOption) (*GetServerStatusResponse, error)
+	RevokeExpiredSessions(ctx context.Context, in *RevokeExpiredSessionsRequest, opts ...grpc.CallOption) (*RevokeExpiredSessionsResponse, error)
+	ImportMacaroonAsSession(ctx context.Context, in *ImportMacaroonAsSessionRequest, opts ...grpc.CallOption) (*ImportMacaroonAsSessionResponse, error)
+	ListPermissionTemplates(ctx context.Context, in *ListPermissionTemplatesRequest, opts ...grpc.CallOption) (*ListPermissionTemplatesResponse, error)
+	CheckMailboxServer(ctx context.Context, in *CheckMailboxServerRequest, opts ...grpc.CallOption) (*CheckMailboxServerResponse, error)
+	MigrateSessionMailbox(ctx context.Context, in *MigrateSessionMailboxRequest, opts ...grpc.CallOption) (*MigrateSessionMailboxResponse, error)
+	RevokeSessionGroup(ctx context.Context, in *RevokeSessionGroupRequest, opts ...grpc.CallOption) (*RevokeSessionGroupResponse, error)
+	SubscribeSessionStateChanges(ctx context.Context, in *SubscribeSessionStateChangesRequest, opts ...grpc.CallOption) (Sessions_SubscribeSessionStateChangesClient, error)
+	RotateUIPassword(ctx context.Context, in *RotateUIPasswordRequest, opts ...grpc.CallOption) (*RotateUIPasswordResponse, error)
+	ListSessionsSummary(ctx context.Context, in *ListSessionsSummaryRequest, opts ...grpc.CallOption) (*ListSessionsSummaryResponse, error)
+	QuarantineSession(ctx context.Context, in *QuarantineSessionRequest, opts ...grpc.CallOption) (*QuarantineSessionResponse, error)
+	UnquarantineSession(ctx context.Context, in *UnquarantineSessionRequest, opts ...grpc.CallOption) (*UnquarantineSessionResponse, error)
+	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error)
+	RenewSessions(ctx context.Context, in *RenewSessionsRequest, opts ...grpc.CallOption) (*RenewSessionsResponse, error)
+	GetMailboxServerStats(ctx context.Context, in *GetMailboxServerStatsRequest, opts ...grpc.CallOption) (*GetMailboxServerStatsResponse, error)
+	RestoreSession(ctx context.Context, in *RestoreSessionRequest, opts ...grpc.CallOption) (*RestoreSessionResponse, error)
+	GetSessionPermissions(ctx context.Context, in *GetSessionPermissionsRequest, opts ...grpc.CallOption) (*GetSessionPermissionsResponse, error)
+	ListFailedSessions(ctx context.Context, in *ListFailedSessionsRequest, opts ...grpc.CallOption) (*ListFailedSessionsResponse, error)
+	ExportSessionEvents(ctx context.Context, in *ExportSessionEventsRequest, opts ...grpc.CallOption) (Sessions_ExportSessionEventsClient, error)
+	ApproveSession(ctx context.Context, in *ApproveSessionRequest, opts ...grpc.CallOption) (*ApproveSessionResponse, error)
+	DenySession(ctx context.Context, in *DenySessionRequest, opts ...grpc.CallOption) (*DenySessionResponse, error)
+	GetSessionsCalendar(ctx context.Context, in *GetSessionsCalendarRequest, opts ...grpc.CallOption) (*GetSessionsCalendarResponse, error)
+	AddSessionCaveat(ctx context.Context, in *AddSessionCaveatRequest, opts ...grpc.CallOption) (*AddSessionCaveatResponse, error)
+	GetSessionPairingQR(ctx context.Context, in *GetSessionPairingQRRequest, opts ...grpc.CallOption) (*GetSessionPairingQRResponse, error)
+	ChangeSessionType(ctx context.Context, in *ChangeSessionTypeRequest, opts ...grpc.CallOption) (*ChangeSessionTypeResponse, error)
+	PrepareRevokeSession(ctx context.Context, in *PrepareRevokeSessionRequest, opts ...grpc.CallOption) (*PrepareRevokeSessionResponse, error)
+	ResetSession(ctx context.Context, in *ResetSessionRequest, opts ...grpc.CallOption) (*ResetSessionResponse, error)
+	GetExpiryHistogram(ctx context.Context, in *GetExpiryHistogramRequest, opts ...grpc.CallOption) (*GetExpiryHistogramResponse, error)
+	RestartSession(ctx context.Context, in *RestartSessionRequest, opts ...grpc.CallOption) (*RestartSessionResponse, error)
+	TestSessionAccess(ctx context.Context, in *TestSessionAccessRequest, opts ...grpc.CallOption) (*TestSessionAccessResponse, error)
+	UpdateSessionsMetadata(ctx context.Context, in *UpdateSessionsMetadataRequest, opts ...grpc.CallOption) (*UpdateSessionsMetadataResponse, error)
+	PreviewSessionMacaroon(ctx context.Context, in *PreviewSessionMacaroonRequest, opts ...grpc.CallOption) (*PreviewSessionMacaroonResponse, error)
 }
 
 type sessionsClient struct {
@@ -40,110 +86,1588 @@ func (c *sessionsClient) AddSession(ctx context.Context, in *AddSessionRequest,
 	return out, nil
 }
 
+func (c *sessionsClient) AddSessions(ctx context.Context, in *AddSessionsRequest, opts ...grpc.CallOption) (*AddSessionsResponse, error) {
+	out := new(AddSessionsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/AddSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sessionsClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
 	out := new(ListSessionsResponse)
 	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ListSessions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *sessionsClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) UpdateSessionDescription(ctx context.Context, in *UpdateSessionDescriptionRequest, opts ...grpc.CallOption) (*UpdateSessionDescriptionResponse, error) {
+	out := new(UpdateSessionDescriptionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/UpdateSessionDescription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) CloneSession(ctx context.Context, in *CloneSessionRequest, opts ...grpc.CallOption) (*CloneSessionResponse, error) {
+	out := new(CloneSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/CloneSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) PauseAllSessions(ctx context.Context, in *PauseAllSessionsRequest, opts ...grpc.CallOption) (*PauseAllSessionsResponse, error) {
+	out := new(PauseAllSessionsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/PauseAllSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ResumeAllSessions(ctx context.Context, in *ResumeAllSessionsRequest, opts ...grpc.CallOption) (*ResumeAllSessionsResponse, error) {
+	out := new(ResumeAllSessionsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ResumeAllSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ListSessionTypes(ctx context.Context, in *ListSessionTypesRequest, opts ...grpc.CallOption) (*ListSessionTypesResponse, error) {
+	out := new(ListSessionTypesResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ListSessionTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) RevealPairingSecret(ctx context.Context, in *RevealPairingSecretRequest, opts ...grpc.CallOption) (*RevealPairingSecretResponse, error) {
+	out := new(RevealPairingSecretResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RevealPairingSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ReplaceSession(ctx context.Context, in *ReplaceSessionRequest, opts ...grpc.CallOption) (*ReplaceSessionResponse, error) {
+	out := new(ReplaceSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ReplaceSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) CompactDB(ctx context.Context, in *CompactDBRequest, opts ...grpc.CallOption) (*CompactDBResponse, error) {
+	out := new(CompactDBResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/CompactDB", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) GetSessionMnemonic(ctx context.Context, in *GetSessionMnemonicRequest, opts ...grpc.CallOption) (*GetSessionMnemonicResponse, error) {
+	out := new(GetSessionMnemonicResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/GetSessionMnemonic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ListSessionEvents(ctx context.Context, in *ListSessionEventsRequest, opts ...grpc.CallOption) (*ListSessionEventsResponse, error) {
+	out := new(ListSessionEventsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ListSessionEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ValidatePermissions(ctx context.Context, in *ValidatePermissionsRequest, opts ...grpc.CallOption) (*ValidatePermissionsResponse, error) {
+	out := new(ValidatePermissionsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ValidatePermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) RefreshSessionMacaroon(ctx context.Context, in *RefreshSessionMacaroonRequest, opts ...grpc.CallOption) (*RefreshSessionMacaroonResponse, error) {
+	out := new(RefreshSessionMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RefreshSessionMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) GetSessionConnectURI(ctx context.Context, in *GetSessionConnectURIRequest, opts ...grpc.CallOption) (*GetSessionConnectURIResponse, error) {
+	out := new(GetSessionConnectURIResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/GetSessionConnectURI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) GetServerStatus(ctx context.Context, in *GetServerStatusRequest, opts ...grpc.CallOption) (*GetServerStatusResponse, error) {
+	out := new(GetServerStatusResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/GetServerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) RevokeExpiredSessions(ctx context.Context, in *RevokeExpiredSessionsRequest, opts ...grpc.CallOption) (*RevokeExpiredSessionsResponse, error) {
+	out := new(RevokeExpiredSessionsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RevokeExpiredSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ImportMacaroonAsSession(ctx context.Context, in *ImportMacaroonAsSessionRequest, opts ...grpc.CallOption) (*ImportMacaroonAsSessionResponse, error) {
+	out := new(ImportMacaroonAsSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ImportMacaroonAsSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ListPermissionTemplates(ctx context.Context, in *ListPermissionTemplatesRequest, opts ...grpc.CallOption) (*ListPermissionTemplatesResponse, error) {
+	out := new(ListPermissionTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ListPermissionTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) CheckMailboxServer(ctx context.Context, in *CheckMailboxServerRequest, opts ...grpc.CallOption) (*CheckMailboxServerResponse, error) {
+	out := new(CheckMailboxServerResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/CheckMailboxServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) MigrateSessionMailbox(ctx context.Context, in *MigrateSessionMailboxRequest, opts ...grpc.CallOption) (*MigrateSessionMailboxResponse, error) {
+	out := new(MigrateSessionMailboxResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/MigrateSessionMailbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) RevokeSessionGroup(ctx context.Context, in *RevokeSessionGroupRequest, opts ...grpc.CallOption) (*RevokeSessionGroupResponse, error) {
+	out := new(RevokeSessionGroupResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RevokeSessionGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) SubscribeSessionStateChanges(ctx context.Context, in *SubscribeSessionStateChangesRequest, opts ...grpc.CallOption) (Sessions_SubscribeSessionStateChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Sessions_ServiceDesc.Streams[0], "/litrpc.Sessions/SubscribeSessionStateChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsSubscribeSessionStateChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Sessions_SubscribeSessionStateChangesClient interface {
+	Recv() (*SessionStateUpdate, error)
+	grpc.ClientStream
+}
+
+type sessionsSubscribeSessionStateChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsSubscribeSessionStateChangesClient) Recv() (*SessionStateUpdate, error) {
+	m := new(SessionStateUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsClient) RotateUIPassword(ctx context.Context, in *RotateUIPasswordRequest, opts ...grpc.CallOption) (*RotateUIPasswordResponse, error) {
+	out := new(RotateUIPasswordResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RotateUIPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ListSessionsSummary(ctx context.Context, in *ListSessionsSummaryRequest, opts ...grpc.CallOption) (*ListSessionsSummaryResponse, error) {
+	out := new(ListSessionsSummaryResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ListSessionsSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) QuarantineSession(ctx context.Context, in *QuarantineSessionRequest, opts ...grpc.CallOption) (*QuarantineSessionResponse, error) {
+	out := new(QuarantineSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/QuarantineSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) UnquarantineSession(ctx context.Context, in *UnquarantineSessionRequest, opts ...grpc.CallOption) (*UnquarantineSessionResponse, error) {
+	out := new(UnquarantineSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/UnquarantineSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*GetSessionResponse, error) {
+	out := new(GetSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/GetSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) RenewSessions(ctx context.Context, in *RenewSessionsRequest, opts ...grpc.CallOption) (*RenewSessionsResponse, error) {
+	out := new(RenewSessionsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RenewSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) GetMailboxServerStats(ctx context.Context, in *GetMailboxServerStatsRequest, opts ...grpc.CallOption) (*GetMailboxServerStatsResponse, error) {
+	out := new(GetMailboxServerStatsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/GetMailboxServerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) RestoreSession(ctx context.Context, in *RestoreSessionRequest, opts ...grpc.CallOption) (*RestoreSessionResponse, error) {
+	out := new(RestoreSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RestoreSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) GetSessionPermissions(ctx context.Context, in *GetSessionPermissionsRequest, opts ...grpc.CallOption) (*GetSessionPermissionsResponse, error) {
+	out := new(GetSessionPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/GetSessionPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ListFailedSessions(ctx context.Context, in *ListFailedSessionsRequest, opts ...grpc.CallOption) (*ListFailedSessionsResponse, error) {
+	out := new(ListFailedSessionsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ListFailedSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ExportSessionEvents(ctx context.Context, in *ExportSessionEventsRequest, opts ...grpc.CallOption) (Sessions_ExportSessionEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Sessions_ServiceDesc.Streams[1], "/litrpc.Sessions/ExportSessionEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsExportSessionEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Sessions_ExportSessionEventsClient interface {
+	Recv() (*ExportSessionEventsResponse, error)
+	grpc.ClientStream
+}
+
+type sessionsExportSessionEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsExportSessionEventsClient) Recv() (*ExportSessionEventsResponse, error) {
+	m := new(ExportSessionEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsClient) ApproveSession(ctx context.Context, in *ApproveSessionRequest, opts ...grpc.CallOption) (*ApproveSessionResponse, error) {
+	out := new(ApproveSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ApproveSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) DenySession(ctx context.Context, in *DenySessionRequest, opts ...grpc.CallOption) (*DenySessionResponse, error) {
+	out := new(DenySessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/DenySession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) GetSessionsCalendar(ctx context.Context, in *GetSessionsCalendarRequest, opts ...grpc.CallOption) (*GetSessionsCalendarResponse, error) {
+	out := new(GetSessionsCalendarResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/GetSessionsCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) AddSessionCaveat(ctx context.Context, in *AddSessionCaveatRequest, opts ...grpc.CallOption) (*AddSessionCaveatResponse, error) {
+	out := new(AddSessionCaveatResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/AddSessionCaveat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) GetSessionPairingQR(ctx context.Context, in *GetSessionPairingQRRequest, opts ...grpc.CallOption) (*GetSessionPairingQRResponse, error) {
+	out := new(GetSessionPairingQRResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/GetSessionPairingQR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ChangeSessionType(ctx context.Context, in *ChangeSessionTypeRequest, opts ...grpc.CallOption) (*ChangeSessionTypeResponse, error) {
+	out := new(ChangeSessionTypeResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ChangeSessionType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) PrepareRevokeSession(ctx context.Context, in *PrepareRevokeSessionRequest, opts ...grpc.CallOption) (*PrepareRevokeSessionResponse, error) {
+	out := new(PrepareRevokeSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/PrepareRevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ResetSession(ctx context.Context, in *ResetSessionRequest, opts ...grpc.CallOption) (*ResetSessionResponse, error) {
+	out := new(ResetSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ResetSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) GetExpiryHistogram(ctx context.Context, in *GetExpiryHistogramRequest, opts ...grpc.CallOption) (*GetExpiryHistogramResponse, error) {
+	out := new(GetExpiryHistogramResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/GetExpiryHistogram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) RestartSession(ctx context.Context, in *RestartSessionRequest, opts ...grpc.CallOption) (*RestartSessionResponse, error) {
+	out := new(RestartSessionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RestartSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) TestSessionAccess(ctx context.Context, in *TestSessionAccessRequest, opts ...grpc.CallOption) (*TestSessionAccessResponse, error) {
+	out := new(TestSessionAccessResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/TestSessionAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) UpdateSessionsMetadata(ctx context.Context, in *UpdateSessionsMetadataRequest, opts ...grpc.CallOption) (*UpdateSessionsMetadataResponse, error) {
+	out := new(UpdateSessionsMetadataResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/UpdateSessionsMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) PreviewSessionMacaroon(ctx context.Context, in *PreviewSessionMacaroonRequest, opts ...grpc.CallOption) (*PreviewSessionMacaroonResponse, error) {
+	out := new(PreviewSessionMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/PreviewSessionMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SessionsServer is the server API for Sessions service.
+// All implementations must embed UnimplementedSessionsServer
+// for forward compatibility
+type SessionsServer interface {
+	AddSession(context.Context, *AddSessionRequest) (*AddSessionResponse, error)
+	AddSessions(context.Context, *AddSessionsRequest) (*AddSessionsResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	UpdateSessionDescription(context.Context, *UpdateSessionDescriptionRequest) (*UpdateSessionDescriptionResponse, error)
+	CloneSession(context.Context, *CloneSessionRequest) (*CloneSessionResponse, error)
+	PauseAllSessions(context.Context, *PauseAllSessionsRequest) (*PauseAllSessionsResponse, error)
+	ResumeAllSessions(context.Context, *ResumeAllSessionsRequest) (*ResumeAllSessionsResponse, error)
+	ListSessionTypes(context.Context, *ListSessionTypesRequest) (*ListSessionTypesResponse, error)
+	RevealPairingSecret(context.Context, *RevealPairingSecretRequest) (*RevealPairingSecretResponse, error)
+	ReplaceSession(context.Context, *ReplaceSessionRequest) (*ReplaceSessionResponse, error)
+	CompactDB(context.Context, *CompactDBRequest) (*CompactDBResponse, error)
+	GetSessionMnemonic(context.Context, *GetSessionMnemonicRequest) (*GetSessionMnemonicResponse, error)
+	ListSessionEvents(context.Context, *ListSessionEventsRequest) (*ListSessionEventsResponse, error)
+	ValidatePermissions(context.Context, *ValidatePermissionsRequest) (*ValidatePermissionsResponse, error)
+	RefreshSessionMacaroon(context.Context, *RefreshSessionMacaroonRequest) (*RefreshSessionMacaroonResponse, error)
+	GetSessionConnectURI(context.Context, *GetSessionConnectURIRequest) (*GetSessionConnectURIResponse, error)
+	GetServerStatus(context.Context, *GetServerStatusRequest) (*GetServerStatusResponse, error)
+	RevokeExpiredSessions(context.Context, *RevokeExpiredSessionsRequest) (*RevokeExpiredSessionsResponse, error)
+	ImportMacaroonAsSession(context.Context, *ImportMacaroonAsSessionRequest) (*ImportMacaroonAsSessionResponse, error)
+	ListPermissionTemplates(context.Context, *ListPermissionTemplatesRequest) (*ListPermissionTemplatesResponse, error)
+	CheckMailboxServer(context.Context, *CheckMailboxServerRequest) (*CheckMailboxServerResponse, error)
+	MigrateSessionMailbox(context.Context, *MigrateSessionMailboxRequest) (*MigrateSessionMailboxResponse, error)
+	RevokeSessionGroup(context.Context, *RevokeSessionGroupRequest) (*RevokeSessionGroupResponse, error)
+	SubscribeSessionStateChanges(*SubscribeSessionStateChangesRequest, Sessions_SubscribeSessionStateChangesServer) error
+	RotateUIPassword(context.Context, *RotateUIPasswordRequest) (*RotateUIPasswordResponse, error)
+	ListSessionsSummary(context.Context, *ListSessionsSummaryRequest) (*ListSessionsSummaryResponse, error)
+	QuarantineSession(context.Context, *QuarantineSessionRequest) (*QuarantineSessionResponse, error)
+	UnquarantineSession(context.Context, *UnquarantineSessionRequest) (*UnquarantineSessionResponse, error)
+	GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error)
+	RenewSessions(context.Context, *RenewSessionsRequest) (*RenewSessionsResponse, error)
+	GetMailboxServerStats(context.Context, *GetMailboxServerStatsRequest) (*GetMailboxServerStatsResponse, error)
+	RestoreSession(context.Context, *RestoreSessionRequest) (*RestoreSessionResponse, error)
+	GetSessionPermissions(context.Context, *GetSessionPermissionsRequest) (*GetSessionPermissionsResponse, error)
+	ListFailedSessions(context.Context, *ListFailedSessionsRequest) (*ListFailedSessionsResponse, error)
+	ExportSessionEvents(*ExportSessionEventsRequest, Sessions_ExportSessionEventsServer) error
+	ApproveSession(context.Context, *ApproveSessionRequest) (*ApproveSessionResponse, error)
+	DenySession(context.Context, *DenySessionRequest) (*DenySessionResponse, error)
+	GetSessionsCalendar(context.Context, *GetSessionsCalendarRequest) (*GetSessionsCalendarResponse, error)
+	AddSessionCaveat(context.Context, *AddSessionCaveatRequest) (*AddSessionCaveatResponse, error)
+	GetSessionPairingQR(context.Context, *GetSessionPairingQRRequest) (*GetSessionPairingQRResponse, error)
+	ChangeSessionType(context.Context, *ChangeSessionTypeRequest) (*ChangeSessionTypeResponse, error)
+	PrepareRevokeSession(context.Context, *PrepareRevokeSessionRequest) (*PrepareRevokeSessionResponse, error)
+	ResetSession(context.Context, *ResetSessionRequest) (*ResetSessionResponse, error)
+	GetExpiryHistogram(context.Context, *GetExpiryHistogramRequest) (*GetExpiryHistogramResponse, error)
+	RestartSession(context.Context, *RestartSessionRequest) (*RestartSessionResponse, error)
+	TestSessionAccess(context.Context, *TestSessionAccessRequest) (*TestSessionAccessResponse, error)
+	UpdateSessionsMetadata(context.Context, *UpdateSessionsMetadataRequest) (*UpdateSessionsMetadataResponse, error)
+	PreviewSessionMacaroon(context.Context, *PreviewSessionMacaroonRequest) (*PreviewSessionMacaroonResponse, error)
+	mustEmbedUnimplementedSessionsServer()
+}
+
+// UnimplementedSessionsServer must be embedded to have forward compatible implementations.
+type UnimplementedSessionsServer struct {
+}
+
+func (UnimplementedSessionsServer) AddSession(context.Context, *AddSessionRequest) (*AddSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSession not implemented")
+}
+func (UnimplementedSessionsServer) AddSessions(context.Context, *AddSessionsRequest) (*AddSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSessions not implemented")
+}
+func (UnimplementedSessionsServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedSessionsServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedSessionsServer) UpdateSessionDescription(context.Context, *UpdateSessionDescriptionRequest) (*UpdateSessionDescriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSessionDescription not implemented")
+}
+func (UnimplementedSessionsServer) CloneSession(context.Context, *CloneSessionRequest) (*CloneSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneSession not implemented")
+}
+func (UnimplementedSessionsServer) PauseAllSessions(context.Context, *PauseAllSessionsRequest) (*PauseAllSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseAllSessions not implemented")
+}
+func (UnimplementedSessionsServer) ResumeAllSessions(context.Context, *ResumeAllSessionsRequest) (*ResumeAllSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAllSessions not implemented")
+}
+func (UnimplementedSessionsServer) ListSessionTypes(context.Context, *ListSessionTypesRequest) (*ListSessionTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionTypes not implemented")
+}
+func (UnimplementedSessionsServer) RevealPairingSecret(context.Context, *RevealPairingSecretRequest) (*RevealPairingSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealPairingSecret not implemented")
+}
+func (UnimplementedSessionsServer) ReplaceSession(context.Context, *ReplaceSessionRequest) (*ReplaceSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceSession not implemented")
+}
+func (UnimplementedSessionsServer) CompactDB(context.Context, *CompactDBRequest) (*CompactDBResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactDB not implemented")
+}
+func (UnimplementedSessionsServer) GetSessionMnemonic(context.Context, *GetSessionMnemonicRequest) (*GetSessionMnemonicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionMnemonic not implemented")
+}
+func (UnimplementedSessionsServer) ListSessionEvents(context.Context, *ListSessionEventsRequest) (*ListSessionEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionEvents not implemented")
+}
+func (UnimplementedSessionsServer) ValidatePermissions(context.Context, *ValidatePermissionsRequest) (*ValidatePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatePermissions not implemented")
+}
+func (UnimplementedSessionsServer) RefreshSessionMacaroon(context.Context, *RefreshSessionMacaroonRequest) (*RefreshSessionMacaroonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSessionMacaroon not implemented")
+}
+func (UnimplementedSessionsServer) GetSessionConnectURI(context.Context, *GetSessionConnectURIRequest) (*GetSessionConnectURIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionConnectURI not implemented")
+}
+func (UnimplementedSessionsServer) GetServerStatus(context.Context, *GetServerStatusRequest) (*GetServerStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerStatus not implemented")
+}
+func (UnimplementedSessionsServer) RevokeExpiredSessions(context.Context, *RevokeExpiredSessionsRequest) (*RevokeExpiredSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeExpiredSessions not implemented")
+}
+func (UnimplementedSessionsServer) ImportMacaroonAsSession(context.Context, *ImportMacaroonAsSessionRequest) (*ImportMacaroonAsSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMacaroonAsSession not implemented")
+}
+func (UnimplementedSessionsServer) ListPermissionTemplates(context.Context, *ListPermissionTemplatesRequest) (*ListPermissionTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissionTemplates not implemented")
+}
+func (UnimplementedSessionsServer) CheckMailboxServer(context.Context, *CheckMailboxServerRequest) (*CheckMailboxServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckMailboxServer not implemented")
+}
+func (UnimplementedSessionsServer) MigrateSessionMailbox(context.Context, *MigrateSessionMailboxRequest) (*MigrateSessionMailboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateSessionMailbox not implemented")
+}
+func (UnimplementedSessionsServer) RevokeSessionGroup(context.Context, *RevokeSessionGroupRequest) (*RevokeSessionGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessionGroup not implemented")
+}
+func (UnimplementedSessionsServer) SubscribeSessionStateChanges(*SubscribeSessionStateChangesRequest, Sessions_SubscribeSessionStateChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSessionStateChanges not implemented")
+}
+func (UnimplementedSessionsServer) RotateUIPassword(context.Context, *RotateUIPasswordRequest) (*RotateUIPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateUIPassword not implemented")
+}
+func (UnimplementedSessionsServer) ListSessionsSummary(context.Context, *ListSessionsSummaryRequest) (*ListSessionsSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionsSummary not implemented")
+}
+func (UnimplementedSessionsServer) QuarantineSession(context.Context, *QuarantineSessionRequest) (*QuarantineSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantineSession not implemented")
+}
+func (UnimplementedSessionsServer) UnquarantineSession(context.Context, *UnquarantineSessionRequest) (*UnquarantineSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnquarantineSession not implemented")
+}
+func (UnimplementedSessionsServer) GetSession(context.Context, *GetSessionRequest) (*GetSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSession not implemented")
+}
+func (UnimplementedSessionsServer) RenewSessions(context.Context, *RenewSessionsRequest) (*RenewSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewSessions not implemented")
+}
+func (UnimplementedSessionsServer) GetMailboxServerStats(context.Context, *GetMailboxServerStatsRequest) (*GetMailboxServerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMailboxServerStats not implemented")
+}
+func (UnimplementedSessionsServer) RestoreSession(context.Context, *RestoreSessionRequest) (*RestoreSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSession not implemented")
+}
+func (UnimplementedSessionsServer) GetSessionPermissions(context.Context, *GetSessionPermissionsRequest) (*GetSessionPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionPermissions not implemented")
+}
+func (UnimplementedSessionsServer) ListFailedSessions(context.Context, *ListFailedSessionsRequest) (*ListFailedSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFailedSessions not implemented")
+}
+func (UnimplementedSessionsServer) ExportSessionEvents(*ExportSessionEventsRequest, Sessions_ExportSessionEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSessionEvents not implemented")
+}
+func (UnimplementedSessionsServer) ApproveSession(context.Context, *ApproveSessionRequest) (*ApproveSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSession not implemented")
+}
+func (UnimplementedSessionsServer) DenySession(context.Context, *DenySessionRequest) (*DenySessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenySession not implemented")
+}
+func (UnimplementedSessionsServer) GetSessionsCalendar(context.Context, *GetSessionsCalendarRequest) (*GetSessionsCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionsCalendar not implemented")
+}
+func (UnimplementedSessionsServer) AddSessionCaveat(context.Context, *AddSessionCaveatRequest) (*AddSessionCaveatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSessionCaveat not implemented")
+}
+func (UnimplementedSessionsServer) GetSessionPairingQR(context.Context, *GetSessionPairingQRRequest) (*GetSessionPairingQRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionPairingQR not implemented")
+}
+func (UnimplementedSessionsServer) ChangeSessionType(context.Context, *ChangeSessionTypeRequest) (*ChangeSessionTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSessionType not implemented")
+}
+func (UnimplementedSessionsServer) PrepareRevokeSession(context.Context, *PrepareRevokeSessionRequest) (*PrepareRevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareRevokeSession not implemented")
+}
+func (UnimplementedSessionsServer) ResetSession(context.Context, *ResetSessionRequest) (*ResetSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetSession not implemented")
+}
+func (UnimplementedSessionsServer) GetExpiryHistogram(context.Context, *GetExpiryHistogramRequest) (*GetExpiryHistogramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpiryHistogram not implemented")
+}
+func (UnimplementedSessionsServer) RestartSession(context.Context, *RestartSessionRequest) (*RestartSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestartSession not implemented")
+}
+func (UnimplementedSessionsServer) TestSessionAccess(context.Context, *TestSessionAccessRequest) (*TestSessionAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestSessionAccess not implemented")
+}
+func (UnimplementedSessionsServer) UpdateSessionsMetadata(context.Context, *UpdateSessionsMetadataRequest) (*UpdateSessionsMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSessionsMetadata not implemented")
+}
+func (UnimplementedSessionsServer) PreviewSessionMacaroon(context.Context, *PreviewSessionMacaroonRequest) (*PreviewSessionMacaroonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewSessionMacaroon not implemented")
+}
+func (UnimplementedSessionsServer) mustEmbedUnimplementedSessionsServer() {}
+
+// UnsafeSessionsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SessionsServer will
+// result in compilation errors.
+type UnsafeSessionsServer interface {
+	mustEmbedUnimplementedSessionsServer()
+}
+
+func RegisterSessionsServer(s grpc.ServiceRegistrar, srv SessionsServer) {
+	s.RegisterService(&Sessions_ServiceDesc, srv)
+}
+
+func _Sessions_AddSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).AddSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/AddSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).AddSession(ctx, req.(*AddSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_AddSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).AddSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/AddSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).AddSessions(ctx, req.(*AddSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_UpdateSessionDescription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSessionDescriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).UpdateSessionDescription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/UpdateSessionDescription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).UpdateSessionDescription(ctx, req.(*UpdateSessionDescriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_CloneSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).CloneSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/CloneSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).CloneSession(ctx, req.(*CloneSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_PauseAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).PauseAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/PauseAllSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).PauseAllSessions(ctx, req.(*PauseAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ResumeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeAllSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ResumeAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ResumeAllSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ResumeAllSessions(ctx, req.(*ResumeAllSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ListSessionTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ListSessionTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ListSessionTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ListSessionTypes(ctx, req.(*ListSessionTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_RevealPairingSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealPairingSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).RevealPairingSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/RevealPairingSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).RevealPairingSecret(ctx, req.(*RevealPairingSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ReplaceSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ReplaceSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ReplaceSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ReplaceSession(ctx, req.(*ReplaceSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_CompactDB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactDBRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).CompactDB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/CompactDB",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).CompactDB(ctx, req.(*CompactDBRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_GetSessionMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionMnemonicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).GetSessionMnemonic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/GetSessionMnemonic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).GetSessionMnemonic(ctx, req.(*GetSessionMnemonicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ListSessionEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ListSessionEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ListSessionEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ListSessionEvents(ctx, req.(*ListSessionEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ValidatePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ValidatePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ValidatePermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ValidatePermissions(ctx, req.(*ValidatePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_RefreshSessionMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).RefreshSessionMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/RefreshSessionMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).RefreshSessionMacaroon(ctx, req.(*RefreshSessionMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_GetSessionConnectURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionConnectURIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).GetSessionConnectURI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/GetSessionConnectURI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).GetSessionConnectURI(ctx, req.(*GetSessionConnectURIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_GetServerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).GetServerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/GetServerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).GetServerStatus(ctx, req.(*GetServerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_RevokeExpiredSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeExpiredSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).RevokeExpiredSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/RevokeExpiredSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).RevokeExpiredSessions(ctx, req.(*RevokeExpiredSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ImportMacaroonAsSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMacaroonAsSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ImportMacaroonAsSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ImportMacaroonAsSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ImportMacaroonAsSession(ctx, req.(*ImportMacaroonAsSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ListPermissionTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ListPermissionTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ListPermissionTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ListPermissionTemplates(ctx, req.(*ListPermissionTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_CheckMailboxServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckMailboxServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).CheckMailboxServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/CheckMailboxServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).CheckMailboxServer(ctx, req.(*CheckMailboxServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_MigrateSessionMailbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateSessionMailboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).MigrateSessionMailbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/MigrateSessionMailbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).MigrateSessionMailbox(ctx, req.(*MigrateSessionMailboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_RevokeSessionGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).RevokeSessionGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/RevokeSessionGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).RevokeSessionGroup(ctx, req.(*RevokeSessionGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_SubscribeSessionStateChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeSessionStateChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SessionsServer).SubscribeSessionStateChanges(m, &sessionsSubscribeSessionStateChangesServer{stream})
+}
+
+type Sessions_SubscribeSessionStateChangesServer interface {
+	Send(*SessionStateUpdate) error
+	grpc.ServerStream
+}
+
+type sessionsSubscribeSessionStateChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *sessionsSubscribeSessionStateChangesServer) Send(m *SessionStateUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Sessions_RotateUIPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateUIPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).RotateUIPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/RotateUIPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).RotateUIPassword(ctx, req.(*RotateUIPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ListSessionsSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ListSessionsSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ListSessionsSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ListSessionsSummary(ctx, req.(*ListSessionsSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_QuarantineSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantineSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).QuarantineSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/QuarantineSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).QuarantineSession(ctx, req.(*QuarantineSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_UnquarantineSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnquarantineSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).UnquarantineSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/UnquarantineSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).UnquarantineSession(ctx, req.(*UnquarantineSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/GetSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).GetSession(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_RenewSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).RenewSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/RenewSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).RenewSessions(ctx, req.(*RenewSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_GetMailboxServerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMailboxServerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).GetMailboxServerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/GetMailboxServerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).GetMailboxServerStats(ctx, req.(*GetMailboxServerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_RestoreSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).RestoreSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/RestoreSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).RestoreSession(ctx, req.(*RestoreSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_GetSessionPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).GetSessionPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/GetSessionPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).GetSessionPermissions(ctx, req.(*GetSessionPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ListFailedSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFailedSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ListFailedSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ListFailedSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ListFailedSessions(ctx, req.(*ListFailedSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ExportSessionEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSessionEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SessionsServer).ExportSessionEvents(m, &sessionsExportSessionEventsServer{stream})
+}
+
+type Sessions_ExportSessionEventsServer interface {
+	Send(*ExportSessionEventsResponse) error
+	grpc.ServerStream
+}
+
+type sessionsExportSessionEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *sessionsExportSessionEventsServer) Send(m *ExportSessionEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Sessions_ApproveSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ApproveSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ApproveSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ApproveSession(ctx, req.(*ApproveSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *sessionsClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
-	out := new(RevokeSessionResponse)
-	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RevokeSession", in, out, opts...)
-	if err != nil {
+func _Sessions_DenySession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenySessionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SessionsServer).DenySession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/DenySession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).DenySession(ctx, req.(*DenySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// SessionsServer is the server API for Sessions service.
-// All implementations must embed UnimplementedSessionsServer
-// for forward compatibility
-type SessionsServer interface {
-	AddSession(context.Context, *AddSessionRequest) (*AddSessionResponse, error)
-	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
-	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
-	mustEmbedUnimplementedSessionsServer()
+func _Sessions_GetSessionsCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionsCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).GetSessionsCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/GetSessionsCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).GetSessionsCalendar(ctx, req.(*GetSessionsCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedSessionsServer must be embedded to have forward compatible implementations.
-type UnimplementedSessionsServer struct {
+func _Sessions_AddSessionCaveat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSessionCaveatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).AddSessionCaveat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/AddSessionCaveat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).AddSessionCaveat(ctx, req.(*AddSessionCaveatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedSessionsServer) AddSession(context.Context, *AddSessionRequest) (*AddSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSession not implemented")
+func _Sessions_GetSessionPairingQR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionPairingQRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).GetSessionPairingQR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/GetSessionPairingQR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).GetSessionPairingQR(ctx, req.(*GetSessionPairingQRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSessionsServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+
+func _Sessions_ChangeSessionType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSessionTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ChangeSessionType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ChangeSessionType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ChangeSessionType(ctx, req.(*ChangeSessionTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSessionsServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+
+func _Sessions_PrepareRevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareRevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).PrepareRevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/PrepareRevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).PrepareRevokeSession(ctx, req.(*PrepareRevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSessionsServer) mustEmbedUnimplementedSessionsServer() {}
 
-// UnsafeSessionsServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SessionsServer will
-// result in compilation errors.
-type UnsafeSessionsServer interface {
-	mustEmbedUnimplementedSessionsServer()
+func _Sessions_ResetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ResetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ResetSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ResetSession(ctx, req.(*ResetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSessionsServer(s grpc.ServiceRegistrar, srv SessionsServer) {
-	s.RegisterService(&Sessions_ServiceDesc, srv)
+func _Sessions_GetExpiryHistogram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExpiryHistogramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).GetExpiryHistogram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/GetExpiryHistogram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).GetExpiryHistogram(ctx, req.(*GetExpiryHistogramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Sessions_AddSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddSessionRequest)
+func _Sessions_RestartSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SessionsServer).AddSession(ctx, in)
+		return srv.(SessionsServer).RestartSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/litrpc.Sessions/AddSession",
+		FullMethod: "/litrpc.Sessions/RestartSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SessionsServer).AddSession(ctx, req.(*AddSessionRequest))
+		return srv.(SessionsServer).RestartSession(ctx, req.(*RestartSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Sessions_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListSessionsRequest)
+func _Sessions_TestSessionAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestSessionAccessRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SessionsServer).ListSessions(ctx, in)
+		return srv.(SessionsServer).TestSessionAccess(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/litrpc.Sessions/ListSessions",
+		FullMethod: "/litrpc.Sessions/TestSessionAccess",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SessionsServer).ListSessions(ctx, req.(*ListSessionsRequest))
+		return srv.(SessionsServer).TestSessionAccess(ctx, req.(*TestSessionAccessRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Sessions_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevokeSessionRequest)
+func _Sessions_UpdateSessionsMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSessionsMetadataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SessionsServer).RevokeSession(ctx, in)
+		return srv.(SessionsServer).UpdateSessionsMetadata(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/litrpc.Sessions/RevokeSession",
+		FullMethod: "/litrpc.Sessions/UpdateSessionsMetadata",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SessionsServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+		return srv.(SessionsServer).UpdateSessionsMetadata(ctx, req.(*UpdateSessionsMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_PreviewSessionMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewSessionMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).PreviewSessionMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/PreviewSessionMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).PreviewSessionMacaroon(ctx, req.(*PreviewSessionMacaroonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -159,6 +1683,10 @@ var Sessions_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddSession",
 			Handler:    _Sessions_AddSession_Handler,
 		},
+		{
+			MethodName: "AddSessions",
+			Handler:    _Sessions_AddSessions_Handler,
+		},
 		{
 			MethodName: "ListSessions",
 			Handler:    _Sessions_ListSessions_Handler,
@@ -167,7 +1695,190 @@ var Sessions_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeSession",
 			Handler:    _Sessions_RevokeSession_Handler,
 		},
+		{
+			MethodName: "UpdateSessionDescription",
+			Handler:    _Sessions_UpdateSessionDescription_Handler,
+		},
+		{
+			MethodName: "CloneSession",
+			Handler:    _Sessions_CloneSession_Handler,
+		},
+		{
+			MethodName: "PauseAllSessions",
+			Handler:    _Sessions_PauseAllSessions_Handler,
+		},
+		{
+			MethodName: "ResumeAllSessions",
+			Handler:    _Sessions_ResumeAllSessions_Handler,
+		},
+		{
+			MethodName: "ListSessionTypes",
+			Handler:    _Sessions_ListSessionTypes_Handler,
+		},
+		{
+			MethodName: "RevealPairingSecret",
+			Handler:    _Sessions_RevealPairingSecret_Handler,
+		},
+		{
+			MethodName: "ReplaceSession",
+			Handler:    _Sessions_ReplaceSession_Handler,
+		},
+		{
+			MethodName: "CompactDB",
+			Handler:    _Sessions_CompactDB_Handler,
+		},
+		{
+			MethodName: "GetSessionMnemonic",
+			Handler:    _Sessions_GetSessionMnemonic_Handler,
+		},
+		{
+			MethodName: "ListSessionEvents",
+			Handler:    _Sessions_ListSessionEvents_Handler,
+		},
+		{
+			MethodName: "ValidatePermissions",
+			Handler:    _Sessions_ValidatePermissions_Handler,
+		},
+		{
+			MethodName: "RefreshSessionMacaroon",
+			Handler:    _Sessions_RefreshSessionMacaroon_Handler,
+		},
+		{
+			MethodName: "GetSessionConnectURI",
+			Handler:    _Sessions_GetSessionConnectURI_Handler,
+		},
+		{
+			MethodName: "GetServerStatus",
+			Handler:    _Sessions_GetServerStatus_Handler,
+		},
+		{
+			MethodName: "RevokeExpiredSessions",
+			Handler:    _Sessions_RevokeExpiredSessions_Handler,
+		},
+		{
+			MethodName: "ImportMacaroonAsSession",
+			Handler:    _Sessions_ImportMacaroonAsSession_Handler,
+		},
+		{
+			MethodName: "ListPermissionTemplates",
+			Handler:    _Sessions_ListPermissionTemplates_Handler,
+		},
+		{
+			MethodName: "CheckMailboxServer",
+			Handler:    _Sessions_CheckMailboxServer_Handler,
+		},
+		{
+			MethodName: "MigrateSessionMailbox",
+			Handler:    _Sessions_MigrateSessionMailbox_Handler,
+		},
+		{
+			MethodName: "RevokeSessionGroup",
+			Handler:    _Sessions_RevokeSessionGroup_Handler,
+		},
+		{
+			MethodName: "RotateUIPassword",
+			Handler:    _Sessions_RotateUIPassword_Handler,
+		},
+		{
+			MethodName: "ListSessionsSummary",
+			Handler:    _Sessions_ListSessionsSummary_Handler,
+		},
+		{
+			MethodName: "QuarantineSession",
+			Handler:    _Sessions_QuarantineSession_Handler,
+		},
+		{
+			MethodName: "UnquarantineSession",
+			Handler:    _Sessions_UnquarantineSession_Handler,
+		},
+		{
+			MethodName: "GetSession",
+			Handler:    _Sessions_GetSession_Handler,
+		},
+		{
+			MethodName: "RenewSessions",
+			Handler:    _Sessions_RenewSessions_Handler,
+		},
+		{
+			MethodName: "GetMailboxServerStats",
+			Handler:    _Sessions_GetMailboxServerStats_Handler,
+		},
+		{
+			MethodName: "RestoreSession",
+			Handler:    _Sessions_RestoreSession_Handler,
+		},
+		{
+			MethodName: "GetSessionPermissions",
+			Handler:    _Sessions_GetSessionPermissions_Handler,
+		},
+		{
+			MethodName: "ListFailedSessions",
+			Handler:    _Sessions_ListFailedSessions_Handler,
+		},
+		{
+			MethodName: "ApproveSession",
+			Handler:    _Sessions_ApproveSession_Handler,
+		},
+		{
+			MethodName: "DenySession",
+			Handler:    _Sessions_DenySession_Handler,
+		},
+		{
+			MethodName: "GetSessionsCalendar",
+			Handler:    _Sessions_GetSessionsCalendar_Handler,
+		},
+		{
+			MethodName: "AddSessionCaveat",
+			Handler:    _Sessions_AddSessionCaveat_Handler,
+		},
+		{
+			MethodName: "GetSessionPairingQR",
+			Handler:    _Sessions_GetSessionPairingQR_Handler,
+		},
+		{
+			MethodName: "ChangeSessionType",
+			Handler:    _Sessions_ChangeSessionType_Handler,
+		},
+		{
+			MethodName: "PrepareRevokeSession",
+			Handler:    _Sessions_PrepareRevokeSession_Handler,
+		},
+		{
+			MethodName: "ResetSession",
+			Handler:    _Sessions_ResetSession_Handler,
+		},
+		{
+			MethodName: "GetExpiryHistogram",
+			Handler:    _Sessions_GetExpiryHistogram_Handler,
+		},
+		{
+			MethodName: "RestartSession",
+			Handler:    _Sessions_RestartSession_Handler,
+		},
+		{
+			MethodName: "TestSessionAccess",
+			Handler:    _Sessions_TestSessionAccess_Handler,
+		},
+		{
+			MethodName: "UpdateSessionsMetadata",
+			Handler:    _Sessions_UpdateSessionsMetadata_Handler,
+		},
+		{
+			MethodName: "PreviewSessionMacaroon",
+			Handler:    _Sessions_PreviewSessionMacaroon_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeSessionStateChanges",
+			Handler:       _Sessions_SubscribeSessionStateChanges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportSessionEvents",
+			Handler:       _Sessions_ExportSessionEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lit-sessions.proto",
 }
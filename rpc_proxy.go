@@ -10,6 +10,7 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -71,12 +72,7 @@ func newRpcProxy(cfg *Config, validator macaroons.MacaroonValidator,
 	permissionMap map[string][]bakery.Op,
 	bufListener *bufconn.Listener) *rpcProxy {
 
-	// The gRPC web calls are protected by HTTP basic auth which is defined
-	// by base64(username:password). Because we only have a password, we
-	// just use base64(password:password).
-	basicAuth := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf(
-		"%s:%s", cfg.UIPassword, cfg.UIPassword,
-	)))
+	basicAuth := uiBasicAuth(cfg.UIPassword)
 
 	// Set up the final gRPC server that will serve gRPC web to the browser
 	// and translate all incoming gRPC web calls into native gRPC that are
@@ -156,9 +152,14 @@ func newRpcProxy(cfg *Config, validator macaroons.MacaroonValidator,
 //
 type rpcProxy struct {
 	cfg           *Config
-	basicAuth     string
 	permissionMap map[string][]bakery.Op
 
+	// basicAuth is the expected basic authentication of gRPC web calls. It
+	// must only be accessed through getBasicAuth and setBasicAuth since it
+	// can be changed while the proxy is running.
+	basicAuth    string
+	basicAuthMtx sync.RWMutex
+
 	macValidator      macaroons.MacaroonValidator
 	superMacValidator session.SuperMacaroonValidator
 	bufListener       *bufconn.Listener
@@ -488,6 +489,34 @@ func (p *rpcProxy) convertBasicAuth(ctx context.Context,
 	return metadata.NewIncomingContext(ctx, md), nil
 }
 
+// uiBasicAuth returns the basic authentication that corresponds to the given
+// UI password. The gRPC web calls are protected by HTTP basic auth which is
+// defined by base64(username:password). Because we only have a password, we
+// just use base64(password:password).
+func uiBasicAuth(password string) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf(
+		"%s:%s", password, password,
+	)))
+}
+
+// getBasicAuth returns the basic authentication gRPC web calls are currently
+// expected to carry.
+func (p *rpcProxy) getBasicAuth() string {
+	p.basicAuthMtx.RLock()
+	defer p.basicAuthMtx.RUnlock()
+
+	return p.basicAuth
+}
+
+// setBasicAuth replaces the basic authentication gRPC web calls are expected
+// to carry.
+func (p *rpcProxy) setBasicAuth(basicAuth string) {
+	p.basicAuthMtx.Lock()
+	defer p.basicAuthMtx.Unlock()
+
+	p.basicAuth = basicAuth
+}
+
 // basicAuthToMacaroon checks that the incoming request context has the expected
 // and valid basic authentication header then attaches the correct macaroon to
 // the context so it can be forwarded to the actual gRPC server.
@@ -503,7 +532,7 @@ func (p *rpcProxy) basicAuthToMacaroon(basicAuth, requestURI string,
 	if len(authHeaderParts) != 2 {
 		return nil, ctxErr
 	}
-	if authHeaderParts[1] != p.basicAuth {
+	if authHeaderParts[1] != p.getBasicAuth() {
 		return nil, ctxErr
 	}
 
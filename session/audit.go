@@ -0,0 +1,247 @@
+package session
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/tlv"
+	"go.etcd.io/bbolt"
+)
+
+// AuditEventType is the type of a lifecycle action recorded in the audit log.
+type AuditEventType uint8
+
+const (
+	// AuditEventCreated indicates that a session was created.
+	AuditEventCreated AuditEventType = 0
+
+	// AuditEventStarted indicates that the mailbox connection of a session
+	// was started.
+	AuditEventStarted AuditEventType = 1
+
+	// AuditEventStopped indicates that the mailbox connection of a session
+	// was stopped.
+	AuditEventStopped AuditEventType = 2
+
+	// AuditEventRevoked indicates that a session was revoked.
+	AuditEventRevoked AuditEventType = 3
+
+	// AuditEventExpired indicates that a session was marked as expired.
+	AuditEventExpired AuditEventType = 4
+
+	// AuditEventRenewed indicates that the macaroon of a session was
+	// renewed.
+	AuditEventRenewed AuditEventType = 5
+
+	// AuditEventQuarantined indicates that a session was quarantined.
+	AuditEventQuarantined AuditEventType = 6
+
+	// AuditEventUnquarantined indicates that a quarantined session was
+	// restored.
+	AuditEventUnquarantined AuditEventType = 7
+
+	// AuditEventRestored indicates that a softly revoked session was
+	// restored.
+	AuditEventRestored AuditEventType = 8
+)
+
+const (
+	typeAuditEventType      tlv.Type = 1
+	typeAuditEventTimestamp tlv.Type = 2
+	typeAuditEventPubKey    tlv.Type = 3
+)
+
+var (
+	// auditBucketKey is the top level bucket of the append-only audit log.
+	// The events are indexed by an increasing sequence number, so they are
+	// iterated in the order they were recorded.
+	auditBucketKey = []byte("session-audit")
+)
+
+// AuditEvent is a single lifecycle action of a session recorded in the audit
+// log.
+type AuditEvent struct {
+	// Type is the action that was performed.
+	Type AuditEventType
+
+	// LocalPublicKey is the local public key of the affected session.
+	LocalPublicKey *btcec.PublicKey
+
+	// Timestamp is the time the action was performed.
+	Timestamp time.Time
+}
+
+// AuditEventFilter restricts the events returned from the audit log. Unset
+// fields don't restrict the result.
+type AuditEventFilter struct {
+	// LocalPublicKey only selects events of the session with this key.
+	LocalPublicKey *btcec.PublicKey
+
+	// StartTime only selects events recorded at or after this time.
+	StartTime time.Time
+
+	// EndTime only selects events recorded at or before this time.
+	EndTime time.Time
+}
+
+// matches returns true if the given event is selected by the filter.
+func (f *AuditEventFilter) matches(event *AuditEvent) bool {
+	if f.LocalPublicKey != nil &&
+		!event.LocalPublicKey.IsEqual(f.LocalPublicKey) {
+
+		return false
+	}
+
+	if !f.StartTime.IsZero() && event.Timestamp.Before(f.StartTime) {
+		return false
+	}
+
+	if !f.EndTime.IsZero() && event.Timestamp.After(f.EndTime) {
+		return false
+	}
+
+	return true
+}
+
+// AddAuditEvent appends an event of the given type for the session with the
+// given local public key to the audit log.
+func (db *DB) AddAuditEvent(key *btcec.PublicKey, typ AuditEventType) error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		return putAuditEvent(tx, key, typ)
+	})
+}
+
+// ListAuditEvents returns all events of the audit log that are selected by the
+// given filter, in the order they were recorded.
+func (db *DB) ListAuditEvents(filter *AuditEventFilter) ([]*AuditEvent,
+	error) {
+
+	var events []*AuditEvent
+	err := db.View(func(tx *bbolt.Tx) error {
+		auditBucket, err := getBucket(tx, auditBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return auditBucket.ForEach(func(_, v []byte) error {
+			event, err := deserializeAuditEvent(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			if filter.matches(event) {
+				events = append(events, event)
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// putAuditEvent appends an event of the given type that happened now to the
+// audit log as part of the given transaction.
+func putAuditEvent(tx *bbolt.Tx, key *btcec.PublicKey,
+	typ AuditEventType) error {
+
+	auditBucket, err := getBucket(tx, auditBucketKey)
+	if err != nil {
+		return err
+	}
+
+	seq, err := auditBucket.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	err = serializeAuditEvent(&buf, &AuditEvent{
+		Type:           typ,
+		LocalPublicKey: key,
+		Timestamp:      time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var seqKey [8]byte
+	binary.BigEndian.PutUint64(seqKey[:], seq)
+
+	return auditBucket.Put(seqKey[:], buf.Bytes())
+}
+
+// stateAuditEvent returns the audit event type that is recorded when a session
+// changes to the given state. False is returned if the state change isn't
+// recorded.
+func stateAuditEvent(state State) (AuditEventType, bool) {
+	switch state {
+	case StateRevoked:
+		return AuditEventRevoked, true
+
+	case StateExpired:
+		return AuditEventExpired, true
+
+	case StateQuarantined:
+		return AuditEventQuarantined, true
+
+	default:
+		return 0, false
+	}
+}
+
+// serializeAuditEvent binary serializes the given event to the writer using
+// the tlv format.
+func serializeAuditEvent(w io.Writer, event *AuditEvent) error {
+	var (
+		typ       = uint8(event.Type)
+		timestamp = uint64(event.Timestamp.UnixNano())
+		pubKey    = event.LocalPublicKey
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeAuditEventType, &typ),
+		tlv.MakePrimitiveRecord(typeAuditEventTimestamp, &timestamp),
+		tlv.MakePrimitiveRecord(typeAuditEventPubKey, &pubKey),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// deserializeAuditEvent deserializes an event from the given reader, expecting
+// the data to be encoded in the tlv format.
+func deserializeAuditEvent(r io.Reader) (*AuditEvent, error) {
+	var (
+		typ       uint8
+		timestamp uint64
+		pubKey    *btcec.PublicKey
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeAuditEventType, &typ),
+		tlv.MakePrimitiveRecord(typeAuditEventTimestamp, &timestamp),
+		tlv.MakePrimitiveRecord(typeAuditEventPubKey, &pubKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(r); err != nil {
+		return nil, err
+	}
+
+	return &AuditEvent{
+		Type:           AuditEventType(typ),
+		LocalPublicKey: pubKey,
+		Timestamp:      time.Unix(0, int64(timestamp)),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuditLog makes sure that creating and revoking a session is recorded in
+// the audit log and that the events can be filtered.
+func TestAuditLog(t *testing.T) {
+	db := newTestDB(t)
+
+	start := time.Now()
+	session := newTestSession(t, "audit")
+	require.NoError(t, db.StoreSession(session))
+	require.NoError(t, db.RevokeSession(session.LocalPublicKey, ""))
+
+	// Updates that don't change the state aren't recorded.
+	err := db.UpdateSessionDescription(session.LocalPublicKey, "updated")
+	require.NoError(t, err)
+
+	other := newTestSession(t, "other")
+	require.NoError(t, db.StoreSession(other))
+
+	events, err := db.ListAuditEvents(&AuditEventFilter{
+		LocalPublicKey: session.LocalPublicKey,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	require.Equal(t, AuditEventCreated, events[0].Type)
+	require.Equal(t, AuditEventRevoked, events[1].Type)
+	for _, event := range events {
+		require.True(t, event.LocalPublicKey.IsEqual(
+			session.LocalPublicKey,
+		))
+		require.False(t, event.Timestamp.Before(start))
+	}
+
+	// Without a filter, the events of all sessions are returned in order.
+	events, err = db.ListAuditEvents(&AuditEventFilter{})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	require.True(t, events[2].LocalPublicKey.IsEqual(other.LocalPublicKey))
+
+	// Events are also recorded explicitly and can be filtered by time.
+	err = db.AddAuditEvent(other.LocalPublicKey, AuditEventStarted)
+	require.NoError(t, err)
+
+	events, err = db.ListAuditEvents(&AuditEventFilter{
+		EndTime: start.Add(-time.Second),
+	})
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	events, err = db.ListAuditEvents(&AuditEventFilter{
+		LocalPublicKey: other.LocalPublicKey,
+		StartTime:      start,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, AuditEventStarted, events[1].Type)
+}
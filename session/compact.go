@@ -0,0 +1,170 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// compactTxMaxSize is the maximum size of a single transaction when
+	// copying the data into the compacted database.
+	compactTxMaxSize = 64 * 1024
+)
+
+var (
+	// ErrCompactionInProgress is returned when a compaction of the
+	// database is requested while another one is still running.
+	ErrCompactionInProgress = errors.New("database compaction already " +
+		"in progress")
+
+	// ErrDBUnusable is returned by all transactions once neither the
+	// compacted nor the original database could be opened after a
+	// compaction.
+	ErrDBUnusable = errors.New("database unusable after failed " +
+		"compaction")
+
+	// reopenDB opens the database file at the given path again after it
+	// was closed for the compaction. It is a variable so tests can make it
+	// fail.
+	reopenDB = initDB
+)
+
+// Compact rewrites the database into a new file, dropping the space that was
+// freed by previous updates, and replaces the current database with it. All
+// other transactions are blocked while the database is compacted. The size of
+// the database file before and after the compaction is returned.
+func (db *DB) Compact() (int64, int64, error) {
+	if !atomic.CompareAndSwapInt32(&db.compacting, 0, 1) {
+		return 0, 0, ErrCompactionInProgress
+	}
+	defer atomic.StoreInt32(&db.compacting, 0)
+
+	db.dbMtx.Lock()
+	defer db.dbMtx.Unlock()
+
+	if db.unusable {
+		return 0, 0, ErrDBUnusable
+	}
+
+	sizeBefore, err := fileSize(db.path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	tempPath := db.path + ".compact"
+	if err := os.RemoveAll(tempPath); err != nil {
+		return 0, 0, err
+	}
+
+	if err := compactInto(tempPath, db.DB); err != nil {
+		_ = os.Remove(tempPath)
+		return 0, 0, fmt.Errorf("error compacting database: %v", err)
+	}
+
+	// Now that we have a complete copy, we replace the original file with
+	// it. Nothing can use the database while we hold the lock, so it's
+	// safe to close and re-open it. The original file is only removed
+	// once the compacted one could be opened, so we can fall back to it.
+	if err := db.DB.Close(); err != nil {
+		return 0, 0, err
+	}
+
+	backupPath := db.path + ".orig"
+	if err := os.Rename(db.path, backupPath); err != nil {
+		_ = os.Remove(tempPath)
+		return 0, 0, db.reopen(fmt.Errorf("error replacing database: "+
+			"%v", err))
+	}
+
+	if err := os.Rename(tempPath, db.path); err != nil {
+		_ = os.Remove(tempPath)
+		return 0, 0, db.restore(backupPath, fmt.Errorf("error "+
+			"replacing database: %v", err))
+	}
+
+	newDB, err := reopenDB(db.path, false)
+	if err != nil {
+		return 0, 0, db.restore(backupPath, fmt.Errorf("error opening "+
+			"compacted database: %v", err))
+	}
+	db.DB = newDB
+
+	if err := os.Remove(backupPath); err != nil {
+		log.Warnf("Unable to remove original database file %s: %v",
+			backupPath, err)
+	}
+
+	sizeAfter, err := fileSize(db.path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return sizeBefore, sizeAfter, nil
+}
+
+// restore moves the original database file back from the given backup path and
+// re-opens it after a failed compaction. The given cause of the failure is
+// returned, extended by any error that occurs while restoring.
+func (db *DB) restore(backupPath string, cause error) error {
+	if err := os.Rename(backupPath, db.path); err != nil {
+		db.unusable = true
+		log.Errorf("Unable to restore database, the original file is "+
+			"kept at %s: %v", backupPath, err)
+
+		return fmt.Errorf("%v, error restoring original database: %v",
+			cause, err)
+	}
+
+	return db.reopen(cause)
+}
+
+// reopen re-opens the original database after a failed compaction and returns
+// the given cause of the failure. If the database can't be opened, it is marked
+// as unusable, so all following transactions fail with ErrDBUnusable instead of
+// using the closed database.
+func (db *DB) reopen(cause error) error {
+	newDB, err := reopenDB(db.path, false)
+	if err != nil {
+		db.unusable = true
+		log.Errorf("Unable to re-open database after failed "+
+			"compaction: %v", err)
+
+		return fmt.Errorf("%v, error re-opening database: %v", cause,
+			err)
+	}
+	db.DB = newDB
+
+	return cause
+}
+
+// compactInto copies all data of the given database into a new database file
+// at the given path.
+func compactInto(path string, src *bbolt.DB) error {
+	dst, err := bbolt.Open(path, dbFilePermission, &bbolt.Options{
+		Timeout: DefaultSessionDBTimeout,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := bbolt.Compact(dst, src, compactTxMaxSize); err != nil {
+		_ = dst.Close()
+		return err
+	}
+
+	return dst.Close()
+}
+
+// fileSize returns the size of the file at the given path.
+func fileSize(path string) (int64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Size(), nil
+}
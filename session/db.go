@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
+	"github.com/lightningnetwork/lnd/subscribe"
 	"go.etcd.io/bbolt"
 )
 
@@ -35,10 +37,59 @@ var (
 // DB is a bolt-backed persistent store.
 type DB struct {
 	*bbolt.DB
+
+	// path is the full path of the database file.
+	path string
+
+	// dbMtx guards the underlying bolt database, which is replaced when
+	// the database is compacted. Regular transactions hold a read lock,
+	// the compaction holds the write lock.
+	dbMtx sync.RWMutex
+
+	// compacting is set to 1 while a compaction is in progress. It must be
+	// used atomically.
+	compacting int32
+
+	// unusable is set if the database couldn't be re-opened after a failed
+	// compaction. It is guarded by dbMtx.
+	unusable bool
+
+	// stateChanges is used to notify subscribers about sessions changing
+	// their state.
+	stateChanges *subscribe.Server
+
+	// secrets encrypts the secrets of sessions before they are stored. It
+	// is nil if no encryption key is configured, in which case the secrets
+	// are stored in plaintext.
+	secrets *secretsCipher
 }
 
 // NewDB creates a new bolt database that can be found at the given directory.
+// The secrets of sessions are stored in plaintext.
 func NewDB(dir, fileName string) (*DB, error) {
+	return NewEncryptedDB(dir, fileName, nil)
+}
+
+// NewEncryptedDB creates a new bolt database that can be found at the given
+// directory. The secrets of sessions are encrypted with the given key before
+// they are stored, and the secrets of sessions that are still stored in
+// plaintext are encrypted right away. If no key is given, the secrets are
+// stored in plaintext. ErrWrongEncryptionKey or ErrEncryptionKeyRequired is
+// returned if the secrets of stored sessions were encrypted with a different
+// key.
+func NewEncryptedDB(dir, fileName string, encryptionKey []byte) (*DB, error) {
+	var secrets *secretsCipher
+	if len(encryptionKey) == 0 {
+		log.Warnf("No session encryption key configured, storing " +
+			"session secrets in plaintext")
+	} else {
+		var err error
+		secrets, err = newSecretsCipher(encryptionKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	firstInit := false
 	path := filepath.Join(dir, fileName)
 
@@ -61,7 +112,65 @@ func NewDB(dir, fileName string) (*DB, error) {
 		return nil, err
 	}
 
-	return &DB{DB: db}, nil
+	sessionDB := &DB{
+		DB:      db,
+		path:    path,
+		secrets: secrets,
+	}
+	if err := sessionDB.checkSecrets(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	stateChanges := subscribe.NewServer()
+	if err := stateChanges.Start(); err != nil {
+		return nil, err
+	}
+	sessionDB.stateChanges = stateChanges
+
+	return sessionDB, nil
+}
+
+// View executes the given function within a read-only transaction.
+func (db *DB) View(fn func(tx *bbolt.Tx) error) error {
+	db.dbMtx.RLock()
+	defer db.dbMtx.RUnlock()
+
+	if db.unusable {
+		return ErrDBUnusable
+	}
+
+	return db.DB.View(fn)
+}
+
+// Update executes the given function within a read-write transaction.
+func (db *DB) Update(fn func(tx *bbolt.Tx) error) error {
+	db.dbMtx.RLock()
+	defer db.dbMtx.RUnlock()
+
+	if db.unusable {
+		return ErrDBUnusable
+	}
+
+	return db.DB.Update(fn)
+}
+
+// Close stops notifying subscribers about state changes and closes the
+// underlying database.
+func (db *DB) Close() error {
+	if err := db.stateChanges.Stop(); err != nil {
+		return err
+	}
+
+	db.dbMtx.Lock()
+	defer db.dbMtx.Unlock()
+
+	// The bolt database of an unusable DB was already closed.
+	if db.unusable {
+		return nil
+	}
+
+	return db.DB.Close()
 }
 
 // fileExists reports whether the named file or directory exists.
@@ -103,6 +212,11 @@ func initDB(filepath string, firstInit bool) (*bbolt.DB, error) {
 		}
 
 		_, err = tx.CreateBucketIfNotExists(sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists(auditBucketKey)
 		return err
 	})
 	if err != nil {
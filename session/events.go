@@ -0,0 +1,85 @@
+package session
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+// StateChange describes a session that was newly created, that changed its
+// state or that expires soon.
+type StateChange struct {
+	// Session is the session after the change was applied.
+	Session *Session
+
+	// Created indicates that the session was newly created. If this is
+	// set, PrevState carries no meaning.
+	Created bool
+
+	// PrevState is the state the session was in before the change.
+	PrevState State
+
+	// NewState is the state the session is in after the change.
+	NewState State
+
+	// ExpiringSoon indicates that the session didn't change its state but
+	// expires soon. If this is set, PrevState and NewState are both the
+	// current state of the session.
+	ExpiringSoon bool
+
+	// Timestamp is the time the change was applied.
+	Timestamp time.Time
+}
+
+// SubscribeStateChanges returns a client that is notified about every session
+// that is created, changes its state or expires soon. Each update sent to the
+// client is a *StateChange. The client must be canceled once it is no longer
+// used.
+func (db *DB) SubscribeStateChanges() (*subscribe.Client, error) {
+	return db.stateChanges.Subscribe()
+}
+
+// notifyStateChange notifies all subscribers that the given session was
+// created or changed its state.
+func (db *DB) notifyStateChange(session *Session, prevState State,
+	created bool) {
+
+	change := &StateChange{
+		Session:   session,
+		Created:   created,
+		PrevState: prevState,
+		NewState:  session.State,
+		Timestamp: time.Now(),
+	}
+	if err := db.stateChanges.SendUpdate(change); err != nil {
+		log.Debugf("Unable to send session state change: %v", err)
+	}
+}
+
+// NotifyExpiringSoon notifies all subscribers that the session with the given
+// local public key expires soon. No notification is sent if the session is
+// already revoked or expired.
+func (db *DB) NotifyExpiringSoon(key *btcec.PublicKey) error {
+	session, err := db.GetSession(key)
+	if err != nil {
+		return err
+	}
+
+	if session.State == StateRevoked || session.State == StateExpired {
+		return nil
+	}
+
+	change := &StateChange{
+		Session:      session,
+		PrevState:    session.State,
+		NewState:     session.State,
+		ExpiringSoon: true,
+		Timestamp:    time.Now(),
+	}
+	if err := db.stateChanges.SendUpdate(change); err != nil {
+		log.Debugf("Unable to send session expiry warning: %v", err)
+	}
+
+	return nil
+}
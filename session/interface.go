@@ -6,6 +6,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/lightninglabs/lightning-node-connect/mailbox"
+	"github.com/lightningnetwork/lnd/subscribe"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 	"gopkg.in/macaroon.v2"
 )
@@ -28,6 +29,36 @@ const (
 	StateInUse   State = 1
 	StateRevoked State = 2
 	StateExpired State = 3
+
+	// StateStarting is a transient state a session is in while its mailbox
+	// connection is being started.
+	StateStarting State = 4
+
+	// StateQuarantined is the state of a session whose access was cut off
+	// but that is kept for inspection. Quarantined sessions aren't resumed
+	// until they are explicitly restored.
+	StateQuarantined State = 5
+
+	// StatePendingApproval is the state of a session that requires
+	// approval and whose remote connected but wasn't approved yet. The
+	// remote isn't served until the session is approved.
+	StatePendingApproval State = 6
+
+	// StateSuspended is the state of a session with an access schedule
+	// that is outside of its access windows. It isn't served until its
+	// next access window opens.
+	StateSuspended State = 7
+)
+
+// MnemonicVersion identifies the scheme used to derive the mnemonic of a
+// session's pairing secret.
+type MnemonicVersion uint8
+
+const (
+	// MnemonicVersionMailbox is the mnemonic scheme of the mailbox package
+	// that maps the pairing secret to words of its word list. Sessions
+	// stored before the version was recorded use this scheme.
+	MnemonicVersionMailbox MnemonicVersion = 0
 )
 
 // MacaroonRecipe defines the permissions and caveats that should be used
@@ -51,22 +82,210 @@ type Session struct {
 	LocalPrivateKey *btcec.PrivateKey
 	LocalPublicKey  *btcec.PublicKey
 	RemotePublicKey *btcec.PublicKey
+
+	// Description is an optional free-text note about the session that,
+	// unlike the label, may be longer.
+	Description string
+
+	// Revision is incremented each time the session is written to the
+	// store. It is used to detect concurrent modifications.
+	Revision uint64
+
+	// InsecureSkipVerify indicates that the TLS certificate of the mailbox
+	// server should not be verified. This is only honored for sessions
+	// that use a dev server.
+	InsecureSkipVerify bool
+
+	// FallbackServerAddrs is a list of mailbox server addresses that are
+	// tried in order if the server at ServerAddr can't be reached.
+	FallbackServerAddrs []string
+
+	// SuppressPairingSecret indicates that the pairing secret must not be
+	// returned when listing the session but only when it is explicitly
+	// revealed.
+	SuppressPairingSecret bool
+
+	// AutoRenewUntil is the time until which the macaroon of the session
+	// is periodically re-baked with a short expiry. A zero value disables
+	// the automatic renewal.
+	AutoRenewUntil time.Time
+
+	// RenewInterval is the interval in which the macaroon of an auto
+	// renewing session is re-baked.
+	RenewInterval time.Duration
+
+	// Owner identifies the caller that created the session. It is either
+	// derived from the caller's macaroon or an explicitly provided owner
+	// ID. An empty value means the owner is unknown.
+	Owner string
+
+	// SingleUse indicates that the session is revoked as soon as its
+	// mailbox connection ends for the first time.
+	SingleUse bool
+
+	// InactivityExpiry is the duration after which a running session
+	// expires if no client connected to it in the meantime. Each new
+	// connection resets the timer, Expiry stays the hard deadline. A zero
+	// value disables the inactivity expiry.
+	InactivityExpiry time.Duration
+
+	// RevokedAt is the time the session was revoked. It is zero for
+	// sessions that were never revoked.
+	RevokedAt time.Time
+
+	// RevokeReason is the optional reason that was given when the session
+	// was revoked.
+	RevokeReason string
+
+	// LastResumeAttempt is the time the session was last skipped or failed
+	// to be resumed, or was resumed successfully after such a failure.
+	LastResumeAttempt time.Time
+
+	// LastResumeError describes why the session couldn't be resumed on the
+	// last attempt. It is empty if the last attempt succeeded.
+	LastResumeError string
+
+	// ActivationTime is the time before which the mailbox connection of the
+	// session isn't started. A zero value starts the session immediately.
+	ActivationTime time.Time
+
+	// GroupID is an optional identifier shared by all sessions that are
+	// managed as a unit, for example all sessions of one customer.
+	GroupID string
+
+	// CreatedAt is the time the session was created. It is zero for
+	// sessions that were created before the creation time was recorded.
+	CreatedAt time.Time
+
+	// KeepaliveInterval is the interval in which keepalive pings are sent
+	// on the connection to the mailbox server. A zero value uses the
+	// default interval.
+	KeepaliveInterval time.Duration
+
+	// HandshakeTimeout is the maximum time to wait for the connection to a
+	// single mailbox server to be established before the next server is
+	// tried. A zero value uses the default timeout.
+	HandshakeTimeout time.Duration
+
+	// StartingFrom is the state the session was in before it was marked as
+	// starting. If the start is interrupted, for example by a crash, the
+	// session is rolled back to this state before it is started again.
+	StartingFrom State
+
+	// RestorableUntil is the time until which a softly revoked session can
+	// still be restored. It is zero for sessions that are permanently
+	// revoked or were never revoked.
+	RestorableUntil time.Time
+
+	// MacaroonExpiry is the lifetime of each macaroon that is baked for
+	// the session, counted from the time it is baked. It lets the macaroon
+	// expire before the session itself. A zero value lets the macaroon
+	// stay valid for as long as the session.
+	MacaroonExpiry time.Duration
+
+	// RemoteDisplayName is a human-readable name of the remote party that
+	// is shown instead of its public key. It is supplied by the operator
+	// when the session is created and never derived from the remote.
+	RemoteDisplayName string
+
+	// MaxConcurrentStreams is the maximum number of requests the remote can
+	// have in flight over the session's connection at the same time.
+	// Requests beyond the limit are rejected. A zero value doesn't limit
+	// the number of requests.
+	MaxConcurrentStreams uint32
+
+	// RequireApproval indicates that the remote isn't served when it
+	// connects until the connection was explicitly approved. It is cleared
+	// once the session was approved.
+	RequireApproval bool
+
+	// Priority determines the order in which sessions are resumed on
+	// startup. Sessions with a higher priority are resumed first. The
+	// default priority is zero.
+	Priority uint32
+
+	// MnemonicVersion is the scheme used to derive the mnemonic of the
+	// pairing secret that was handed out for the session.
+	MnemonicVersion MnemonicVersion
+
+	// AccessSchedule, if set, restricts the use of the session to its
+	// access windows. Outside of them, the session is suspended.
+	AccessSchedule *AccessSchedule
+
+	// HardDeadline, if set, is the time the expiry of the session can
+	// never be moved past, no matter how often it is renewed. It is set
+	// when the session is created and never changes.
+	HardDeadline time.Time
+
+	// Metadata holds arbitrary key value pairs operators tag the session
+	// with, for example the team that owns it.
+	Metadata map[string]string
+
+	// FirstConnectedAt is the time a client first completed the handshake
+	// with the session. It is zero as long as the session was never
+	// paired.
+	FirstConnectedAt time.Time
 }
 
-// NewSession creates a new session with the given user-defined parameters.
+// InvalidRecord is a stored session record that can't be used as a session.
+type InvalidRecord struct {
+	// Key is the key the record is stored under, normally the local
+	// public key of the session.
+	Key []byte
+
+	// Reason describes why the record can't be used.
+	Reason string
+}
+
+// PendingActivation returns true if the session isn't started yet because its
+// activation time lies in the future.
+func (s *Session) PendingActivation(now time.Time) bool {
+	return s.ActivationTime.After(now)
+}
+
+// AutoRenews returns true if the macaroon of the session is renewed
+// periodically.
+func (s *Session) AutoRenews() bool {
+	return !s.AutoRenewUntil.IsZero() && s.RenewInterval > 0
+}
+
+// MailboxServerAddrs returns all mailbox server addresses of the session in the
+// order they should be tried in.
+func (s *Session) MailboxServerAddrs() []string {
+	addrs := make([]string, 0, len(s.FallbackServerAddrs)+1)
+	addrs = append(addrs, s.ServerAddr)
+
+	return append(addrs, s.FallbackServerAddrs...)
+}
+
+// NewSession creates a new session with the given user-defined parameters and
+// a random local key.
 func NewSession(label string, typ Type, expiry time.Time, serverAddr string,
 	devServer bool, perms []bakery.Op, caveats []macaroon.Caveat) (*Session,
 	error) {
 
-	_, pairingSecret, err := mailbox.NewPassword()
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
 	if err != nil {
-		return nil, fmt.Errorf("error deriving pairing secret: %v", err)
+		return nil, fmt.Errorf("error deriving private key: %v", err)
 	}
 
-	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	return NewSessionWithKey(
+		privateKey, label, typ, expiry, serverAddr, devServer, perms,
+		caveats,
+	)
+}
+
+// NewSessionWithKey creates a new session with the given user-defined
+// parameters that uses the given local private key.
+func NewSessionWithKey(privateKey *btcec.PrivateKey, label string, typ Type,
+	expiry time.Time, serverAddr string, devServer bool, perms []bakery.Op,
+	caveats []macaroon.Caveat) (*Session, error) {
+
+	_, pairingSecret, err := mailbox.NewPassword()
 	if err != nil {
-		return nil, fmt.Errorf("error deriving private key: %v", err)
+		return nil, fmt.Errorf("error deriving pairing secret: %v", err)
 	}
+
 	pubKey := privateKey.PubKey()
 
 	var macRootKeyBase [4]byte
@@ -85,6 +304,7 @@ func NewSession(label string, typ Type, expiry time.Time, serverAddr string,
 		LocalPrivateKey: privateKey,
 		LocalPublicKey:  pubKey,
 		RemotePublicKey: nil,
+		CreatedAt:       time.Now(),
 	}
 
 	if perms != nil || caveats != nil {
@@ -102,13 +322,150 @@ func NewSession(label string, typ Type, expiry time.Time, serverAddr string,
 type Store interface {
 	// StoreSession stores a session in the store. If a session with the
 	// same local public key already exists, the existing record is updated/
-	// overwritten instead.
+	// overwritten instead. If the stored record was modified since the
+	// session was read, ErrSessionConflict is returned. If the key belongs
+	// to a different session, ErrSessionExists is returned.
 	StoreSession(*Session) error
 
-	// ListSessions returns all sessions currently known to the store.
+	// GetSession fetches the session with the given local public key.
+	GetSession(*btcec.PublicKey) (*Session, error)
+
+	// GetSessionByShortID fetches the session with the given short ID.
+	GetSessionByShortID(id string) (*Session, error)
+
+	// ListSessions returns all sessions currently known to the store as a
+	// consistent point-in-time view. Invalid session records are skipped.
 	ListSessions() ([]*Session, error)
 
+	// InvalidRecords returns all stored session records that can't be used
+	// as a session, for example because they are corrupted.
+	InvalidRecords() ([]*InvalidRecord, error)
+
+	// ListGroupSessions returns all sessions with the given group ID.
+	ListGroupSessions(groupID string) ([]*Session, error)
+
 	// RevokeSession updates the state of the session with the given local
-	// public key to be revoked.
-	RevokeSession(*btcec.PublicKey) error
+	// public key to be revoked and records the time of the revocation
+	// together with the given reason.
+	RevokeSession(key *btcec.PublicKey, reason string) error
+
+	// RevokeExpiredSessions revokes the sessions with the given local
+	// public keys that aren't revoked yet and whose expiry lies before the
+	// given time like RevokeSession, but in a single transaction. The
+	// revoked sessions are returned.
+	RevokeExpiredSessions(keys []*btcec.PublicKey, reason string,
+		now time.Time) ([]*Session, error)
+
+	// SoftRevokeSession revokes the session with the given local public key
+	// like RevokeSession, but keeps it restorable until the given time.
+	SoftRevokeSession(key *btcec.PublicKey, reason string,
+		restorableUntil time.Time) error
+
+	// RestoreSession moves the softly revoked session with the given local
+	// public key back to the created state, as long as its restore window
+	// and its expiry haven't passed yet.
+	RestoreSession(key *btcec.PublicKey) error
+
+	// ReplaceSession stores the given new session and revokes the session
+	// with the given old local public key for the given reason in a single
+	// transaction.
+	ReplaceSession(oldKey *btcec.PublicKey, session *Session,
+		reason string) error
+
+	// RevertReplaceSession revokes the new session with the given local
+	// public key for the given reason and moves the session it replaced
+	// back to the given state in a single transaction.
+	RevertReplaceSession(oldKey *btcec.PublicKey, oldState State,
+		newKey *btcec.PublicKey, reason string) error
+
+	// UpdateSessionState updates the state of the session with the given
+	// local public key.
+	UpdateSessionState(*btcec.PublicKey, State) error
+
+	// UpdateResumeStatus records an attempt to resume the session with the
+	// given local public key together with the error that prevented it
+	// from being resumed. An empty error marks a successful attempt.
+	UpdateResumeStatus(key *btcec.PublicKey, resumeErr string) error
+
+	// SubscribeStateChanges returns a client that is notified about every
+	// session that is created, changes its state or expires soon. Each
+	// update is a *StateChange.
+	SubscribeStateChanges() (*subscribe.Client, error)
+
+	// NotifyExpiringSoon notifies all subscribers of state changes that
+	// the session with the given local public key expires soon.
+	NotifyExpiringSoon(key *btcec.PublicKey) error
+
+	// MarkSessionStarting marks the session with the given local public
+	// key as starting if it currently is in the from state and records
+	// that state, so an interrupted start can be rolled back to it.
+	// ErrStateMismatch is returned if the session isn't in the from state.
+	MarkSessionStarting(key *btcec.PublicKey, from State) error
+
+	// SwapSessionState updates the state of the session with the given
+	// local public key to the given state, but only if it currently is in
+	// the from state. ErrStateMismatch is returned otherwise.
+	SwapSessionState(key *btcec.PublicKey, from, to State) error
+
+	// MarkSessionConnected marks the session with the given local public
+	// key as in use because a client completed the handshake with it. The
+	// time of the first connection is recorded as well. ErrStateMismatch is
+	// returned if the session isn't usable anymore.
+	MarkSessionConnected(key *btcec.PublicKey) error
+
+	// UpdateSessionExpiry updates the expiry of the session with the given
+	// local public key.
+	UpdateSessionExpiry(key *btcec.PublicKey, expiry time.Time) error
+
+	// UpdateSessionDescription updates the description of the session with
+	// the given local public key.
+	UpdateSessionDescription(*btcec.PublicKey, string) error
+
+	// UpdateSessionsMetadata sets and removes the given metadata keys of
+	// all sessions with the given local public keys in a single
+	// transaction and returns the number of sessions that changed.
+	UpdateSessionsMetadata(keys []*btcec.PublicKey, set map[string]string,
+		remove []string) (int, error)
+
+	// AddSessionCaveat appends the given caveat to the macaroon recipe of
+	// the session with the given local public key.
+	AddSessionCaveat(key *btcec.PublicKey, caveat macaroon.Caveat) error
+
+	// ChangeSessionType changes the type of the session with the given
+	// local public key.
+	ChangeSessionType(key *btcec.PublicKey, typ Type) error
+
+	// MarkPendingApproval marks the session with the given local public
+	// key as waiting for approval if it still requires approval. False is
+	// returned if it doesn't.
+	MarkPendingApproval(key *btcec.PublicKey) (bool, error)
+
+	// ApproveSession approves the session with the given local public key
+	// that is waiting for approval. ErrStateMismatch is returned if the
+	// session isn't waiting for approval.
+	ApproveSession(key *btcec.PublicKey) error
+
+	// ResetSession puts the session with the given local public key that
+	// wasn't fully paired yet back into the created state.
+	// ErrStateMismatch is returned if the session is in use or isn't
+	// usable anymore.
+	ResetSession(key *btcec.PublicKey) error
+
+	// SuspendSession marks the session with the given local public key as
+	// suspended until its next access window opens. ErrStateMismatch is
+	// returned if the session isn't usable anymore.
+	SuspendSession(key *btcec.PublicKey) error
+
+	// UpdateSessionMailbox moves the session with the given local public
+	// key to the mailbox server with the given address.
+	UpdateSessionMailbox(key *btcec.PublicKey, serverAddr string,
+		devServer bool) error
+
+	// AddAuditEvent appends an event of the given type for the session with
+	// the given local public key to the audit log.
+	AddAuditEvent(*btcec.PublicKey, AuditEventType) error
+
+	// ListAuditEvents returns all events of the audit log that are selected
+	// by the given filter, in the order they were recorded.
+	ListAuditEvents(*AuditEventFilter) ([]*AuditEvent, error)
 }
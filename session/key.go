@@ -0,0 +1,53 @@
+package session
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+const (
+	// MinKeySeedLen is the minimum length in bytes of a seed a session's
+	// local key is derived from.
+	MinKeySeedLen = 32
+)
+
+var (
+	// keyDerivationTag is prepended to the seed before it is hashed, so
+	// the derived key is specific to LiT sessions.
+	keyDerivationTag = []byte("lit-session-local-key")
+)
+
+// DeriveLocalKey deterministically derives the local private key of a session
+// from the given seed. The same seed always results in the same key, which
+// allows a session to be recreated with the same local public key.
+//
+// NOTE: Anyone who knows the seed can derive the session's private key and
+// therefore impersonate LiT's side of the session's mailbox connection. The
+// seed must have at least MinKeySeedLen bytes of entropy, be kept as secret as
+// the key itself and must never be shared between sessions that are meant to
+// be independent.
+func DeriveLocalKey(seed []byte) (*btcec.PrivateKey, error) {
+	if len(seed) < MinKeySeedLen {
+		return nil, fmt.Errorf("seed must be at least %d bytes, got %d",
+			MinKeySeedLen, len(seed))
+	}
+
+	hash := sha256.New()
+	_, _ = hash.Write(keyDerivationTag)
+	_, _ = hash.Write(seed)
+	keyBytes := hash.Sum(nil)
+
+	// The hash is practically never outside the valid range of private
+	// keys, but we don't want to silently use a reduced key if it is.
+	scalar := new(big.Int).SetBytes(keyBytes)
+	if scalar.Sign() == 0 || scalar.Cmp(btcec.S256().N) >= 0 {
+		return nil, fmt.Errorf("seed results in an invalid private key")
+	}
+
+	privateKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), keyBytes)
+
+	return privateKey, nil
+}
@@ -0,0 +1,38 @@
+package session
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeriveLocalKey makes sure that the same seed always results in the same
+// key while different seeds result in different keys and that short seeds are
+// rejected.
+func TestDeriveLocalKey(t *testing.T) {
+	seed := bytes.Repeat([]byte{0x01}, MinKeySeedLen)
+	otherSeed := bytes.Repeat([]byte{0x02}, MinKeySeedLen)
+
+	key1, err := DeriveLocalKey(seed)
+	require.NoError(t, err)
+	key2, err := DeriveLocalKey(seed)
+	require.NoError(t, err)
+	require.True(t, key1.PubKey().IsEqual(key2.PubKey()))
+
+	otherKey, err := DeriveLocalKey(otherSeed)
+	require.NoError(t, err)
+	require.False(t, key1.PubKey().IsEqual(otherKey.PubKey()))
+
+	_, err = DeriveLocalKey(seed[:MinKeySeedLen-1])
+	require.Error(t, err)
+
+	// The derived key is used as the session's local key.
+	session, err := NewSessionWithKey(
+		key1, "derived", TypeMacaroonCustom, time.Now().Add(time.Hour),
+		"foo.bar.baz:1234", false, nil, nil,
+	)
+	require.NoError(t, err)
+	require.True(t, session.LocalPublicKey.IsEqual(key1.PubKey()))
+}
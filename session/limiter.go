@@ -0,0 +1,78 @@
+package session
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// streamLimiter limits the number of requests that are handled at the same
+// time over the connection of a single session. Requests beyond the limit are
+// rejected right away instead of being queued, so a single remote can't tie up
+// the node with many concurrent streams.
+type streamLimiter struct {
+	slots chan struct{}
+}
+
+// newStreamLimiter creates a limiter that allows up to the given number of
+// requests at the same time.
+func newStreamLimiter(maxStreams uint32) *streamLimiter {
+	return &streamLimiter{
+		slots: make(chan struct{}, maxStreams),
+	}
+}
+
+// acquire takes a slot for a new request. A ResourceExhausted error is returned
+// if all slots are taken.
+func (l *streamLimiter) acquire() error {
+	select {
+	case l.slots <- struct{}{}:
+		return nil
+
+	default:
+		return status.Errorf(codes.ResourceExhausted, "session allows "+
+			"at most %d concurrent requests", cap(l.slots))
+	}
+}
+
+// release frees the slot of a finished request.
+func (l *streamLimiter) release() {
+	<-l.slots
+}
+
+// unaryInterceptor rejects unary calls that exceed the limit.
+func (l *streamLimiter) unaryInterceptor(ctx context.Context, req interface{},
+	_ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{},
+	error) {
+
+	if err := l.acquire(); err != nil {
+		return nil, err
+	}
+	defer l.release()
+
+	return handler(ctx, req)
+}
+
+// streamInterceptor rejects streams that exceed the limit. A stream holds its
+// slot until it ends.
+func (l *streamLimiter) streamInterceptor(srv interface{},
+	ss grpc.ServerStream, _ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	if err := l.acquire(); err != nil {
+		return err
+	}
+	defer l.release()
+
+	return handler(srv, ss)
+}
+
+// serverOptions returns the options that enforce the limit on a gRPC server.
+func (l *streamLimiter) serverOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(l.unaryInterceptor),
+		grpc.ChainStreamInterceptor(l.streamInterceptor),
+	}
+}
@@ -0,0 +1,72 @@
+package session
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// TestStreamLimiter makes sure that a gRPC server with a stream limiter rejects
+// requests while all slots are held by open streams and accepts them again
+// once a stream ended.
+func TestStreamLimiter(t *testing.T) {
+	limiter := newStreamLimiter(1)
+	server := grpc.NewServer(limiter.serverOptions()...)
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	ctx := context.Background()
+	conn, err := grpc.DialContext(
+		ctx, "bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(
+			func(context.Context, string) (net.Conn, error) {
+				return listener.Dial()
+			},
+		),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	check := func() error {
+		_, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		return err
+	}
+	require.NoError(t, check())
+
+	// A watch stream stays open, so it holds the only slot.
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	watch, err := client.Watch(
+		watchCtx, &grpc_health_v1.HealthCheckRequest{},
+	)
+	require.NoError(t, err)
+	_, err = watch.Recv()
+	require.NoError(t, err)
+
+	err = check()
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Contains(t, err.Error(), "at most 1 concurrent requests")
+
+	// Once the stream is closed, its slot is free again.
+	cancel()
+	require.Eventually(t, func() bool {
+		return check() == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
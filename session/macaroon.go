@@ -5,14 +5,22 @@ import (
 	"context"
 	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"strconv"
 
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"google.golang.org/protobuf/proto"
 	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
 	"gopkg.in/macaroon.v2"
 )
 
+const (
+	// macaroonIPLockCondition is the name of the caveat lnd uses to lock a
+	// macaroon to an IP address.
+	macaroonIPLockCondition = "ipaddr"
+)
+
 var (
 	// SuperMacaroonRootKeyPrefix is the prefix we set on a super macaroon's
 	// root key to clearly mark it as such.
@@ -56,13 +64,7 @@ func IsSuperMacaroon(macHex string) bool {
 		return false
 	}
 
-	rawID := mac.Id()
-	if rawID[0] != byte(bakery.LatestVersion) {
-		return false
-	}
-	decodedID := &lnrpc.MacaroonId{}
-	idProto := rawID[1:]
-	err = proto.Unmarshal(idProto, decodedID)
+	decodedID, err := decodeMacaroonID(mac)
 	if err != nil {
 		return false
 	}
@@ -73,13 +75,92 @@ func IsSuperMacaroon(macHex string) bool {
 		return false
 	}
 
-	return isSuperMacaroonRootKeyID(rootKeyID)
+	return IsSuperMacaroonRootKeyID(rootKeyID)
+}
+
+// decodeMacaroonID decodes the ID of a macaroon baked by lnd, which contains
+// the macaroon's root key ID and permissions.
+func decodeMacaroonID(mac *macaroon.Macaroon) (*lnrpc.MacaroonId, error) {
+	rawID := mac.Id()
+	if len(rawID) == 0 || rawID[0] != byte(bakery.LatestVersion) {
+		return nil, fmt.Errorf("unsupported macaroon ID version")
+	}
+
+	decodedID := &lnrpc.MacaroonId{}
+	if err := proto.Unmarshal(rawID[1:], decodedID); err != nil {
+		return nil, fmt.Errorf("invalid macaroon ID: %v", err)
+	}
+
+	return decodedID, nil
+}
+
+// RecipeFromMacaroon returns the recipe that bakes a macaroon with the same
+// permissions and caveats as the given macaroon baked by lnd. Only first party
+// caveats that restrict the macaroon's lifetime or IP address are supported,
+// any other caveat results in an error so an imported macaroon is never less
+// restricted than the original one.
+func RecipeFromMacaroon(mac *macaroon.Macaroon) (*MacaroonRecipe, error) {
+	decodedID, err := decodeMacaroonID(mac)
+	if err != nil {
+		return nil, err
+	}
+
+	recipe := &MacaroonRecipe{}
+	for _, op := range decodedID.Ops {
+		for _, action := range op.Actions {
+			recipe.Permissions = append(
+				recipe.Permissions, bakery.Op{
+					Entity: op.Entity,
+					Action: action,
+				},
+			)
+		}
+	}
+
+	if len(recipe.Permissions) == 0 {
+		return nil, fmt.Errorf("macaroon doesn't grant any permissions")
+	}
+
+	for _, caveat := range mac.Caveats() {
+		if len(caveat.VerificationId) != 0 {
+			return nil, fmt.Errorf("unsupported third party "+
+				"caveat %q", caveat.Id)
+		}
+
+		err := validateImportedCaveat(string(caveat.Id))
+		if err != nil {
+			return nil, err
+		}
+
+		recipe.Caveats = append(recipe.Caveats, macaroon.Caveat{
+			Id: caveat.Id,
+		})
+	}
+
+	return recipe, nil
+}
+
+// validateImportedCaveat makes sure the given first party caveat condition is
+// one that is understood when importing a macaroon.
+func validateImportedCaveat(condition string) error {
+	name, _, err := checkers.ParseCaveat(condition)
+	if err != nil {
+		return fmt.Errorf("invalid caveat %q: %v", condition, err)
+	}
+
+	switch name {
+	case checkers.CondTimeBefore, macaroonIPLockCondition:
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported caveat %q", condition)
+	}
 }
 
-// isSuperMacaroonRootKeyID returns true if the given macaroon root key ID (also
+// IsSuperMacaroonRootKeyID returns true if the given macaroon root key ID (also
 // known as storage ID) is a super macaroon, which can be identified by its
 // first 4 bytes.
-func isSuperMacaroonRootKeyID(rootKeyID uint64) bool {
+func IsSuperMacaroonRootKeyID(rootKeyID uint64) bool {
 	rootKeyBytes := make([]byte, 8)
 	binary.BigEndian.PutUint64(rootKeyBytes, rootKeyID)
 	return bytes.HasPrefix(rootKeyBytes, SuperMacaroonRootKeyPrefix[:])
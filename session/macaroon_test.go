@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
 )
 
 var (
@@ -28,10 +30,92 @@ var (
 func TestSuperMacaroonRootKeyID(t *testing.T) {
 	someBytes := [4]byte{02, 03, 44, 88}
 	rootKeyID := NewSuperMacaroonRootKeyID(someBytes)
-	require.True(t, isSuperMacaroonRootKeyID(rootKeyID))
-	require.False(t, isSuperMacaroonRootKeyID(123))
+	require.True(t, IsSuperMacaroonRootKeyID(rootKeyID))
+	require.False(t, IsSuperMacaroonRootKeyID(123))
 }
 
 func TestIsSuperMacaroon(t *testing.T) {
 	require.True(t, IsSuperMacaroon(testMacHex))
 }
+
+// TestRecipeFromMacaroon makes sure that the recipe derived from a macaroon has
+// the macaroon's permissions and caveats and that unknown caveats are rejected.
+func TestRecipeFromMacaroon(t *testing.T) {
+	parseTestMac := func() *macaroon.Macaroon {
+		mac, err := ParseMacaroon(testMacHex)
+		require.NoError(t, err)
+
+		return mac
+	}
+
+	recipe, err := RecipeFromMacaroon(parseTestMac())
+	require.NoError(t, err)
+	require.Empty(t, recipe.Caveats)
+
+	expectedPerms := []bakery.Op{
+		{Entity: "account", Action: "read"},
+		{Entity: "auction", Action: "read"},
+		{Entity: "audit", Action: "read"},
+		{Entity: "auth", Action: "read"},
+		{Entity: "info", Action: "read"},
+		{Entity: "insights", Action: "read"},
+		{Entity: "invoices", Action: "read"},
+		{Entity: "loop", Action: "in"},
+		{Entity: "loop", Action: "out"},
+		{Entity: "macaroon", Action: "read"},
+		{Entity: "message", Action: "read"},
+		{Entity: "offchain", Action: "read"},
+		{Entity: "onchain", Action: "read"},
+		{Entity: "order", Action: "read"},
+		{Entity: "peers", Action: "read"},
+		{Entity: "rates", Action: "read"},
+		{Entity: "recommendation", Action: "read"},
+		{Entity: "report", Action: "read"},
+		{Entity: "suggestions", Action: "read"},
+		{Entity: "swap", Action: "read"},
+		{Entity: "terms", Action: "read"},
+	}
+	require.Equal(t, expectedPerms, recipe.Permissions)
+
+	// Known first party caveats are carried over.
+	mac := parseTestMac()
+	known := []string{
+		"time-before 2030-01-01T00:00:00Z",
+		"ipaddr 127.0.0.1",
+	}
+	for _, condition := range known {
+		require.NoError(t, mac.AddFirstPartyCaveat([]byte(condition)))
+	}
+
+	recipe, err = RecipeFromMacaroon(mac)
+	require.NoError(t, err)
+	require.Equal(t, expectedPerms, recipe.Permissions)
+	require.Len(t, recipe.Caveats, len(known))
+	for i, condition := range known {
+		require.Equal(t, condition, string(recipe.Caveats[i].Id))
+	}
+
+	// Caveats we don't understand are rejected.
+	unknown := []string{
+		"declared username bob",
+		"lnd-custom unknown-app value",
+		"lnd-custom account 0011223344556677",
+		"lnd-custom peer 0011223344556677",
+	}
+	for _, condition := range unknown {
+		mac := parseTestMac()
+		require.NoError(t, mac.AddFirstPartyCaveat([]byte(condition)))
+
+		_, err := RecipeFromMacaroon(mac)
+		require.Error(t, err, condition)
+	}
+
+	mac = parseTestMac()
+	err = mac.AddThirdPartyCaveat(
+		[]byte("caveat key"), []byte("third party"), "remote",
+	)
+	require.NoError(t, err)
+	_, err = RecipeFromMacaroon(mac)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "third party")
+}
@@ -0,0 +1,69 @@
+package session
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync/atomic"
+)
+
+// RedactionMode determines how secrets are shown in log messages.
+type RedactionMode uint32
+
+const (
+	// RedactFingerprint replaces a secret with a short fingerprint of it.
+	// The fingerprint is stable, so the same secret can be recognized
+	// across log messages without being revealed.
+	RedactFingerprint RedactionMode = 0
+
+	// RedactFull replaces a secret with a fixed placeholder.
+	RedactFull RedactionMode = 1
+
+	// fingerprintLen is the number of bytes of the secret's hash that
+	// make up its fingerprint.
+	fingerprintLen = 8
+
+	// redactedPlaceholder replaces a secret that is fully redacted.
+	redactedPlaceholder = "<redacted>"
+)
+
+// redactionMode is the RedactionMode used for all secrets. It is accessed
+// atomically.
+var redactionMode uint32
+
+// SetRedactionMode sets how secrets are shown in log messages.
+func SetRedactionMode(mode RedactionMode) {
+	atomic.StoreUint32(&redactionMode, uint32(mode))
+}
+
+// Fingerprint returns a stable fingerprint of the given secret that doesn't
+// reveal the secret itself.
+func Fingerprint(secret []byte) string {
+	hash := sha256.Sum256(secret)
+	return "sha256:" + hex.EncodeToString(hash[:fingerprintLen])
+}
+
+// Secret is secret material like a pairing secret, a password or a macaroon
+// that is never formatted as is. Whatever verb it is formatted with, only its
+// redacted form is printed, so it can safely be passed to a logger.
+type Secret []byte
+
+// Redact wraps the given secret so it is redacted when formatted.
+func Redact(secret []byte) Secret {
+	return Secret(secret)
+}
+
+// String returns the redacted form of the secret.
+func (s Secret) String() string {
+	if RedactionMode(atomic.LoadUint32(&redactionMode)) == RedactFull {
+		return redactedPlaceholder
+	}
+
+	return Fingerprint(s)
+}
+
+// Format implements fmt.Formatter, so verbs like %x or %s don't print the raw
+// secret either.
+func (s Secret) Format(f fmt.State, _ rune) {
+	_, _ = f.Write([]byte(s.String()))
+}
@@ -0,0 +1,40 @@
+package session
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btclog"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRedact makes sure that a redacted secret never shows up in a log message,
+// whatever verb it is formatted with, while its fingerprint is stable.
+func TestRedact(t *testing.T) {
+	var buf bytes.Buffer
+	logger := btclog.NewBackend(&buf).Logger("TEST")
+	logger.SetLevel(btclog.LevelTrace)
+
+	secret := []byte("super secret pairing phrase")
+	fingerprint := Fingerprint(secret)
+	require.Equal(t, fingerprint, Fingerprint(secret))
+	require.NotEqual(t, fingerprint, Fingerprint([]byte("other")))
+
+	for _, verb := range []string{"%v", "%s", "%x", "%X", "%q", "%+v"} {
+		logger.Infof("secret: "+verb, Redact(secret))
+	}
+
+	logged := buf.String()
+	require.NotContains(t, logged, string(secret))
+	require.NotContains(t, logged, fmt.Sprintf("%x", secret))
+	require.Equal(t, 6, bytes.Count(buf.Bytes(), []byte(fingerprint)))
+
+	SetRedactionMode(RedactFull)
+	defer SetRedactionMode(RedactFingerprint)
+
+	buf.Reset()
+	logger.Infof("secret: %x", Redact(secret))
+	require.NotContains(t, buf.String(), fingerprint)
+	require.Contains(t, buf.String(), redactedPlaceholder)
+}
@@ -0,0 +1,222 @@
+package session
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// maxScheduleLookahead is how far into the future the next change of
+	// an access schedule is looked for. Windows repeat weekly, so eight
+	// days always include the next change if there is one.
+	maxScheduleLookahead = 8
+)
+
+// AccessWindow is a time range on a day of the week during which a session
+// with an access schedule can be used. The window repeats every week.
+type AccessWindow struct {
+	// Weekday is the day of the week of the window.
+	Weekday time.Weekday
+
+	// Start is the wall clock time since midnight at which the window
+	// opens.
+	Start time.Duration
+
+	// End is the wall clock time since midnight at which the window
+	// closes. The window is open up to but excluding the end.
+	End time.Duration
+}
+
+// AccessSchedule restricts the use of a session to a set of weekly recurring
+// access windows in a time zone.
+type AccessSchedule struct {
+	// Timezone is the IANA name of the time zone of the windows. UTC is
+	// used if it is empty.
+	Timezone string
+
+	// Windows are the access windows of the schedule.
+	Windows []AccessWindow
+
+	// loc is the loaded location of Timezone.
+	loc *time.Location
+}
+
+// NewAccessSchedule creates a new access schedule with the given windows in
+// the time zone with the given IANA name. An error is returned if the time zone
+// is unknown or a window is invalid.
+func NewAccessSchedule(timezone string, windows []AccessWindow) (
+	*AccessSchedule, error) {
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q: %v", timezone,
+			err)
+	}
+
+	if len(windows) == 0 {
+		return nil, fmt.Errorf("an access schedule needs at least one " +
+			"window")
+	}
+
+	for _, window := range windows {
+		switch {
+		case window.Weekday < time.Sunday ||
+			window.Weekday > time.Saturday:
+
+			return nil, fmt.Errorf("invalid weekday %d",
+				window.Weekday)
+
+		case window.Start < 0 || window.End > 24*time.Hour:
+			return nil, fmt.Errorf("access window must be within " +
+				"a single day")
+
+		case window.Start >= window.End:
+			return nil, fmt.Errorf("access window must end after " +
+				"it starts")
+		}
+	}
+
+	return &AccessSchedule{
+		Timezone: timezone,
+		Windows:  windows,
+		loc:      loc,
+	}, nil
+}
+
+// Allows returns true if the given time is inside one of the schedule's access
+// windows.
+func (s *AccessSchedule) Allows(t time.Time) bool {
+	local := t.In(s.loc)
+	hour, min, sec := local.Clock()
+	clock := time.Duration(hour)*time.Hour +
+		time.Duration(min)*time.Minute + time.Duration(sec)*time.Second
+
+	for _, window := range s.Windows {
+		if window.Weekday == local.Weekday() &&
+			clock >= window.Start && clock < window.End {
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextChange returns the first time after the given one at which the schedule
+// switches from allowing access to not allowing it or the other way around. The
+// zero time is returned if it never changes.
+func (s *AccessSchedule) NextChange(t time.Time) time.Time {
+	local := t.In(s.loc)
+	year, month, day := local.Date()
+
+	var boundaries []time.Time
+	for offset := 0; offset <= maxScheduleLookahead; offset++ {
+		date := time.Date(year, month, day+offset, 0, 0, 0, 0, s.loc)
+		for _, window := range s.Windows {
+			if window.Weekday != date.Weekday() {
+				continue
+			}
+
+			for _, clock := range []time.Duration{
+				window.Start, window.End,
+			} {
+				boundary := time.Date(
+					year, month, day+offset, 0, 0,
+					int(clock/time.Second), 0, s.loc,
+				)
+				if boundary.After(t) {
+					boundaries = append(
+						boundaries, boundary,
+					)
+				}
+			}
+		}
+	}
+
+	sort.Slice(boundaries, func(i, j int) bool {
+		return boundaries[i].Before(boundaries[j])
+	})
+
+	allowed := s.Allows(t)
+	for _, boundary := range boundaries {
+		if s.Allows(boundary) != allowed {
+			return boundary
+		}
+	}
+
+	return time.Time{}
+}
+
+// serializeAccessSchedule encodes the given schedule as its time zone followed
+// by its windows.
+func serializeAccessSchedule(s *AccessSchedule) ([]byte, error) {
+	var (
+		b   bytes.Buffer
+		buf [8]byte
+	)
+	err := tlv.WriteVarInt(&b, uint64(len(s.Timezone)), &buf)
+	if err != nil {
+		return nil, err
+	}
+	b.WriteString(s.Timezone)
+
+	for _, window := range s.Windows {
+		b.WriteByte(byte(window.Weekday))
+
+		var clock [8]byte
+		binary.BigEndian.PutUint32(
+			clock[:4], uint32(window.Start/time.Second),
+		)
+		binary.BigEndian.PutUint32(
+			clock[4:], uint32(window.End/time.Second),
+		)
+		b.Write(clock[:])
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeAccessSchedule decodes a schedule that was encoded with
+// serializeAccessSchedule.
+func deserializeAccessSchedule(raw []byte) (*AccessSchedule, error) {
+	var buf [8]byte
+	r := bytes.NewReader(raw)
+
+	tzLen, err := tlv.ReadVarInt(r, &buf)
+	if err != nil {
+		return nil, err
+	}
+	if tzLen > uint64(r.Len()) {
+		return nil, fmt.Errorf("time zone length %d exceeds schedule "+
+			"length", tzLen)
+	}
+
+	timezone := make([]byte, tzLen)
+	if _, err := io.ReadFull(r, timezone); err != nil {
+		return nil, err
+	}
+
+	var windows []AccessWindow
+	for r.Len() > 0 {
+		var window [9]byte
+		if _, err := io.ReadFull(r, window[:]); err != nil {
+			return nil, err
+		}
+
+		start := binary.BigEndian.Uint32(window[1:5])
+		end := binary.BigEndian.Uint32(window[5:])
+		windows = append(windows, AccessWindow{
+			Weekday: time.Weekday(window[0]),
+			Start:   time.Duration(start) * time.Second,
+			End:     time.Duration(end) * time.Second,
+		})
+	}
+
+	return NewAccessSchedule(string(timezone), windows)
+}
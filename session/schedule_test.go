@@ -0,0 +1,142 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccessSchedule makes sure that an access schedule only allows access
+// inside its windows and reports when that changes next.
+func TestAccessSchedule(t *testing.T) {
+	// Business hours on weekdays, plus a window on Friday evening that
+	// directly follows the regular one.
+	var windows []AccessWindow
+	for day := time.Monday; day <= time.Friday; day++ {
+		windows = append(windows, AccessWindow{
+			Weekday: day,
+			Start:   9 * time.Hour,
+			End:     17 * time.Hour,
+		})
+	}
+	windows = append(windows, AccessWindow{
+		Weekday: time.Friday,
+		Start:   17 * time.Hour,
+		End:     24 * time.Hour,
+	})
+
+	schedule, err := NewAccessSchedule("UTC", windows)
+	require.NoError(t, err)
+
+	// 2024-01-01 is a Monday.
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2024, 1, day, hour, min, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name    string
+		now     time.Time
+		allowed bool
+		next    time.Time
+	}{{
+		name:    "before the window",
+		now:     at(1, 8, 59),
+		allowed: false,
+		next:    at(1, 9, 0),
+	}, {
+		name:    "window opens",
+		now:     at(1, 9, 0),
+		allowed: true,
+		next:    at(1, 17, 0),
+	}, {
+		name:    "window closes",
+		now:     at(1, 17, 0),
+		allowed: false,
+		next:    at(2, 9, 0),
+	}, {
+		name:    "adjacent windows",
+		now:     at(5, 12, 0),
+		allowed: true,
+		next:    at(6, 0, 0),
+	}, {
+		name:    "weekend",
+		now:     at(6, 12, 0),
+		allowed: false,
+		next:    at(8, 9, 0),
+	}}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.allowed, schedule.Allows(test.now))
+			require.True(
+				t, test.next.Equal(schedule.NextChange(test.now)),
+			)
+		})
+	}
+
+	// A window that covers the whole week never changes.
+	var always []AccessWindow
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		always = append(always, AccessWindow{
+			Weekday: day,
+			End:     24 * time.Hour,
+		})
+	}
+	schedule, err = NewAccessSchedule("", always)
+	require.NoError(t, err)
+	require.True(t, schedule.Allows(at(3, 3, 3)))
+	require.True(t, schedule.NextChange(at(3, 3, 3)).IsZero())
+}
+
+// TestAccessScheduleValidation makes sure that invalid schedules are rejected.
+func TestAccessScheduleValidation(t *testing.T) {
+	window := AccessWindow{
+		Weekday: time.Monday,
+		Start:   9 * time.Hour,
+		End:     17 * time.Hour,
+	}
+
+	_, err := NewAccessSchedule("UTC", nil)
+	require.Error(t, err)
+
+	_, err = NewAccessSchedule("Nowhere/Atlantis", []AccessWindow{window})
+	require.Error(t, err)
+
+	invalid := []AccessWindow{
+		{Weekday: 7, End: time.Hour},
+		{Weekday: time.Monday, Start: 2 * time.Hour, End: time.Hour},
+		{Weekday: time.Monday, End: 25 * time.Hour},
+	}
+	for _, window := range invalid {
+		_, err = NewAccessSchedule("UTC", []AccessWindow{window})
+		require.Error(t, err)
+	}
+}
+
+// TestAccessScheduleSerialization makes sure that a schedule is the same after
+// it was serialized and deserialized again.
+func TestAccessScheduleSerialization(t *testing.T) {
+	schedule, err := NewAccessSchedule("UTC", []AccessWindow{{
+		Weekday: time.Tuesday,
+		Start:   8*time.Hour + 30*time.Minute,
+		End:     12 * time.Hour,
+	}, {
+		Weekday: time.Saturday,
+		Start:   0,
+		End:     24 * time.Hour,
+	}})
+	require.NoError(t, err)
+
+	raw, err := serializeAccessSchedule(schedule)
+	require.NoError(t, err)
+
+	decoded, err := deserializeAccessSchedule(raw)
+	require.NoError(t, err)
+	require.Equal(t, schedule.Timezone, decoded.Timezone)
+	require.Equal(t, schedule.Windows, decoded.Windows)
+
+	_, err = deserializeAccessSchedule(raw[:len(raw)-1])
+	require.Error(t, err)
+}
@@ -0,0 +1,278 @@
+package session
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/tlv"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	// EncryptionKeySize is the size of the key the secrets of sessions are
+	// encrypted with.
+	EncryptionKeySize = 32
+)
+
+var (
+	// ErrWrongEncryptionKey is returned if the secrets of a stored session
+	// can't be decrypted with the configured encryption key.
+	ErrWrongEncryptionKey = errors.New("session secrets can't be " +
+		"decrypted with the configured encryption key")
+
+	// ErrEncryptionKeyRequired is returned if the secrets of a stored
+	// session are encrypted but no encryption key is configured.
+	ErrEncryptionKeyRequired = errors.New("session secrets are " +
+		"encrypted but no encryption key is configured")
+)
+
+// secretsCipher encrypts the secrets of sessions, which are their pairing
+// secret, local private key and macaroon root key, before they are stored. The
+// secrets are authenticated together with the local public key the session is
+// stored under, so they can't be moved to a different session.
+type secretsCipher struct {
+	aead cipher.AEAD
+}
+
+// newSecretsCipher creates a cipher that encrypts session secrets with the
+// given key.
+func newSecretsCipher(key []byte) (*secretsCipher, error) {
+	if len(key) != EncryptionKeySize {
+		return nil, fmt.Errorf("encryption key must be %d bytes, got "+
+			"%d", EncryptionKeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretsCipher{
+		aead: aead,
+	}, nil
+}
+
+// seal encrypts the secrets of the given session. The returned data starts
+// with the random nonce it was encrypted with.
+func (c *secretsCipher) seal(session *Session) ([]byte, error) {
+	var (
+		pairingSecret = session.PairingSecret[:]
+		privateKey    = session.LocalPrivateKey.Serialize()
+		plaintext     bytes.Buffer
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(
+			typeMacaroonRootKey, &session.MacaroonRootKey,
+		),
+		tlv.MakePrimitiveRecord(typePairingSecret, &pairingSecret),
+		tlv.MakePrimitiveRecord(typeLocalPrivateKey, &privateKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := tlvStream.Encode(&plaintext); err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("error creating nonce: %v", err)
+	}
+
+	return c.aead.Seal(
+		nonce, nonce, plaintext.Bytes(), getSessionKey(session),
+	), nil
+}
+
+// open decrypts the sealed secrets of the session that is stored under the
+// given key and applies them to the session. ErrWrongEncryptionKey is returned
+// if the secrets weren't encrypted with the key of the cipher for that
+// session.
+func (c *secretsCipher) open(sessionKey, sealed []byte,
+	session *Session) error {
+
+	nonceSize := c.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return fmt.Errorf("sealed session secrets too short")
+	}
+
+	plaintext, err := c.aead.Open(
+		nil, sealed[:nonceSize], sealed[nonceSize:], sessionKey,
+	)
+	if err != nil {
+		return ErrWrongEncryptionKey
+	}
+
+	var (
+		rootKey                   uint64
+		pairingSecret, privateKey []byte
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeMacaroonRootKey, &rootKey),
+		tlv.MakePrimitiveRecord(typePairingSecret, &pairingSecret),
+		tlv.MakePrimitiveRecord(typeLocalPrivateKey, &privateKey),
+	)
+	if err != nil {
+		return err
+	}
+	if err := tlvStream.Decode(bytes.NewReader(plaintext)); err != nil {
+		return fmt.Errorf("error decoding session secrets: %v", err)
+	}
+
+	if len(pairingSecret) != len(session.PairingSecret) {
+		return fmt.Errorf("invalid pairing secret length %d, expected "+
+			"%d", len(pairingSecret), len(session.PairingSecret))
+	}
+	if len(privateKey) != btcec.PrivKeyBytesLen {
+		return fmt.Errorf("invalid private key length %d, expected %d",
+			len(privateKey), btcec.PrivKeyBytesLen)
+	}
+
+	privKey, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), privateKey)
+
+	session.MacaroonRootKey = rootKey
+	copy(session.PairingSecret[:], pairingSecret)
+	session.LocalPrivateKey = privKey
+	session.LocalPublicKey = pubKey
+
+	return nil
+}
+
+// encodeSession serializes the given session. Its secrets are encrypted if an
+// encryption key is configured.
+func (db *DB) encodeSession(w io.Writer, session *Session) error {
+	if db.secrets == nil {
+		return SerializeSession(w, session)
+	}
+
+	sealed, err := db.secrets.seal(session)
+	if err != nil {
+		return fmt.Errorf("error encrypting session secrets: %v", err)
+	}
+
+	return serializeSession(w, session, sealed)
+}
+
+// decodeSession deserializes the session that is stored under the given key
+// and decrypts its secrets if they are encrypted.
+func (db *DB) decodeSession(sessionKey, sessionBytes []byte) (*Session,
+	error) {
+
+	session, sealed, err := deserializeSession(
+		bytes.NewReader(sessionBytes),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sessions that were stored before an encryption key was configured
+	// still have plaintext secrets. They are encrypted once the session
+	// is stored again.
+	if sealed == nil {
+		return session, nil
+	}
+
+	if db.secrets == nil {
+		return nil, ErrEncryptionKeyRequired
+	}
+
+	if err := db.secrets.open(sessionKey, sealed, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// checkSecrets makes sure that the secrets of all stored sessions can be
+// decrypted, so a missing or wrong encryption key is detected right away
+// instead of on first use. If an encryption key is configured, the secrets of
+// sessions that are still stored in plaintext are encrypted. Records that
+// can't be deserialized at all are left for InvalidRecords to report.
+func (db *DB) checkSecrets() error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		var plaintext [][]byte
+		err = sessionBucket.ForEach(func(k, v []byte) error {
+			if v == nil {
+				return nil
+			}
+
+			session, sealed, err := deserializeSession(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return nil
+			}
+
+			// The secrets are authenticated together with the key
+			// the session is stored under, so only sessions that
+			// are stored under their own key can be encrypted.
+			if sealed == nil {
+				if validateRecord(k, session) == nil {
+					plaintext = append(
+						plaintext,
+						append([]byte(nil), k...),
+					)
+				}
+
+				return nil
+			}
+
+			// Only a missing or wrong key is fatal, any other
+			// problem only affects this record.
+			_, err = db.decodeSession(k, v)
+			if errors.Is(err, ErrWrongEncryptionKey) ||
+				errors.Is(err, ErrEncryptionKeyRequired) {
+
+				return err
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if db.secrets == nil {
+			return nil
+		}
+
+		// The bucket can't be modified while iterating over it, so the
+		// plaintext records are only encrypted afterwards. Their
+		// revision is left untouched since the session doesn't change.
+		for _, key := range plaintext {
+			session, err := db.decodeSession(
+				key, sessionBucket.Get(key),
+			)
+			if err != nil {
+				return err
+			}
+
+			var buf bytes.Buffer
+			if err := db.encodeSession(&buf, session); err != nil {
+				return err
+			}
+
+			if err := sessionBucket.Put(key, buf.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
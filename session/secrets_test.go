@@ -0,0 +1,137 @@
+package session
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+// rawSession returns the stored record of the given session.
+func rawSession(t *testing.T, db *DB, session *Session) []byte {
+	var raw []byte
+	err := db.View(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		raw = append(
+			[]byte(nil), sessionBucket.Get(getSessionKey(session))...,
+		)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	return raw
+}
+
+// requireSealed makes sure that the stored record of the given session doesn't
+// contain any of its secrets in plaintext.
+func requireSealed(t *testing.T, db *DB, session *Session) {
+	raw := rawSession(t, db, session)
+	require.NotEmpty(t, raw)
+	require.False(t, bytes.Contains(raw, session.PairingSecret[:]))
+	require.False(t, bytes.Contains(
+		raw, session.LocalPrivateKey.Serialize(),
+	))
+}
+
+// TestEncryptedSecrets makes sure that the secrets of sessions are encrypted
+// before they are stored if an encryption key is configured and are decrypted
+// transparently when the sessions are loaded.
+func TestEncryptedSecrets(t *testing.T) {
+	dir := t.TempDir()
+	key := bytes.Repeat([]byte{0x01}, EncryptionKeySize)
+
+	// A session that was stored before the key was configured has its
+	// secrets in plaintext.
+	db, err := NewDB(dir, DBFilename)
+	require.NoError(t, err)
+
+	plain := newTestSession(t, "plain")
+	plain.MacaroonRootKey = 1234
+	require.NoError(t, db.StoreSession(plain))
+	raw := rawSession(t, db, plain)
+	require.True(t, bytes.Contains(raw, plain.PairingSecret[:]))
+	require.NoError(t, db.Close())
+
+	// Once the key is configured, the secrets of new and existing
+	// sessions are encrypted.
+	db, err = NewEncryptedDB(dir, DBFilename, key)
+	require.NoError(t, err)
+	requireSealed(t, db, plain)
+
+	sealed := newTestSession(t, "sealed")
+	sealed.MacaroonRootKey = 5678
+	require.NoError(t, db.StoreSession(sealed))
+	requireSealed(t, db, sealed)
+
+	checkSecrets := func(db *DB, session *Session) {
+		stored, err := db.GetSession(session.LocalPublicKey)
+		require.NoError(t, err)
+		require.Equal(t, session.PairingSecret, stored.PairingSecret)
+		require.Equal(t, session.MacaroonRootKey, stored.MacaroonRootKey)
+		require.Equal(
+			t, session.LocalPrivateKey.Serialize(),
+			stored.LocalPrivateKey.Serialize(),
+		)
+		require.True(t, session.LocalPublicKey.IsEqual(
+			stored.LocalPublicKey,
+		))
+	}
+	checkSecrets(db, plain)
+	checkSecrets(db, sealed)
+
+	// Updates keep the secrets encrypted.
+	err = db.UpdateSessionDescription(sealed.LocalPublicKey, "updated")
+	require.NoError(t, err)
+	requireSealed(t, db, sealed)
+	checkSecrets(db, sealed)
+
+	sessions, err := db.ListSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+
+	invalid, err := db.InvalidRecords()
+	require.NoError(t, err)
+	require.Empty(t, invalid)
+	require.NoError(t, db.Close())
+
+	// The sessions can be loaded again with the same key.
+	db, err = NewEncryptedDB(dir, DBFilename, key)
+	require.NoError(t, err)
+	checkSecrets(db, plain)
+	checkSecrets(db, sealed)
+	require.NoError(t, db.Close())
+}
+
+// TestEncryptedSecretsWrongKey makes sure that a database whose session secrets
+// were encrypted can't be opened with a different key or without a key.
+func TestEncryptedSecretsWrongKey(t *testing.T) {
+	dir := t.TempDir()
+	key := bytes.Repeat([]byte{0x01}, EncryptionKeySize)
+
+	db, err := NewEncryptedDB(dir, DBFilename, key)
+	require.NoError(t, err)
+	require.NoError(t, db.StoreSession(newTestSession(t, "sealed")))
+	require.NoError(t, db.Close())
+
+	wrongKey := bytes.Repeat([]byte{0x02}, EncryptionKeySize)
+	_, err = NewEncryptedDB(dir, DBFilename, wrongKey)
+	require.ErrorIs(t, err, ErrWrongEncryptionKey)
+
+	_, err = NewDB(dir, DBFilename)
+	require.ErrorIs(t, err, ErrEncryptionKeyRequired)
+
+	// Keys of the wrong size are rejected.
+	_, err = NewEncryptedDB(dir, DBFilename, key[:16])
+	require.Error(t, err)
+
+	// The database is still usable with the right key.
+	db, err = NewEncryptedDB(dir, DBFilename, key)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+}
@@ -1,8 +1,11 @@
 package session
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
+	"io"
+	"net"
 	"sync"
 	"time"
 
@@ -18,8 +21,431 @@ type sessionID [33]byte
 
 type GRPCServerCreator func(opts ...grpc.ServerOption) *grpc.Server
 
+// AuthDataFunc creates the authentication data a session hands out to the
+// clients that connect to it. The given channel is closed once the session is
+// stopped, a function that blocks must return then.
+type AuthDataFunc func(quit <-chan struct{}) ([]byte, error)
+
+// ConnectionInfo describes the live mailbox connection of a running session.
+type ConnectionInfo struct {
+	// ConnectedSince is the time the most recent client completed its
+	// handshake. It is zero if no client connected yet.
+	ConnectedSince time.Time
+
+	// RemoteAddr is the address of the most recent client as seen on the
+	// mailbox connection.
+	RemoteAddr string
+
+	// Healthy is true if the session is currently serving clients over its
+	// connection to a mailbox server.
+	Healthy bool
+}
+
+// ConnectionEvent is an event on the mailbox connection of a running session.
+type ConnectionEvent uint8
+
+const (
+	// EventRemoteConnected is sent once a client completed the handshake.
+	EventRemoteConnected ConnectionEvent = iota
+
+	// EventRemoteDisconnected is sent once the connection of a client that
+	// completed the handshake was closed.
+	EventRemoteDisconnected
+
+	// EventStreamOpened is sent once a client started a call over its
+	// connection.
+	EventStreamOpened
+)
+
+// String returns a human readable name of the event.
+func (e ConnectionEvent) String() string {
+	switch e {
+	case EventRemoteConnected:
+		return "remote connected"
+
+	case EventRemoteDisconnected:
+		return "remote disconnected"
+
+	case EventStreamOpened:
+		return "stream opened"
+
+	default:
+		return fmt.Sprintf("unknown event %d", uint8(e))
+	}
+}
+
+const (
+	// connectionEventBuffer is the number of connection events of a
+	// session that are queued for a reader that falls behind. Events
+	// beyond that are dropped.
+	connectionEventBuffer = 32
+
+	// mailboxDialTimeout is the maximum time we wait for a connection to a
+	// single mailbox server before trying the next one.
+	mailboxDialTimeout = 10 * time.Second
+
+	// minMailboxBackoff is the time we wait before retrying all mailbox
+	// servers of a session after none of them could be reached.
+	minMailboxBackoff = time.Second
+
+	// maxMailboxBackoff is the maximum time we wait between two attempts
+	// to reach the mailbox servers of a session.
+	maxMailboxBackoff = time.Minute
+
+	// mailboxKeepaliveInterval is the default interval in which keepalive
+	// pings are sent on the connection to the mailbox server.
+	mailboxKeepaliveInterval = 2 * time.Minute
+)
+
+// authDataCreds are the transport credentials of a mailbox session. They
+// delegate to a noise connection that can be replaced, so the next handshake
+// hands out new authentication data without restarting the gRPC server.
+// Connections that are already established keep using the data they were
+// handshaked with.
+type authDataCreds struct {
+	ecdh     keychain.SingleKeyECDH
+	password []byte
+
+	// connected receives a signal each time a client completed the
+	// handshake. Signals aren't queued, so a reader that falls behind
+	// only learns that at least one client connected.
+	connected chan struct{}
+
+	// events receives every connection event of the session. Events are
+	// dropped if the buffer of the channel is full.
+	events chan ConnectionEvent
+
+	// lazyAuthData, if set, creates the authentication data once the first
+	// client starts its handshake. It is cleared once the data was
+	// created or replaced.
+	lazyAuthData AuthDataFunc
+
+	// lazyMtx makes sure only one handshake at a time creates the lazy
+	// authentication data. It is held without holding connMtx, so the
+	// creation can block without blocking the other handshakes.
+	lazyMtx sync.Mutex
+
+	// quit is closed once the session is stopped.
+	quit <-chan struct{}
+
+	// connectedSince and remoteAddr describe the most recent client that
+	// completed the handshake.
+	connectedSince time.Time
+	remoteAddr     string
+
+	// numClients is the number of clients that completed the handshake
+	// and whose connection is still open. The idle channel is closed while
+	// no client is connected.
+	numClients int
+	idle       chan struct{}
+
+	// draining is set once no new clients are accepted anymore.
+	draining bool
+
+	conn    *mailbox.NoiseGrpcConn
+	connMtx sync.Mutex
+}
+
+// newAuthDataCreds creates new transport credentials that hand out the given
+// authentication data.
+func newAuthDataCreds(ecdh keychain.SingleKeyECDH, password,
+	authData []byte) *authDataCreds {
+
+	c := &authDataCreds{
+		ecdh:      ecdh,
+		password:  password,
+		connected: make(chan struct{}, 1),
+		events:    make(chan ConnectionEvent, connectionEventBuffer),
+		idle:      make(chan struct{}),
+	}
+	close(c.idle)
+	c.setAuthData(authData)
+
+	return c
+}
+
+// setAuthData replaces the authentication data that is handed out during the
+// next handshake.
+func (c *authDataCreds) setAuthData(authData []byte) {
+	conn := mailbox.NewNoiseGrpcConn(c.ecdh, authData, c.password)
+
+	c.connMtx.Lock()
+	defer c.connMtx.Unlock()
+
+	c.conn = conn
+	c.lazyAuthData = nil
+}
+
+// createLazyAuthData creates the authentication data if it is created lazily
+// and doesn't exist yet. To only create the data once a client actually
+// connects, we wait for the first byte of the client's handshake. The returned
+// connection replays that byte, so it must be used for the handshake instead
+// of the given one.
+func (c *authDataCreds) createLazyAuthData(conn net.Conn) (net.Conn, error) {
+	c.connMtx.Lock()
+	lazy := c.lazyAuthData != nil
+	c.connMtx.Unlock()
+
+	if !lazy {
+		return conn, nil
+	}
+
+	proxyConn, ok := conn.(mailbox.ProxyConn)
+	if !ok {
+		return nil, fmt.Errorf("invalid connection type")
+	}
+
+	var peeked [1]byte
+	if _, err := io.ReadFull(proxyConn, peeked[:]); err != nil {
+		return nil, err
+	}
+
+	c.lazyMtx.Lock()
+	defer c.lazyMtx.Unlock()
+
+	// The data might have been created by a concurrent handshake in the
+	// meantime.
+	c.connMtx.Lock()
+	lazyAuthData := c.lazyAuthData
+	c.connMtx.Unlock()
+
+	if lazyAuthData != nil {
+		authData, err := lazyAuthData(c.quit)
+		if err != nil {
+			return nil, fmt.Errorf("error creating auth data: %v",
+				err)
+		}
+		noiseConn := mailbox.NewNoiseGrpcConn(
+			c.ecdh, authData, c.password,
+		)
+
+		// If the data was replaced while it was created, the
+		// replacement is kept.
+		c.connMtx.Lock()
+		if c.lazyAuthData != nil {
+			c.conn = noiseConn
+			c.lazyAuthData = nil
+		}
+		c.connMtx.Unlock()
+	}
+
+	return &peekedConn{
+		ProxyConn: proxyConn,
+		peeked:    peeked[:],
+	}, nil
+}
+
+// current returns the noise connection that is used for the next handshake.
+func (c *authDataCreds) current() *mailbox.NoiseGrpcConn {
+	c.connMtx.Lock()
+	defer c.connMtx.Unlock()
+
+	return c.conn
+}
+
+// ClientHandshake implements the client part of the connection handshake.
+//
+// NOTE: This is part of the credentials.TransportCredentials interface.
+func (c *authDataCreds) ClientHandshake(ctx context.Context, authority string,
+	conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+
+	return c.current().ClientHandshake(ctx, authority, conn)
+}
+
+// ServerHandshake implements the server part of the connection handshake.
+//
+// NOTE: This is part of the credentials.TransportCredentials interface.
+func (c *authDataCreds) ServerHandshake(conn net.Conn) (net.Conn,
+	credentials.AuthInfo, error) {
+
+	c.connMtx.Lock()
+	draining := c.draining
+	c.connMtx.Unlock()
+
+	if draining {
+		return nil, nil, fmt.Errorf("session is draining and doesn't " +
+			"accept new clients")
+	}
+
+	conn, err := c.createLazyAuthData(conn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	netConn, authInfo, err := c.current().ServerHandshake(conn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	netConn = c.clientConnected(netConn)
+
+	select {
+	case c.connected <- struct{}{}:
+	default:
+	}
+
+	return netConn, authInfo, nil
+}
+
+// clientConnected registers the connection of a client that completed the
+// handshake. The returned connection must be used instead of the given one, it
+// reports when it is closed.
+func (c *authDataCreds) clientConnected(conn net.Conn) net.Conn {
+	c.connMtx.Lock()
+	defer c.connMtx.Unlock()
+
+	c.connectedSince = time.Now()
+	if addr := conn.RemoteAddr(); addr != nil {
+		c.remoteAddr = addr.String()
+	}
+	if c.numClients == 0 {
+		c.idle = make(chan struct{})
+	}
+	c.numClients++
+	c.sendEvent(EventRemoteConnected)
+
+	return &clientConn{
+		Conn:         conn,
+		disconnected: c.clientDisconnected,
+	}
+}
+
+// clientDisconnected registers that the connection of a client was closed.
+func (c *authDataCreds) clientDisconnected() {
+	c.connMtx.Lock()
+	defer c.connMtx.Unlock()
+
+	c.numClients--
+	if c.numClients == 0 {
+		close(c.idle)
+	}
+	c.sendEvent(EventRemoteDisconnected)
+}
+
+// sendEvent queues the given connection event without blocking. The event is
+// dropped if no more events fit into the queue.
+func (c *authDataCreds) sendEvent(event ConnectionEvent) {
+	select {
+	case c.events <- event:
+	default:
+		log.Debugf("Dropping connection event %v", event)
+	}
+}
+
+// unaryInterceptor reports every unary call as an opened stream.
+func (c *authDataCreds) unaryInterceptor(ctx context.Context,
+	req interface{}, _ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+
+	c.sendEvent(EventStreamOpened)
+
+	return handler(ctx, req)
+}
+
+// streamInterceptor reports every stream that is opened.
+func (c *authDataCreds) streamInterceptor(srv interface{},
+	ss grpc.ServerStream, _ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	c.sendEvent(EventStreamOpened)
+
+	return handler(srv, ss)
+}
+
+// drain makes sure no new clients are accepted anymore. Clients that are
+// already connected are left untouched.
+func (c *authDataCreds) drain() {
+	c.connMtx.Lock()
+	defer c.connMtx.Unlock()
+
+	c.draining = true
+}
+
+// idleChan returns a channel that is closed once no client is connected.
+func (c *authDataCreds) idleChan() <-chan struct{} {
+	c.connMtx.Lock()
+	defer c.connMtx.Unlock()
+
+	return c.idle
+}
+
+// Info returns general information about the protocol that's being used.
+//
+// NOTE: This is part of the credentials.TransportCredentials interface.
+func (c *authDataCreds) Info() credentials.ProtocolInfo {
+	return c.current().Info()
+}
+
+// Clone makes a copy of the current credentials.
+//
+// NOTE: This is part of the credentials.TransportCredentials interface.
+func (c *authDataCreds) Clone() credentials.TransportCredentials {
+	return c.current().Clone()
+}
+
+// OverrideServerName overrides the server name used to verify the hostname.
+//
+// NOTE: This is part of the credentials.TransportCredentials interface.
+func (c *authDataCreds) OverrideServerName(name string) error {
+	return c.current().OverrideServerName(name)
+}
+
+// peekedConn is a mailbox connection that returns the bytes that were already
+// read from it before reading from the connection itself.
+type peekedConn struct {
+	mailbox.ProxyConn
+
+	peeked []byte
+}
+
+// Read reads the peeked bytes first and then from the underlying connection.
+//
+// NOTE: This is part of the net.Conn interface.
+func (c *peekedConn) Read(b []byte) (int, error) {
+	if len(c.peeked) > 0 {
+		n := copy(b, c.peeked)
+		c.peeked = c.peeked[n:]
+
+		return n, nil
+	}
+
+	return c.ProxyConn.Read(b)
+}
+
+// clientConn is the connection of a client that completed the handshake. It
+// reports when it is closed, so the number of connected clients can be
+// tracked.
+type clientConn struct {
+	net.Conn
+
+	disconnected func()
+	closeOnce    sync.Once
+}
+
+// Close closes the underlying connection and reports the disconnect once.
+//
+// NOTE: This is part of the net.Conn interface.
+func (c *clientConn) Close() error {
+	err := c.Conn.Close()
+	c.closeOnce.Do(c.disconnected)
+
+	return err
+}
+
 type mailboxSession struct {
 	server *grpc.Server
+	creds  *authDataCreds
+
+	// connCfg holds the parameters of the connection to the mailbox
+	// server. It is set once the session is started.
+	connCfg *mailboxConnConfig
+
+	// activeAddr is the address of the mailbox server the session is
+	// connected to and serving is true while clients are served over that
+	// connection. Both are guarded by activeAddrMtx.
+	activeAddr    string
+	serving       bool
+	activeAddrMtx sync.Mutex
 
 	wg   sync.WaitGroup
 	quit chan struct{}
@@ -31,50 +457,280 @@ func newMailboxSession() *mailboxSession {
 	}
 }
 
+// start starts the mailbox connection of the given session in the background.
+// The returned channel receives the outcome of the search for a reachable
+// mailbox server. If the given timeout is non-zero, the search is given up once
+// it elapsed.
 func (m *mailboxSession) start(session *Session,
-	serverCreator GRPCServerCreator, authData []byte) error {
+	serverCreator GRPCServerCreator, authData []byte,
+	lazyAuthData AuthDataFunc, timeout time.Duration) <-chan error {
 
-	tlsConfig := &tls.Config{}
-	if session.DevServer {
-		tlsConfig = &tls.Config{InsecureSkipVerify: true}
+	m.connCfg = newMailboxConnConfig(session)
+
+	ecdh := &keychain.PrivKeyECDH{PrivKey: session.LocalPrivateKey}
+	m.creds = newAuthDataCreds(ecdh, session.PairingSecret[:], authData)
+	m.creds.lazyAuthData = lazyAuthData
+	m.creds.quit = m.quit
+
+	opts := []grpc.ServerOption{grpc.Creds(m.creds)}
+	if session.MaxConcurrentStreams != 0 {
+		limiter := newStreamLimiter(session.MaxConcurrentStreams)
+		opts = append(opts, limiter.serverOptions()...)
+	}
+
+	// Streams are only reported once the limiter accepted them.
+	opts = append(
+		opts, grpc.ChainUnaryInterceptor(m.creds.unaryInterceptor),
+		grpc.ChainStreamInterceptor(m.creds.streamInterceptor),
+	)
+	m.server = serverCreator(opts...)
+
+	ready := make(chan error, 1)
+	m.wg.Add(1)
+	go m.run(session, timeout, ready)
+
+	return ready
+}
+
+// run connects the session to the first of its mailbox servers that can be
+// reached and serves its clients over that connection until the session is
+// stopped. The outcome of the search for a reachable server is sent on the
+// ready channel, before any client is served.
+func (m *mailboxSession) run(session *Session, timeout time.Duration,
+	ready chan<- error) {
+
+	defer m.wg.Done()
+
+	// The mailbox server itself connects lazily, so we first find out
+	// which of the session's mailbox servers can actually be reached.
+	// Only that search is bounded by the start timeout.
+	ctx, cancel := context.WithCancel(context.Background())
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), timeout)
+	}
+	dialOpts := m.connCfg.dialOptions()
+	probe := func(ctx context.Context, addr string) error {
+		return probeMailboxServer(
+			ctx, addr, m.connCfg.handshakeTimeout, dialOpts,
+		)
+	}
+	addr, err := findMailboxServer(
+		ctx, session.MailboxServerAddrs(), probe, minMailboxBackoff,
+		maxMailboxBackoff, m.quit,
+	)
+	cancel()
+	if err != nil {
+		log.Debugf("Not serving mailbox gRPC: %v", err)
+		ready <- err
+		return
 	}
 
 	// Start the mailbox gRPC server.
 	mailboxServer, err := mailbox.NewServer(
-		session.ServerAddr, session.PairingSecret[:],
+		addr, session.PairingSecret[:], dialOpts...,
+	)
+	if err != nil {
+		log.Errorf("Unable to create mailbox server: %v", err)
+		ready <- fmt.Errorf("error creating mailbox server: %v", err)
+		return
+	}
+
+	m.activeAddrMtx.Lock()
+	m.activeAddr = addr
+	m.serving = true
+	m.activeAddrMtx.Unlock()
+	ready <- nil
+
+	log.Infof("Mailbox RPC server listening on %s", mailboxServer.Addr())
+	if err := m.server.Serve(mailboxServer); err != nil {
+		log.Errorf("Unable to serve mailbox gRPC: %v", err)
+	}
+
+	m.activeAddrMtx.Lock()
+	m.serving = false
+	m.activeAddrMtx.Unlock()
+}
+
+// mailboxConnConfig holds the parameters of the connection to a mailbox server.
+type mailboxConnConfig struct {
+	devServer          bool
+	insecureSkipVerify bool
+	keepaliveInterval  time.Duration
+	handshakeTimeout   time.Duration
+}
+
+// newMailboxConnConfig returns the mailbox connection parameters of the given
+// session. Tuning options the session doesn't set use the defaults.
+func newMailboxConnConfig(session *Session) *mailboxConnConfig {
+	cfg := &mailboxConnConfig{
+		devServer:          session.DevServer,
+		insecureSkipVerify: session.InsecureSkipVerify,
+		keepaliveInterval:  session.KeepaliveInterval,
+		handshakeTimeout:   session.HandshakeTimeout,
+	}
+	if cfg.keepaliveInterval == 0 {
+		cfg.keepaliveInterval = mailboxKeepaliveInterval
+	}
+	if cfg.handshakeTimeout == 0 {
+		cfg.handshakeTimeout = mailboxDialTimeout
+	}
+
+	return cfg
+}
+
+// dialOptions returns the gRPC dial options used to connect to a mailbox
+// server. The TLS certificate of the server is only left unverified for
+// development servers.
+func (c *mailboxConnConfig) dialOptions() []grpc.DialOption {
+	tlsConfig := &tls.Config{}
+	if c.devServer && c.insecureSkipVerify {
+		tlsConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return []grpc.DialOption{
 		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
-			Time: 2 * time.Minute,
+			Time: c.keepaliveInterval,
 		}),
+	}
+}
+
+// probeMailboxServer dials the mailbox server at the given address, waits up
+// to the given timeout for the connection to be established and closes it
+// again.
+func probeMailboxServer(ctx context.Context, addr string,
+	timeout time.Duration, dialOpts []grpc.DialOption) error {
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(
+		ctx, addr, append(dialOpts, grpc.WithBlock())...,
 	)
 	if err != nil {
 		return err
 	}
 
-	ecdh := &keychain.PrivKeyECDH{PrivKey: session.LocalPrivateKey}
-	noiseConn := mailbox.NewNoiseGrpcConn(
-		ecdh, authData, session.PairingSecret[:],
+	return conn.Close()
+}
+
+// CheckMailboxServer dials the mailbox server at the given address the same
+// way a session does, without creating a session. It returns the time it took
+// to establish the connection. A timeout of 0 uses the default dial timeout of
+// sessions.
+func CheckMailboxServer(ctx context.Context, addr string, devServer,
+	insecureSkipVerify bool, timeout time.Duration) (time.Duration, error) {
+
+	connCfg := newMailboxConnConfig(&Session{
+		DevServer:          devServer,
+		InsecureSkipVerify: insecureSkipVerify,
+		HandshakeTimeout:   timeout,
+	})
+	dialOpts := connCfg.dialOptions()
+
+	start := time.Now()
+	err := probeMailboxServer(
+		ctx, addr, connCfg.handshakeTimeout, dialOpts,
 	)
-	m.server = serverCreator(grpc.Creds(noiseConn))
+	if err != nil {
+		return 0, err
+	}
 
-	m.wg.Add(1)
-	go m.run(mailboxServer)
+	return time.Since(start), nil
+}
 
-	return nil
+// findMailboxServer probes each of the given mailbox server addresses in order
+// and returns the first one that can be reached. If none of them can be
+// reached, all addresses are tried again after an exponentially increasing
+// backoff. An error is only returned if the quit channel is closed or the given
+// context is done before a reachable server was found. In the latter case, the
+// error wraps the error of the context.
+func findMailboxServer(parentCtx context.Context, addrs []string,
+	probe func(ctx context.Context, addr string) error, minBackoff,
+	maxBackoff time.Duration, quit <-chan struct{}) (string, error) {
+
+	ctx, cancel := context.WithCancel(parentCtx)
+	defer cancel()
+
+	// stopped returns why the search was given up.
+	stopped := func() error {
+		select {
+		case <-quit:
+			return fmt.Errorf("session stopped")
+		default:
+			return fmt.Errorf("no mailbox server could be "+
+				"reached: %w", parentCtx.Err())
+		}
+	}
+
+	go func() {
+		select {
+		case <-quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	backoff := minBackoff
+	for {
+		for _, addr := range addrs {
+			err := probe(ctx, addr)
+			if err == nil {
+				return addr, nil
+			}
+
+			if ctx.Err() != nil {
+				return "", stopped()
+			}
+
+			log.Warnf("Unable to reach mailbox server %s: %v", addr,
+				err)
+		}
+
+		log.Debugf("None of the mailbox servers could be reached, "+
+			"retrying in %v", backoff)
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return "", stopped()
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
 }
 
-func (m *mailboxSession) run(mailboxServer *mailbox.Server) {
-	defer m.wg.Done()
+// activeServerAddr returns the address of the mailbox server the session is
+// currently connected to or an empty string if it isn't connected yet.
+func (m *mailboxSession) activeServerAddr() string {
+	m.activeAddrMtx.Lock()
+	defer m.activeAddrMtx.Unlock()
 
-	log.Infof("Mailbox RPC server listening on %s", mailboxServer.Addr())
-	if err := m.server.Serve(mailboxServer); err != nil {
-		log.Errorf("Unable to serve mailbox gRPC: %v", err)
+	return m.activeAddr
+}
+
+// connectionInfo returns the details of the session's live mailbox connection.
+func (m *mailboxSession) connectionInfo() *ConnectionInfo {
+	m.activeAddrMtx.Lock()
+	info := &ConnectionInfo{
+		Healthy: m.serving,
 	}
+	m.activeAddrMtx.Unlock()
+
+	m.creds.connMtx.Lock()
+	info.ConnectedSince = m.creds.connectedSince
+	info.RemoteAddr = m.creds.remoteAddr
+	m.creds.connMtx.Unlock()
+
+	return info
 }
 
 func (m *mailboxSession) stop() {
-	m.server.Stop()
 	close(m.quit)
+	m.server.Stop()
 	m.wg.Wait()
 }
 
@@ -95,24 +751,75 @@ func NewServer(serverCreator GRPCServerCreator) *Server {
 	}
 }
 
-func (s *Server) StartSession(session *Session, authData []byte) (chan struct{},
+// StartSession starts the mailbox connection of the given session that hands
+// out the given authentication data. If the timeout is non-zero, the call
+// blocks until one of the session's mailbox servers was reached. If none can be
+// reached in time, the session is stopped again and an error that wraps
+// context.DeadlineExceeded is returned.
+func (s *Server) StartSession(session *Session, authData []byte,
+	timeout time.Duration) (chan struct{}, error) {
+
+	return s.startSession(session, authData, nil, timeout)
+}
+
+// StartLazySession starts the mailbox connection of the given session without
+// creating its authentication data yet. The data is only created with the
+// given function once the first client connects. The timeout is applied like
+// for StartSession.
+func (s *Server) StartLazySession(session *Session, authData AuthDataFunc,
+	timeout time.Duration) (chan struct{}, error) {
+
+	return s.startSession(session, nil, authData, timeout)
+}
+
+// startSession starts the mailbox connection of the given session that hands
+// out the given authentication data or, if lazyAuthData is set, the data
+// created by it once the first client connects. If the timeout is non-zero, it
+// waits for a mailbox server to be reached.
+func (s *Server) startSession(session *Session, authData []byte,
+	lazyAuthData AuthDataFunc, timeout time.Duration) (chan struct{},
 	error) {
 
 	s.activeSessionsMtx.Lock()
-	defer s.activeSessionsMtx.Unlock()
 
 	var id sessionID
 	copy(id[:], session.LocalPublicKey.SerializeCompressed())
 
 	_, ok := s.activeSessions[id]
 	if ok {
+		s.activeSessionsMtx.Unlock()
 		return nil, fmt.Errorf("session %x is already active", id[:])
 	}
 
 	sess := newMailboxSession()
 	s.activeSessions[id] = sess
+	ready := sess.start(
+		session, s.serverCreator, authData, lazyAuthData, timeout,
+	)
+	s.activeSessionsMtx.Unlock()
+
+	if timeout == 0 {
+		return sess.quit, nil
+	}
 
-	return sess.quit, sess.start(session, s.serverCreator, authData)
+	// We don't hold the lock while waiting, so the other sessions can be
+	// managed in the meantime.
+	err := <-ready
+	if err == nil {
+		return sess.quit, nil
+	}
+
+	// The session might have been stopped and even started again while
+	// we were waiting, so we only clean up our own session.
+	s.activeSessionsMtx.Lock()
+	if s.activeSessions[id] == sess {
+		sess.stop()
+		delete(s.activeSessions, id)
+	}
+	s.activeSessionsMtx.Unlock()
+
+	return nil, fmt.Errorf("error starting mailbox connection of "+
+		"session %x: %w", id[:], err)
 }
 
 func (s *Server) StopSession(localPublicKey *btcec.PublicKey) error {
@@ -133,6 +840,140 @@ func (s *Server) StopSession(localPublicKey *btcec.PublicKey) error {
 	return nil
 }
 
+// ActiveServerAddr returns the address of the mailbox server the session with
+// the given local public key is currently connected to. An empty string is
+// returned if the session isn't active or not connected yet.
+func (s *Server) ActiveServerAddr(localPublicKey *btcec.PublicKey) string {
+	s.activeSessionsMtx.Lock()
+	defer s.activeSessionsMtx.Unlock()
+
+	var id sessionID
+	copy(id[:], localPublicKey.SerializeCompressed())
+
+	sess, ok := s.activeSessions[id]
+	if !ok {
+		return ""
+	}
+
+	return sess.activeServerAddr()
+}
+
+// UpdateAuthData replaces the authentication data the session with the given
+// local public key hands out to clients. The mailbox connection isn't
+// restarted, so the new data is only used for the next handshake.
+func (s *Server) UpdateAuthData(localPublicKey *btcec.PublicKey,
+	authData []byte) error {
+
+	s.activeSessionsMtx.Lock()
+	defer s.activeSessionsMtx.Unlock()
+
+	var id sessionID
+	copy(id[:], localPublicKey.SerializeCompressed())
+
+	sess, ok := s.activeSessions[id]
+	if !ok {
+		return fmt.Errorf("session %x is not active", id[:])
+	}
+
+	sess.creds.setAuthData(authData)
+
+	return nil
+}
+
+// ConnectionInfo returns the details of the live mailbox connection of the
+// session with the given local public key. Nil is returned if the session isn't
+// active.
+func (s *Server) ConnectionInfo(
+	localPublicKey *btcec.PublicKey) *ConnectionInfo {
+
+	s.activeSessionsMtx.Lock()
+	defer s.activeSessionsMtx.Unlock()
+
+	var id sessionID
+	copy(id[:], localPublicKey.SerializeCompressed())
+
+	sess, ok := s.activeSessions[id]
+	if !ok {
+		return nil
+	}
+
+	return sess.connectionInfo()
+}
+
+// Drain makes the session with the given local public key stop accepting new
+// clients. Clients that are already connected can keep using the session.
+func (s *Server) Drain(localPublicKey *btcec.PublicKey) error {
+	s.activeSessionsMtx.Lock()
+	defer s.activeSessionsMtx.Unlock()
+
+	var id sessionID
+	copy(id[:], localPublicKey.SerializeCompressed())
+
+	sess, ok := s.activeSessions[id]
+	if !ok {
+		return fmt.Errorf("session %x is not active", id[:])
+	}
+
+	sess.creds.drain()
+
+	return nil
+}
+
+// Idle returns a channel that is closed once no client is connected to the
+// session with the given local public key anymore. Nil is returned if the
+// session isn't active.
+func (s *Server) Idle(localPublicKey *btcec.PublicKey) <-chan struct{} {
+	s.activeSessionsMtx.Lock()
+	defer s.activeSessionsMtx.Unlock()
+
+	var id sessionID
+	copy(id[:], localPublicKey.SerializeCompressed())
+
+	sess, ok := s.activeSessions[id]
+	if !ok {
+		return nil
+	}
+
+	return sess.creds.idleChan()
+}
+
+// Connections returns a channel that receives a signal each time a client
+// connects to the session with the given local public key. Nil is returned if
+// the session isn't active.
+func (s *Server) Connections(localPublicKey *btcec.PublicKey) <-chan struct{} {
+	s.activeSessionsMtx.Lock()
+	defer s.activeSessionsMtx.Unlock()
+
+	var id sessionID
+	copy(id[:], localPublicKey.SerializeCompressed())
+
+	sess, ok := s.activeSessions[id]
+	if !ok {
+		return nil
+	}
+
+	return sess.creds.connected
+}
+
+// Events returns a channel that receives the connection events of the session
+// with the given local public key. Nil is returned if the session isn't active.
+func (s *Server) Events(
+	localPublicKey *btcec.PublicKey) <-chan ConnectionEvent {
+
+	s.activeSessionsMtx.Lock()
+	defer s.activeSessionsMtx.Unlock()
+
+	var id sessionID
+	copy(id[:], localPublicKey.SerializeCompressed())
+
+	sess, ok := s.activeSessions[id]
+	if !ok {
+		return nil
+	}
+
+	return sess.creds.events
+}
+
 func (s *Server) Stop() {
 	s.activeSessionsMtx.Lock()
 	defer s.activeSessionsMtx.Unlock()
@@ -0,0 +1,231 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// TestFindMailboxServer makes sure that the mailbox servers of a session are
+// tried in order and that the first reachable one is used.
+func TestFindMailboxServer(t *testing.T) {
+	var probed []string
+	probe := func(_ context.Context, addr string) error {
+		probed = append(probed, addr)
+		if addr == "first:443" {
+			return fmt.Errorf("connection refused")
+		}
+
+		return nil
+	}
+
+	addr, err := findMailboxServer(
+		context.Background(),
+		[]string{"first:443", "second:443", "third:443"}, probe,
+		time.Millisecond, time.Millisecond, make(chan struct{}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "second:443", addr)
+	require.Equal(t, []string{"first:443", "second:443"}, probed)
+}
+
+// TestFindMailboxServerRetry makes sure that all mailbox servers are retried if
+// none of them could be reached and that the search is aborted on quit.
+func TestFindMailboxServerRetry(t *testing.T) {
+	attempts := 0
+	probe := func(_ context.Context, addr string) error {
+		attempts++
+		if attempts < 5 {
+			return fmt.Errorf("connection refused")
+		}
+
+		return nil
+	}
+
+	addrs := []string{"first:443", "second:443"}
+	addr, err := findMailboxServer(
+		context.Background(), addrs, probe, time.Millisecond,
+		2*time.Millisecond, make(chan struct{}),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "first:443", addr)
+
+	quit := make(chan struct{})
+	close(quit)
+	_, err = findMailboxServer(
+		context.Background(), addrs,
+		func(ctx context.Context, _ string) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}, time.Millisecond, time.Millisecond, quit,
+	)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// TestStartSessionTimeout makes sure that starting a session gives up once none
+// of its mailbox servers completed the handshake within the start timeout and
+// that the session is stopped again then.
+func TestStartSessionTimeout(t *testing.T) {
+	// The listener accepts connections but never answers, like a mailbox
+	// server that never completes the TLS handshake.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	go func() {
+		var conns []net.Conn
+		defer func() {
+			for _, conn := range conns {
+				_ = conn.Close()
+			}
+		}()
+
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conns = append(conns, conn)
+		}
+	}()
+
+	server := NewServer(func(opts ...grpc.ServerOption) *grpc.Server {
+		return grpc.NewServer(opts...)
+	})
+	defer server.Stop()
+
+	session, err := NewSession(
+		"hanging", TypeMacaroonAdmin, time.Now().Add(time.Hour),
+		listener.Addr().String(), true, nil, nil,
+	)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = server.StartSession(session, nil, 200*time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	require.Nil(t, server.ConnectionInfo(session.LocalPublicKey))
+
+	// The session can be started again afterwards.
+	_, err = server.StartSession(session, nil, 0)
+	require.NoError(t, err)
+	require.NoError(t, server.StopSession(session.LocalPublicKey))
+}
+
+// TestMailboxConnConfig makes sure that the connection tuning options of a
+// session reach the mailbox connection of a started session and that the
+// defaults apply if they aren't set.
+func TestMailboxConnConfig(t *testing.T) {
+	server := NewServer(func(opts ...grpc.ServerOption) *grpc.Server {
+		return grpc.NewServer(opts...)
+	})
+	defer server.Stop()
+
+	startSession := func(keepalive,
+		handshake time.Duration) *mailboxConnConfig {
+
+		session, err := NewSession(
+			"conn", TypeMacaroonAdmin, time.Now().Add(time.Hour),
+			"localhost:0", true, nil, nil,
+		)
+		require.NoError(t, err)
+		session.KeepaliveInterval = keepalive
+		session.HandshakeTimeout = handshake
+
+		_, err = server.StartSession(session, nil, 0)
+		require.NoError(t, err)
+
+		var id sessionID
+		copy(id[:], session.LocalPublicKey.SerializeCompressed())
+
+		server.activeSessionsMtx.Lock()
+		defer server.activeSessionsMtx.Unlock()
+
+		return server.activeSessions[id].connCfg
+	}
+
+	connCfg := startSession(30*time.Second, 5*time.Second)
+	require.Equal(t, 30*time.Second, connCfg.keepaliveInterval)
+	require.Equal(t, 5*time.Second, connCfg.handshakeTimeout)
+	require.True(t, connCfg.devServer)
+
+	connCfg = startSession(0, 0)
+	require.Equal(t, mailboxKeepaliveInterval, connCfg.keepaliveInterval)
+	require.Equal(t, mailboxDialTimeout, connCfg.handshakeTimeout)
+}
+
+// TestConnectionInfo makes sure that the connection details are only reported
+// for active sessions and that a session that couldn't reach its mailbox
+// server isn't reported as healthy.
+func TestConnectionInfo(t *testing.T) {
+	server := NewServer(func(opts ...grpc.ServerOption) *grpc.Server {
+		return grpc.NewServer(opts...)
+	})
+	defer server.Stop()
+
+	session, err := NewSession(
+		"conn", TypeMacaroonAdmin, time.Now().Add(time.Hour),
+		"localhost:0", true, nil, nil,
+	)
+	require.NoError(t, err)
+	require.Nil(t, server.ConnectionInfo(session.LocalPublicKey))
+
+	_, err = server.StartSession(session, nil, 0)
+	require.NoError(t, err)
+
+	info := server.ConnectionInfo(session.LocalPublicKey)
+	require.NotNil(t, info)
+	require.False(t, info.Healthy)
+	require.True(t, info.ConnectedSince.IsZero())
+	require.Empty(t, info.RemoteAddr)
+
+	require.NoError(t, server.StopSession(session.LocalPublicKey))
+	require.Nil(t, server.ConnectionInfo(session.LocalPublicKey))
+}
+
+// TestClientTracking makes sure that connected clients are tracked until their
+// connection is closed and that a draining session rejects new clients.
+func TestClientTracking(t *testing.T) {
+	creds := newAuthDataCreds(nil, nil, nil)
+
+	isIdle := func() bool {
+		select {
+		case <-creds.idleChan():
+			return true
+		default:
+			return false
+		}
+	}
+	require.True(t, isIdle())
+
+	first, _ := net.Pipe()
+	second, _ := net.Pipe()
+	firstConn := creds.clientConnected(first)
+	secondConn := creds.clientConnected(second)
+	require.False(t, isIdle())
+	require.Equal(t, EventRemoteConnected, <-creds.events)
+	require.Equal(t, EventRemoteConnected, <-creds.events)
+
+	// Closing a connection twice only counts once.
+	require.NoError(t, firstConn.Close())
+	_ = firstConn.Close()
+	require.False(t, isIdle())
+
+	require.NoError(t, secondConn.Close())
+	require.True(t, isIdle())
+
+	// Each disconnect is reported once.
+	require.Equal(t, EventRemoteDisconnected, <-creds.events)
+	require.Equal(t, EventRemoteDisconnected, <-creds.events)
+	require.Empty(t, creds.events)
+
+	creds.drain()
+	_, _, err := creds.ServerHandshake(nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,63 @@
+package session
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+const (
+	// shortIDHashBytes is the number of bytes of the hash of the local
+	// public key that a short session ID is made of.
+	shortIDHashBytes = 8
+)
+
+var (
+	// shortIDEncoding is the encoding of short session IDs. Base32 only
+	// uses characters that are easy to tell apart and copy.
+	shortIDEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// ShortID returns the short ID of the session with the given local public key.
+// It is derived from the key deterministically, so it never changes. Different
+// keys can result in the same ID, although that is extremely unlikely.
+func ShortID(localPublicKey *btcec.PublicKey) string {
+	hash := sha256.Sum256(localPublicKey.SerializeCompressed())
+
+	return strings.ToLower(
+		shortIDEncoding.EncodeToString(hash[:shortIDHashBytes]),
+	)
+}
+
+// GetSessionByShortID fetches the session with the given short ID. The ID is
+// matched case insensitively. If more than one session has the ID,
+// ErrMultipleSessions is returned and the session must be looked up by its
+// local public key instead.
+func (db *DB) GetSessionByShortID(id string) (*Session, error) {
+	sessions, err := db.ListSessions()
+	if err != nil {
+		return nil, err
+	}
+
+	id = strings.ToLower(id)
+
+	var match *Session
+	for _, session := range sessions {
+		if ShortID(session.LocalPublicKey) != id {
+			continue
+		}
+
+		if match != nil {
+			return nil, ErrMultipleSessions
+		}
+		match = session
+	}
+
+	if match == nil {
+		return nil, ErrSessionNotFound
+	}
+
+	return match, nil
+}
@@ -0,0 +1,54 @@
+package session
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+)
+
+// TestShortID makes sure that the short ID of a session is derived from its
+// local public key deterministically.
+func TestShortID(t *testing.T) {
+	// The ID of a known key must never change, clients may have stored
+	// it.
+	_, pubKey := btcec.PrivKeyFromBytes(
+		btcec.S256(), bytes.Repeat([]byte{0x01}, 32),
+	)
+	require.Equal(t, "6hisaesanod26", ShortID(pubKey))
+
+	session := newTestSession(t, "short")
+	require.Equal(
+		t, ShortID(session.LocalPublicKey),
+		ShortID(session.LocalPublicKey),
+	)
+	require.NotEqual(
+		t, ShortID(session.LocalPublicKey),
+		ShortID(newTestSession(t, "other").LocalPublicKey),
+	)
+}
+
+// TestGetSessionByShortID makes sure that a session can be looked up by its
+// short ID.
+func TestGetSessionByShortID(t *testing.T) {
+	db := newTestDB(t)
+
+	session := newTestSession(t, "short")
+	require.NoError(t, db.StoreSession(session))
+	require.NoError(t, db.StoreSession(newTestSession(t, "other")))
+
+	id := ShortID(session.LocalPublicKey)
+	stored, err := db.GetSessionByShortID(id)
+	require.NoError(t, err)
+	require.True(t, session.LocalPublicKey.IsEqual(stored.LocalPublicKey))
+
+	// The ID is matched case insensitively.
+	stored, err = db.GetSessionByShortID(strings.ToUpper(id))
+	require.NoError(t, err)
+	require.True(t, session.LocalPublicKey.IsEqual(stored.LocalPublicKey))
+
+	_, err = db.GetSessionByShortID("unknown")
+	require.ErrorIs(t, err, ErrSessionNotFound)
+}
@@ -3,9 +3,12 @@ package session
 import (
 	"bytes"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"go.etcd.io/bbolt"
+	"gopkg.in/macaroon.v2"
 )
 
 var (
@@ -17,6 +20,33 @@ var (
 	// ErrSessionNotFound is an error returned when we attempt to retrieve
 	// information about a session but it is not found.
 	ErrSessionNotFound = errors.New("session not found")
+
+	// ErrSessionConflict is an error returned when we attempt to store a
+	// session that was modified by someone else since it was read.
+	ErrSessionConflict = errors.New("session was modified concurrently")
+
+	// ErrSessionExists is an error returned when we attempt to store a new
+	// session but its local public key already belongs to a different
+	// session.
+	ErrSessionExists = errors.New("a different session with the same " +
+		"local public key already exists")
+
+	// ErrStateMismatch is an error returned when we attempt to swap the
+	// state of a session that isn't in the expected state.
+	ErrStateMismatch = errors.New("session is not in the expected state")
+
+	// ErrMultipleSessions is an error returned when we attempt to look up
+	// a session by its short ID but more than one session has that ID.
+	ErrMultipleSessions = errors.New("multiple sessions found")
+
+	// ErrNotRestorable is an error returned when we attempt to restore a
+	// revoked session that was revoked permanently or whose restore window
+	// has passed.
+	ErrNotRestorable = errors.New("session can no longer be restored")
+
+	// ErrSessionExpired is an error returned when we attempt to restore a
+	// revoked session whose expiry has passed in the meantime.
+	ErrSessionExpired = errors.New("session is expired")
 )
 
 // getSessionKey returns the key for a session.
@@ -26,27 +56,115 @@ func getSessionKey(session *Session) []byte {
 
 // StoreSession stores a session in the store. If a session with the
 // same local public key already exists, the existing record is updated/
-// overwritten instead.
+// overwritten instead. To prevent lost updates, the session's revision must
+// match the revision of the stored record, otherwise ErrSessionConflict is
+// returned and the caller should reload the session and try again. A session
+// is never overwritten by a different session that happens to use the same
+// local public key, ErrSessionExists is returned in that case. On success, the
+// session's revision is incremented.
 func (db *DB) StoreSession(session *Session) error {
-	var buf bytes.Buffer
-	if err := SerializeSession(&buf, session); err != nil {
-		return err
-	}
 	sessionKey := getSessionKey(session)
 
-	return db.Update(func(tx *bbolt.Tx) error {
+	var (
+		created   bool
+		prevState State
+	)
+	err := db.Update(func(tx *bbolt.Tx) error {
 		sessionBucket, err := getBucket(tx, sessionBucketKey)
 		if err != nil {
 			return err
 		}
 
-		return sessionBucket.Put(sessionKey, buf.Bytes())
+		existing, err := db.getSession(
+			sessionBucket, session.LocalPublicKey,
+		)
+		switch {
+		case err == ErrSessionNotFound:
+			created = true
+
+		case err != nil:
+			return err
+
+		case !isSameSession(existing, session):
+			return ErrSessionExists
+
+		case existing.Revision != session.Revision:
+			return ErrSessionConflict
+
+		default:
+			prevState = existing.State
+		}
+
+		err = db.putSession(sessionBucket, sessionKey, session)
+		if err != nil {
+			return err
+		}
+
+		if created {
+			err = putAuditEvent(
+				tx, session.LocalPublicKey, AuditEventCreated,
+			)
+		} else {
+			err = putStateAuditEvent(tx, session, prevState)
+		}
+
+		// The transaction is rolled back, so the stored revision
+		// doesn't change either.
+		if err != nil {
+			session.Revision--
+		}
+
+		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	if created || prevState != session.State {
+		sessionCopy := *session
+		db.notifyStateChange(&sessionCopy, prevState, created)
+	}
+
+	return nil
 }
 
-// ListSessions returns all sessions currently known to the store.
+// ListSessions returns all sessions currently known to the store. The sessions
+// are read in a single read transaction, so they reflect a consistent point in
+// time: each session is returned either as it was before or as it is after any
+// concurrent write, and no write is only partially visible. Invalid session
+// records are skipped, they are returned by InvalidRecords instead.
 func (db *DB) ListSessions() ([]*Session, error) {
-	var sessions []*Session
+	sessions, invalid, err := db.scanSessions()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range invalid {
+		log.Debugf("Skipping invalid session record %x: %v", record.Key,
+			record.Reason)
+	}
+
+	return sessions, nil
+}
+
+// InvalidRecords returns all stored session records that can't be used as a
+// session, for example because they are corrupted.
+func (db *DB) InvalidRecords() ([]*InvalidRecord, error) {
+	_, invalid, err := db.scanSessions()
+	if err != nil {
+		return nil, err
+	}
+
+	return invalid, nil
+}
+
+// scanSessions reads all stored session records and returns the valid sessions
+// and the invalid records separately. The raw records are only copied within a
+// single read transaction and decoded after it ended, so the transaction is
+// kept short and doesn't hold back writers that need to grow the database
+// file.
+func (db *DB) scanSessions() ([]*Session, []*InvalidRecord, error) {
+	var rawKeys, rawSessions [][]byte
 	err := db.View(func(tx *bbolt.Tx) error {
 		sessionBucket, err := getBucket(tx, sessionBucketKey)
 		if err != nil {
@@ -60,26 +178,90 @@ func (db *DB) ListSessions() ([]*Session, error) {
 				return nil
 			}
 
-			session, err := DeserializeSession(bytes.NewReader(v))
-			if err != nil {
-				return err
-			}
-			sessions = append(sessions, session)
+			// The key and value are only valid for the lifetime of
+			// the transaction, so we need to copy them.
+			rawKeys = append(rawKeys, append([]byte(nil), k...))
+			rawSessions = append(
+				rawSessions, append([]byte(nil), v...),
+			)
 
 			return nil
 		})
 	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		sessions []*Session
+		invalid  []*InvalidRecord
+	)
+	for i, v := range rawSessions {
+		session, err := db.decodeSession(rawKeys[i], v)
+		if err == nil {
+			err = validateRecord(rawKeys[i], session)
+		}
+		if err != nil {
+			invalid = append(invalid, &InvalidRecord{
+				Key:    rawKeys[i],
+				Reason: err.Error(),
+			})
+			continue
+		}
+
+		sessions = append(sessions, session)
+	}
+
+	return sessions, invalid, nil
+}
+
+// validateRecord makes sure that the session stored under the given key can be
+// used: its keys are intact and its type, state and expiry are known values.
+func validateRecord(key []byte, session *Session) error {
+	if session.LocalPublicKey == nil {
+		return fmt.Errorf("session has no local key")
+	}
+
+	if !bytes.Equal(key, getSessionKey(session)) {
+		return fmt.Errorf("session is stored under a key that doesn't "+
+			"match its local public key %x", getSessionKey(session))
+	}
+
+	if session.Type > TypeUIPassword {
+		return fmt.Errorf("unknown session type %d", session.Type)
+	}
+
+	if session.State > StateSuspended {
+		return fmt.Errorf("unknown session state %d", session.State)
+	}
 
+	if session.Expiry.Unix() <= 0 {
+		return fmt.Errorf("session has no expiry")
+	}
+
+	return nil
+}
+
+// ListGroupSessions returns all sessions with the given group ID. Groups aren't
+// indexed, so all sessions are scanned.
+func (db *DB) ListGroupSessions(groupID string) ([]*Session, error) {
+	sessions, err := db.ListSessions()
 	if err != nil {
 		return nil, err
 	}
 
-	return sessions, nil
+	var group []*Session
+	for _, session := range sessions {
+		if session.GroupID == groupID {
+			group = append(group, session)
+		}
+	}
+
+	return group, nil
 }
 
-// RevokeSession updates the state of the session with the given local
-// public key to be revoked.
-func (db *DB) RevokeSession(key *btcec.PublicKey) error {
+// GetSession fetches the session with the given local public key.
+func (db *DB) GetSession(key *btcec.PublicKey) (*Session, error) {
 	var session *Session
 	err := db.View(func(tx *bbolt.Tx) error {
 		sessionBucket, err := getBucket(tx, sessionBucketKey)
@@ -87,18 +269,722 @@ func (db *DB) RevokeSession(key *btcec.PublicKey) error {
 			return err
 		}
 
-		sessionBytes := sessionBucket.Get(key.SerializeCompressed())
-		if len(sessionBytes) == 0 {
-			return ErrSessionNotFound
+		session, err = db.getSession(sessionBucket, key)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// RevokeSession updates the state of the session with the given local
+// public key to be revoked and records the time of the revocation together with
+// the given reason. Revoking an already revoked session keeps the originally
+// recorded time and reason.
+func (db *DB) RevokeSession(key *btcec.PublicKey, reason string) error {
+	return db.updateSession(key, func(session *Session) error {
+		// Revoking a softly revoked session again makes the revocation
+		// permanent.
+		session.RestorableUntil = time.Time{}
+		if session.State == StateRevoked {
+			return nil
+		}
+
+		session.State = StateRevoked
+		session.RevokedAt = time.Now()
+		session.RevokeReason = reason
+		return nil
+	})
+}
+
+// RevokeExpiredSessions revokes the sessions with the given local public keys
+// for the given reason like RevokeSession, but in a single transaction. The
+// state and expiry of each session are checked again within the transaction,
+// so sessions that were revoked in the meantime or whose expiry no longer lies
+// before the given time are skipped. The revoked sessions are returned. Either
+// all of the expired sessions are revoked or none of them is, for example
+// because one of them doesn't exist.
+func (db *DB) RevokeExpiredSessions(keys []*btcec.PublicKey, reason string,
+	now time.Time) ([]*Session, error) {
+
+	var (
+		sessions   = make([]*Session, 0, len(keys))
+		prevStates = make([]State, 0, len(keys))
+	)
+	err := db.Update(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		revokedAt := time.Now()
+		for _, key := range keys {
+			session, err := db.getSession(sessionBucket, key)
+			if err != nil {
+				return err
+			}
+
+			if session.State == StateRevoked ||
+				!session.Expiry.Before(now) {
+
+				continue
+			}
+			prevState := session.State
+
+			session.RestorableUntil = time.Time{}
+			session.State = StateRevoked
+			session.RevokedAt = revokedAt
+			session.RevokeReason = reason
+
+			err = db.putSession(
+				sessionBucket, getSessionKey(session), session,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = putStateAuditEvent(tx, session, prevState)
+			if err != nil {
+				return err
+			}
+
+			sessions = append(sessions, session)
+			prevStates = append(prevStates, prevState)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, session := range sessions {
+		db.notifyStateChange(session, prevStates[i], false)
+	}
+
+	return sessions, nil
+}
+
+// SoftRevokeSession revokes the session with the given local public key like
+// RevokeSession, but keeps it restorable with RestoreSession until the given
+// time. Sessions that are revoked already are left untouched.
+func (db *DB) SoftRevokeSession(key *btcec.PublicKey, reason string,
+	restorableUntil time.Time) error {
+
+	return db.updateSession(key, func(session *Session) error {
+		if session.State == StateRevoked {
+			return nil
+		}
+
+		session.State = StateRevoked
+		session.RevokedAt = time.Now()
+		session.RevokeReason = reason
+		session.RestorableUntil = restorableUntil
+		return nil
+	})
+}
+
+// RestoreSession moves the softly revoked session with the given local public
+// key back to the created state. ErrStateMismatch is returned if the session
+// isn't revoked, ErrNotRestorable if it was revoked permanently or its restore
+// window has passed and ErrSessionExpired if its expiry has passed.
+func (db *DB) RestoreSession(key *btcec.PublicKey) error {
+	return db.updateSession(key, func(session *Session) error {
+		if session.State != StateRevoked {
+			return ErrStateMismatch
+		}
+
+		now := time.Now()
+		if session.RestorableUntil.IsZero() ||
+			now.After(session.RestorableUntil) {
+
+			return ErrNotRestorable
+		}
+
+		if !session.Expiry.After(now) {
+			return ErrSessionExpired
+		}
+
+		session.State = StateCreated
+		session.RevokedAt = time.Time{}
+		session.RevokeReason = ""
+		session.RestorableUntil = time.Time{}
+		return nil
+	})
+}
+
+// ReplaceSession stores the given new session and revokes the session with the
+// given old local public key for the given reason in a single transaction, so
+// there is no point in time where both or neither of them are valid. The new
+// session must not exist yet and the old session must not be revoked already,
+// ErrSessionExists or ErrStateMismatch are returned otherwise.
+func (db *DB) ReplaceSession(oldKey *btcec.PublicKey, session *Session,
+	reason string) error {
+
+	var (
+		oldSession *Session
+		prevState  State
+	)
+	err := db.Update(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.getSession(sessionBucket, session.LocalPublicKey)
+		switch {
+		case err == nil:
+			return ErrSessionExists
+
+		case err != ErrSessionNotFound:
+			return err
+		}
+
+		oldSession, err = db.getSession(sessionBucket, oldKey)
+		if err != nil {
+			return err
+		}
+		if oldSession.State == StateRevoked {
+			return ErrStateMismatch
+		}
+		prevState = oldSession.State
+
+		oldSession.State = StateRevoked
+		oldSession.RevokedAt = time.Now()
+		oldSession.RevokeReason = reason
+		err = db.putSession(
+			sessionBucket, getSessionKey(oldSession), oldSession,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = putStateAuditEvent(tx, oldSession, prevState)
+		if err != nil {
+			return err
+		}
+
+		err = db.putSession(
+			sessionBucket, getSessionKey(session), session,
+		)
+		if err != nil {
+			return err
+		}
+
+		// The transaction is rolled back, so the stored revision
+		// doesn't change either.
+		err = putAuditEvent(
+			tx, session.LocalPublicKey, AuditEventCreated,
+		)
+		if err != nil {
+			session.Revision--
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	db.notifyStateChange(oldSession, prevState, false)
+
+	sessionCopy := *session
+	db.notifyStateChange(&sessionCopy, session.State, true)
+
+	return nil
+}
+
+// RevertReplaceSession undoes a replacement in a single transaction. The new
+// session with the given local public key is revoked for the given reason and
+// the replaced session with the given old local public key is moved back to the
+// given state it was in before, as if it was never revoked. ErrStateMismatch is
+// returned if the old session isn't revoked.
+func (db *DB) RevertReplaceSession(oldKey *btcec.PublicKey, oldState State,
+	newKey *btcec.PublicKey, reason string) error {
+
+	var (
+		oldSession, newSession *Session
+		newPrevState           State
+	)
+	err := db.Update(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		oldSession, err = db.getSession(sessionBucket, oldKey)
+		if err != nil {
+			return err
+		}
+		if oldSession.State != StateRevoked {
+			return ErrStateMismatch
+		}
+
+		newSession, err = db.getSession(sessionBucket, newKey)
+		if err != nil {
+			return err
+		}
+		newPrevState = newSession.State
+
+		if newSession.State != StateRevoked {
+			newSession.State = StateRevoked
+			newSession.RevokedAt = time.Now()
+			newSession.RevokeReason = reason
+		}
+		err = db.putSession(
+			sessionBucket, getSessionKey(newSession), newSession,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = putStateAuditEvent(tx, newSession, newPrevState)
+		if err != nil {
+			return err
+		}
+
+		oldSession.State = oldState
+		oldSession.RevokedAt = time.Time{}
+		oldSession.RevokeReason = ""
+		err = db.putSession(
+			sessionBucket, getSessionKey(oldSession), oldSession,
+		)
+		if err != nil {
+			return err
 		}
 
-		session, err = DeserializeSession(bytes.NewReader(sessionBytes))
+		return putStateAuditEvent(tx, oldSession, StateRevoked)
+	})
+	if err != nil {
 		return err
+	}
+
+	if newPrevState != StateRevoked {
+		db.notifyStateChange(newSession, newPrevState, false)
+	}
+	db.notifyStateChange(oldSession, StateRevoked, false)
+
+	return nil
+}
+
+// UpdateSessionState updates the state of the session with the given local
+// public key.
+func (db *DB) UpdateSessionState(key *btcec.PublicKey, state State) error {
+	return db.updateSession(key, func(session *Session) error {
+		session.State = state
+		return nil
+	})
+}
+
+// UpdateResumeStatus records an attempt to resume the session with the given
+// local public key that happened now, together with the error that prevented it
+// from being resumed. An empty error marks a successful attempt.
+func (db *DB) UpdateResumeStatus(key *btcec.PublicKey, resumeErr string) error {
+	return db.updateSession(key, func(session *Session) error {
+		session.LastResumeAttempt = time.Now()
+		session.LastResumeError = resumeErr
+		return nil
+	})
+}
+
+// SwapSessionState updates the state of the session with the given local
+// public key to the given state, but only if it currently is in the from
+// state. ErrStateMismatch is returned otherwise.
+func (db *DB) SwapSessionState(key *btcec.PublicKey, from, to State) error {
+	return db.updateSession(key, func(session *Session) error {
+		if session.State != from {
+			return ErrStateMismatch
+		}
+
+		session.State = to
+		return nil
+	})
+}
+
+// MarkSessionStarting marks the session with the given local public key as
+// starting, but only if it currently is in the from state. The from state is
+// recorded on the session, so a start that is interrupted by a crash can be
+// rolled back to it on the next resume. ErrStateMismatch is returned if the
+// session isn't in the from state.
+func (db *DB) MarkSessionStarting(key *btcec.PublicKey, from State) error {
+	return db.updateSession(key, func(session *Session) error {
+		if session.State != from {
+			return ErrStateMismatch
+		}
+
+		session.State = StateStarting
+		session.StartingFrom = from
+		return nil
+	})
+}
+
+// MarkSessionConnected marks the session with the given local public key as in
+// use because a client completed the handshake with it. The time of the first
+// connection is only recorded once. ErrStateMismatch is returned if the session
+// isn't usable anymore.
+func (db *DB) MarkSessionConnected(key *btcec.PublicKey) error {
+	return db.updateSession(key, func(session *Session) error {
+		switch session.State {
+		case StateRevoked, StateExpired, StateQuarantined,
+			StateSuspended:
+
+			return ErrStateMismatch
+		}
+
+		session.State = StateInUse
+		if session.FirstConnectedAt.IsZero() {
+			session.FirstConnectedAt = time.Now()
+		}
+
+		return nil
+	})
+}
+
+// UpdateSessionExpiry updates the expiry of the session with the given local
+// public key.
+func (db *DB) UpdateSessionExpiry(key *btcec.PublicKey,
+	expiry time.Time) error {
+
+	return db.updateSession(key, func(session *Session) error {
+		session.Expiry = expiry
+		return nil
+	})
+}
+
+// UpdateSessionDescription updates the description of the session with the
+// given local public key.
+func (db *DB) UpdateSessionDescription(key *btcec.PublicKey,
+	description string) error {
+
+	return db.updateSession(key, func(session *Session) error {
+		session.Description = description
+		return nil
+	})
+}
+
+// UpdateSessionsMetadata sets the given metadata keys and removes the given
+// ones from all sessions with the given local public keys in a single
+// transaction. A key must not be both set and removed. Sessions whose metadata
+// doesn't change aren't written again. The number of sessions that
+// changed is returned. If one of the sessions doesn't exist, none of them is
+// updated.
+func (db *DB) UpdateSessionsMetadata(keys []*btcec.PublicKey,
+	set map[string]string, remove []string) (int, error) {
+
+	var numUpdated int
+	err := db.Update(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			session, err := db.getSession(sessionBucket, key)
+			if err != nil {
+				return err
+			}
+
+			if !updateMetadata(session, set, remove) {
+				continue
+			}
+
+			err = db.putSession(
+				sessionBucket, getSessionKey(session), session,
+			)
+			if err != nil {
+				return err
+			}
+
+			numUpdated++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numUpdated, nil
+}
+
+// updateMetadata sets the given metadata keys and removes the given ones from
+// the session. True is returned if the metadata of the session changed.
+func updateMetadata(session *Session, set map[string]string,
+	remove []string) bool {
+
+	var changed bool
+	for key, value := range set {
+		current, ok := session.Metadata[key]
+		if ok && current == value {
+			continue
+		}
+
+		if session.Metadata == nil {
+			session.Metadata = make(map[string]string, len(set))
+		}
+		session.Metadata[key] = value
+		changed = true
+	}
+
+	for _, key := range remove {
+		if _, ok := session.Metadata[key]; !ok {
+			continue
+		}
+
+		delete(session.Metadata, key)
+		changed = true
+	}
+
+	if len(session.Metadata) == 0 {
+		session.Metadata = nil
+	}
+
+	return changed
+}
+
+// ChangeSessionType changes the type of the session with the given local public
+// key. ErrStateMismatch is returned if the session is already revoked.
+func (db *DB) ChangeSessionType(key *btcec.PublicKey, typ Type) error {
+	return db.updateSession(key, func(session *Session) error {
+		if session.State == StateRevoked {
+			return ErrStateMismatch
+		}
+
+		session.Type = typ
+		return nil
+	})
+}
+
+// AddSessionCaveat appends the given caveat to the macaroon recipe of the
+// session with the given local public key. A caveat the recipe already contains
+// isn't added again.
+func (db *DB) AddSessionCaveat(key *btcec.PublicKey,
+	caveat macaroon.Caveat) error {
+
+	return db.updateSession(key, func(session *Session) error {
+		if session.MacaroonRecipe == nil {
+			session.MacaroonRecipe = &MacaroonRecipe{}
+		}
+
+		for _, existing := range session.MacaroonRecipe.Caveats {
+			if bytes.Equal(existing.Id, caveat.Id) {
+				return nil
+			}
+		}
+
+		session.MacaroonRecipe.Caveats = append(
+			session.MacaroonRecipe.Caveats, caveat,
+		)
+		return nil
+	})
+}
+
+// MarkPendingApproval marks the session with the given local public key as
+// waiting for approval if it still requires approval. False is returned if the
+// session doesn't require approval (anymore), its state isn't changed then.
+// ErrStateMismatch is returned if the session isn't usable anymore.
+func (db *DB) MarkPendingApproval(key *btcec.PublicKey) (bool, error) {
+	var pending bool
+	err := db.updateSession(key, func(session *Session) error {
+		switch session.State {
+		case StateRevoked, StateExpired, StateQuarantined:
+			return ErrStateMismatch
+		}
+
+		pending = session.RequireApproval
+		if pending {
+			session.State = StatePendingApproval
+		}
+
+		return nil
+	})
+
+	return pending, err
+}
+
+// ApproveSession approves the session with the given local public key that is
+// waiting for approval. The approval is permanent, the session doesn't require
+// approval for later connections anymore. ErrStateMismatch is returned if the
+// session isn't waiting for approval.
+func (db *DB) ApproveSession(key *btcec.PublicKey) error {
+	return db.updateSession(key, func(session *Session) error {
+		if session.State != StatePendingApproval {
+			return ErrStateMismatch
+		}
+
+		session.State = StateCreated
+		session.RequireApproval = false
+		return nil
+	})
+}
+
+// ResetSession puts the session with the given local public key that wasn't
+// fully paired yet back into the created state, so its pairing can be retried.
+// ErrStateMismatch is returned if the session is in use or isn't usable
+// anymore.
+func (db *DB) ResetSession(key *btcec.PublicKey) error {
+	return db.updateSession(key, func(session *Session) error {
+		switch session.State {
+		case StateCreated, StateStarting, StatePendingApproval:
+
+		default:
+			return ErrStateMismatch
+		}
+
+		session.State = StateCreated
+		session.StartingFrom = StateCreated
+		return nil
+	})
+}
+
+// SuspendSession marks the session with the given local public key as
+// suspended until its next access window opens. ErrStateMismatch is returned if
+// the session isn't usable anymore.
+func (db *DB) SuspendSession(key *btcec.PublicKey) error {
+	return db.updateSession(key, func(session *Session) error {
+		switch session.State {
+		case StateCreated, StateInUse, StateStarting,
+			StatePendingApproval, StateSuspended:
+
+		default:
+			return ErrStateMismatch
+		}
+
+		session.State = StateSuspended
+		return nil
+	})
+}
+
+// UpdateSessionMailbox moves the session with the given local public key to the
+// mailbox server with the given address. The new address is removed from the
+// fallback servers and the TLS verification is only skipped if the new server
+// is a development server as well.
+func (db *DB) UpdateSessionMailbox(key *btcec.PublicKey, serverAddr string,
+	devServer bool) error {
+
+	return db.updateSession(key, func(session *Session) error {
+		var fallbacks []string
+		for _, addr := range session.FallbackServerAddrs {
+			if addr != serverAddr {
+				fallbacks = append(fallbacks, addr)
+			}
+		}
+
+		session.ServerAddr = serverAddr
+		session.FallbackServerAddrs = fallbacks
+		session.DevServer = devServer
+		session.InsecureSkipVerify = session.InsecureSkipVerify &&
+			devServer
+
+		return nil
+	})
+}
+
+// updateSession applies the given update function to the session with the
+// given local public key and stores the result, all within a single database
+// transaction.
+func (db *DB) updateSession(key *btcec.PublicKey,
+	update func(session *Session) error) error {
+
+	var (
+		session   *Session
+		prevState State
+	)
+	err := db.Update(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		session, err = db.getSession(sessionBucket, key)
+		if err != nil {
+			return err
+		}
+		prevState = session.State
+
+		if err := update(session); err != nil {
+			return err
+		}
+
+		err = db.putSession(
+			sessionBucket, getSessionKey(session), session,
+		)
+		if err != nil {
+			return err
+		}
+
+		return putStateAuditEvent(tx, session, prevState)
 	})
 	if err != nil {
 		return err
 	}
 
-	session.State = StateRevoked
-	return db.StoreSession(session)
+	if prevState != session.State {
+		db.notifyStateChange(session, prevState, false)
+	}
+
+	return nil
+}
+
+// putSession increments the revision of the given session and writes it to the
+// session bucket. If the write fails, the session's revision is left
+// untouched.
+func (db *DB) putSession(sessionBucket *bbolt.Bucket, sessionKey []byte,
+	session *Session) error {
+
+	session.Revision++
+
+	var buf bytes.Buffer
+	err := db.encodeSession(&buf, session)
+	if err == nil {
+		err = sessionBucket.Put(sessionKey, buf.Bytes())
+	}
+	if err != nil {
+		session.Revision--
+		return err
+	}
+
+	return nil
+}
+
+// putStateAuditEvent records the state change of the given session in the
+// audit log as part of the given transaction, if the state changed and the new
+// state is one that is audited.
+func putStateAuditEvent(tx *bbolt.Tx, session *Session,
+	prevState State) error {
+
+	if prevState == session.State {
+		return nil
+	}
+
+	typ, ok := stateAuditEvent(session.State)
+	if !ok {
+		return nil
+	}
+
+	return putAuditEvent(tx, session.LocalPublicKey, typ)
+}
+
+// isSameSession returns true if both sessions are versions of the same session
+// and not just two sessions that share the same local public key. A session's
+// pairing secret and macaroon root key never change, so those identify it.
+func isSameSession(a, b *Session) bool {
+	return a.PairingSecret == b.PairingSecret &&
+		a.MacaroonRootKey == b.MacaroonRootKey
+}
+
+// getSession reads and deserializes the session with the given local public
+// key from the session bucket.
+func (db *DB) getSession(sessionBucket *bbolt.Bucket,
+	key *btcec.PublicKey) (*Session, error) {
+
+	sessionKey := key.SerializeCompressed()
+	sessionBytes := sessionBucket.Get(sessionKey)
+	if len(sessionBytes) == 0 {
+		return nil, ErrSessionNotFound
+	}
+
+	return db.decodeSession(sessionKey, sessionBytes)
 }
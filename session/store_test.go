@@ -0,0 +1,773 @@
+package session
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+// newTestDB creates a new session DB in a temporary directory that is closed
+// once the test completes.
+func newTestDB(t *testing.T) *DB {
+	db, err := NewDB(t.TempDir(), DBFilename)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	return db
+}
+
+// newTestSession creates a new session with the given label that expires in
+// one day.
+func newTestSession(t *testing.T, label string) *Session {
+	session, err := NewSession(
+		label, TypeMacaroonAdmin, time.Now().Add(24*time.Hour),
+		"foo.bar.baz:1234", false, nil, nil,
+	)
+	require.NoError(t, err)
+
+	return session
+}
+
+// TestSessionDescription makes sure that a session's description is persisted
+// and can be updated.
+func TestSessionDescription(t *testing.T) {
+	db := newTestDB(t)
+
+	session := newTestSession(t, "description")
+	session.Description = "a longer note about what this session is for"
+	require.NoError(t, db.StoreSession(session))
+
+	sessions, err := db.ListSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, session.Description, sessions[0].Description)
+
+	err = db.UpdateSessionDescription(session.LocalPublicKey, "updated")
+	require.NoError(t, err)
+
+	updated, err := db.GetSession(session.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, "updated", updated.Description)
+	require.Equal(t, session.Label, updated.Label)
+
+	// Updating a session that doesn't exist should fail.
+	unknown := newTestSession(t, "unknown")
+	err = db.UpdateSessionDescription(unknown.LocalPublicKey, "updated")
+	require.ErrorIs(t, err, ErrSessionNotFound)
+}
+
+// TestSwapSessionState makes sure that the state of a session is only swapped
+// if the session is in the expected state.
+func TestSwapSessionState(t *testing.T) {
+	db := newTestDB(t)
+
+	session := newTestSession(t, "swap")
+	require.NoError(t, db.StoreSession(session))
+
+	key := session.LocalPublicKey
+	err := db.SwapSessionState(key, StateCreated, StateStarting)
+	require.NoError(t, err)
+
+	stored, err := db.GetSession(key)
+	require.NoError(t, err)
+	require.Equal(t, StateStarting, stored.State)
+
+	// A session that's no longer in the expected state is left untouched.
+	require.NoError(t, db.RevokeSession(key, ""))
+	err = db.SwapSessionState(key, StateStarting, StateCreated)
+	require.ErrorIs(t, err, ErrStateMismatch)
+
+	stored, err = db.GetSession(key)
+	require.NoError(t, err)
+	require.Equal(t, StateRevoked, stored.State)
+}
+
+// TestApproveSession makes sure that a session that requires approval is only
+// marked as pending until it was approved once.
+func TestApproveSession(t *testing.T) {
+	db := newTestDB(t)
+
+	session := newTestSession(t, "approval")
+	session.RequireApproval = true
+	require.NoError(t, db.StoreSession(session))
+
+	// A session can only be approved while it is waiting for approval.
+	key := session.LocalPublicKey
+	require.ErrorIs(t, db.ApproveSession(key), ErrStateMismatch)
+
+	pending, err := db.MarkPendingApproval(key)
+	require.NoError(t, err)
+	require.True(t, pending)
+
+	stored, err := db.GetSession(key)
+	require.NoError(t, err)
+	require.Equal(t, StatePendingApproval, stored.State)
+
+	require.NoError(t, db.ApproveSession(key))
+
+	stored, err = db.GetSession(key)
+	require.NoError(t, err)
+	require.Equal(t, StateCreated, stored.State)
+	require.False(t, stored.RequireApproval)
+
+	// Once approved, later connections don't need approval anymore.
+	pending, err = db.MarkPendingApproval(key)
+	require.NoError(t, err)
+	require.False(t, pending)
+
+	// A revoked session can't wait for approval.
+	require.NoError(t, db.RevokeSession(key, ""))
+	_, err = db.MarkPendingApproval(key)
+	require.ErrorIs(t, err, ErrStateMismatch)
+}
+
+// TestMarkSessionConnected makes sure that a session is marked as in use once a
+// client connected and that its first connection is only recorded once.
+func TestMarkSessionConnected(t *testing.T) {
+	db := newTestDB(t)
+
+	session := newTestSession(t, "connected")
+	require.NoError(t, db.StoreSession(session))
+
+	key := session.LocalPublicKey
+	require.NoError(t, db.MarkSessionConnected(key))
+
+	stored, err := db.GetSession(key)
+	require.NoError(t, err)
+	require.Equal(t, StateInUse, stored.State)
+	require.False(t, stored.FirstConnectedAt.IsZero())
+
+	// A later connection doesn't move the first connection.
+	firstConnected := stored.FirstConnectedAt
+	require.NoError(t, db.MarkSessionConnected(key))
+
+	stored, err = db.GetSession(key)
+	require.NoError(t, err)
+	require.True(t, firstConnected.Equal(stored.FirstConnectedAt))
+
+	// A revoked session isn't brought back into use.
+	require.NoError(t, db.RevokeSession(key, ""))
+	err = db.MarkSessionConnected(key)
+	require.ErrorIs(t, err, ErrStateMismatch)
+
+	stored, err = db.GetSession(key)
+	require.NoError(t, err)
+	require.Equal(t, StateRevoked, stored.State)
+}
+
+// TestStoreSessionConflict makes sure that a session that was modified since
+// it was read can't be stored without reloading it first, so no updates are
+// lost when the same session is written concurrently.
+func TestStoreSessionConflict(t *testing.T) {
+	db := newTestDB(t)
+
+	session := newTestSession(t, "conflict")
+	require.NoError(t, db.StoreSession(session))
+	require.EqualValues(t, 1, session.Revision)
+
+	first, err := db.GetSession(session.LocalPublicKey)
+	require.NoError(t, err)
+	second, err := db.GetSession(session.LocalPublicKey)
+	require.NoError(t, err)
+
+	first.Label = "updated"
+	require.NoError(t, db.StoreSession(first))
+
+	// The second copy is now stale and must be reloaded before it can be
+	// stored.
+	second.Description = "updated"
+	require.ErrorIs(t, db.StoreSession(second), ErrSessionConflict)
+
+	second, err = db.GetSession(session.LocalPublicKey)
+	require.NoError(t, err)
+	second.Description = "updated"
+	require.NoError(t, db.StoreSession(second))
+
+	stored, err := db.GetSession(session.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, "updated", stored.Label)
+	require.Equal(t, "updated", stored.Description)
+	require.EqualValues(t, 3, stored.Revision)
+
+	// Now let many writers modify the session concurrently and retry on
+	// conflicts. Every single update must end up in the stored session.
+	const numWriters = 20
+	var wg sync.WaitGroup
+	for i := 0; i < numWriters; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				s, err := db.GetSession(session.LocalPublicKey)
+				require.NoError(t, err)
+
+				s.Description += "x"
+				err = db.StoreSession(s)
+				if errors.Is(err, ErrSessionConflict) {
+					continue
+				}
+				require.NoError(t, err)
+
+				return
+			}
+		}()
+	}
+	wg.Wait()
+
+	stored, err = db.GetSession(session.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(
+		t, "updated"+strings.Repeat("x", numWriters),
+		stored.Description,
+	)
+}
+
+// TestStoreSessionExists makes sure that a session can't be overwritten by a
+// different session that uses the same local public key, while updates of the
+// same session still succeed.
+func TestStoreSessionExists(t *testing.T) {
+	db := newTestDB(t)
+
+	session := newTestSession(t, "original")
+	require.NoError(t, db.StoreSession(session))
+
+	colliding := newTestSession(t, "colliding")
+	colliding.LocalPublicKey = session.LocalPublicKey
+	colliding.LocalPrivateKey = session.LocalPrivateKey
+	colliding.Revision = session.Revision
+	require.ErrorIs(t, db.StoreSession(colliding), ErrSessionExists)
+
+	stored, err := db.GetSession(session.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, "original", stored.Label)
+
+	stored.State = StateRevoked
+	require.NoError(t, db.StoreSession(stored))
+
+	stored, err = db.GetSession(session.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, StateRevoked, stored.State)
+}
+
+// TestReplaceSessionStore makes sure that replacing a session stores the new
+// session and revokes the old one in a single step and that neither happens if
+// the replacement isn't possible.
+func TestReplaceSessionStore(t *testing.T) {
+	db := newTestDB(t)
+
+	old := newTestSession(t, "bot")
+	require.NoError(t, db.StoreSession(old))
+
+	replacement := newTestSession(t, "bot")
+	require.NoError(t, db.ReplaceSession(
+		old.LocalPublicKey, replacement, "replaced",
+	))
+
+	stored, err := db.GetSession(old.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, StateRevoked, stored.State)
+	require.Equal(t, "replaced", stored.RevokeReason)
+
+	stored, err = db.GetSession(replacement.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, StateCreated, stored.State)
+	require.Equal(t, "bot", stored.Label)
+
+	// A revoked session can't be replaced again, and the new session isn't
+	// stored in that case.
+	another := newTestSession(t, "bot")
+	err = db.ReplaceSession(old.LocalPublicKey, another, "replaced")
+	require.ErrorIs(t, err, ErrStateMismatch)
+	_, err = db.GetSession(another.LocalPublicKey)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+
+	// The new session must not exist yet.
+	err = db.ReplaceSession(
+		replacement.LocalPublicKey, replacement, "replaced",
+	)
+	require.ErrorIs(t, err, ErrSessionExists)
+
+	// Reverting the replacement revokes the new session and moves the old
+	// one back to its previous state.
+	err = db.RevertReplaceSession(
+		old.LocalPublicKey, StateCreated, replacement.LocalPublicKey,
+		"start failed",
+	)
+	require.NoError(t, err)
+
+	stored, err = db.GetSession(old.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, StateCreated, stored.State)
+	require.Empty(t, stored.RevokeReason)
+	require.True(t, stored.RevokedAt.IsZero())
+
+	stored, err = db.GetSession(replacement.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, StateRevoked, stored.State)
+	require.Equal(t, "start failed", stored.RevokeReason)
+
+	// Only a revoked session can be reverted.
+	err = db.RevertReplaceSession(
+		old.LocalPublicKey, StateCreated, replacement.LocalPublicKey,
+		"start failed",
+	)
+	require.ErrorIs(t, err, ErrStateMismatch)
+}
+
+// TestRevokeExpiredSessions makes sure that many sessions are revoked in a
+// single transaction, that none of them is revoked if one can't be, that
+// sessions which no longer qualify are skipped and that the batch needs fewer
+// writes than revoking each session on its own.
+func TestRevokeExpiredSessions(t *testing.T) {
+	const numSessions = 20
+
+	storeSessions := func(db *DB) []*btcec.PublicKey {
+		keys := make([]*btcec.PublicKey, numSessions)
+		for i := range keys {
+			session := newTestSession(t, fmt.Sprintf("batch %d", i))
+			require.NoError(t, db.StoreSession(session))
+			keys[i] = session.LocalPublicKey
+		}
+
+		return keys
+	}
+
+	// The test sessions expire in a day, so they count as expired a bit
+	// later than that.
+	now := time.Now().Add(25 * time.Hour)
+
+	db := newTestDB(t)
+	keys := storeSessions(db)
+
+	// If one of the sessions doesn't exist, none of them is revoked.
+	unknown := newTestSession(t, "unknown")
+	_, err := db.RevokeExpiredSessions(
+		append(keys[:numSessions:numSessions], unknown.LocalPublicKey),
+		"expired", now,
+	)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+	for _, key := range keys {
+		stored, err := db.GetSession(key)
+		require.NoError(t, err)
+		require.Equal(t, StateCreated, stored.State)
+	}
+
+	before := db.Stats().TxStats.Write
+	revoked, err := db.RevokeExpiredSessions(keys, "expired", now)
+	require.NoError(t, err)
+	batchWrites := db.Stats().TxStats.Write - before
+	require.Len(t, revoked, numSessions)
+
+	for _, key := range keys {
+		stored, err := db.GetSession(key)
+		require.NoError(t, err)
+		require.Equal(t, StateRevoked, stored.State)
+		require.Equal(t, "expired", stored.RevokeReason)
+		require.False(t, stored.RevokedAt.IsZero())
+	}
+
+	// Revoking the same sessions one by one takes at least one write per
+	// session.
+	individualDB := newTestDB(t)
+	keys = storeSessions(individualDB)
+
+	before = individualDB.Stats().TxStats.Write
+	for _, key := range keys {
+		require.NoError(t, individualDB.RevokeSession(key, "expired"))
+	}
+	individualWrites := individualDB.Stats().TxStats.Write - before
+
+	require.GreaterOrEqual(t, individualWrites, numSessions)
+	require.Less(t, batchWrites, individualWrites)
+	t.Logf("Batch revocation took %d writes instead of %d", batchWrites,
+		individualWrites)
+
+	// A session that was extended or revoked after it was selected is
+	// skipped and keeps its state and revocation reason.
+	skipDB := newTestDB(t)
+	keys = storeSessions(skipDB)
+
+	err = skipDB.UpdateSessionExpiry(keys[0], now.Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, skipDB.RevokeSession(keys[1], "manual"))
+
+	revoked, err = skipDB.RevokeExpiredSessions(keys, "expired", now)
+	require.NoError(t, err)
+	require.Len(t, revoked, numSessions-2)
+
+	stored, err := skipDB.GetSession(keys[0])
+	require.NoError(t, err)
+	require.Equal(t, StateCreated, stored.State)
+
+	stored, err = skipDB.GetSession(keys[1])
+	require.NoError(t, err)
+	require.Equal(t, StateRevoked, stored.State)
+	require.Equal(t, "manual", stored.RevokeReason)
+}
+
+// TestUpdateSessionsMetadata makes sure that metadata keys are set and removed
+// on all given sessions at once and that only changed sessions are counted.
+func TestUpdateSessionsMetadata(t *testing.T) {
+	db := newTestDB(t)
+
+	first := newTestSession(t, "first")
+	require.NoError(t, db.StoreSession(first))
+	second := newTestSession(t, "second")
+	require.NoError(t, db.StoreSession(second))
+	keys := []*btcec.PublicKey{first.LocalPublicKey, second.LocalPublicKey}
+
+	numUpdated, err := db.UpdateSessionsMetadata(
+		keys, map[string]string{"owner": "teamA", "env": "prod"}, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, numUpdated)
+
+	// Setting a key to its current value doesn't change the session.
+	before, err := db.GetSession(first.LocalPublicKey)
+	require.NoError(t, err)
+	numUpdated, err = db.UpdateSessionsMetadata(
+		keys[:1], map[string]string{"owner": "teamA"}, nil,
+	)
+	require.NoError(t, err)
+	require.Zero(t, numUpdated)
+	after, err := db.GetSession(first.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, before.Revision, after.Revision)
+
+	numUpdated, err = db.UpdateSessionsMetadata(
+		keys, map[string]string{"owner": "platform"},
+		[]string{"env", "unknown"},
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, numUpdated)
+	for _, key := range keys {
+		stored, err := db.GetSession(key)
+		require.NoError(t, err)
+		require.Equal(
+			t, map[string]string{"owner": "platform"},
+			stored.Metadata,
+		)
+	}
+
+	// If one of the sessions doesn't exist, none of them is updated.
+	unknown := newTestSession(t, "unknown")
+	_, err = db.UpdateSessionsMetadata(
+		append(keys, unknown.LocalPublicKey), nil, []string{"owner"},
+	)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+	stored, err := db.GetSession(first.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, "platform", stored.Metadata["owner"])
+}
+
+// TestSubscribeStateChanges makes sure that subscribers are notified about new
+// sessions and state changes but not about other updates.
+func TestSubscribeStateChanges(t *testing.T) {
+	db := newTestDB(t)
+
+	sub, err := db.SubscribeStateChanges()
+	require.NoError(t, err)
+	defer sub.Cancel()
+
+	receive := func() *StateChange {
+		select {
+		case update := <-sub.Updates():
+			return update.(*StateChange)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no state change received")
+			return nil
+		}
+	}
+
+	session := newTestSession(t, "events")
+	require.NoError(t, db.StoreSession(session))
+
+	change := receive()
+	require.True(t, change.Created)
+	require.Equal(t, StateCreated, change.NewState)
+
+	// Updating the description doesn't change the state, so the next
+	// update is the revocation.
+	err = db.UpdateSessionDescription(session.LocalPublicKey, "foo")
+	require.NoError(t, err)
+	require.NoError(t, db.RevokeSession(session.LocalPublicKey, ""))
+
+	change = receive()
+	require.False(t, change.Created)
+	require.Equal(t, StateCreated, change.PrevState)
+	require.Equal(t, StateRevoked, change.NewState)
+	require.Equal(t, "foo", change.Session.Description)
+	require.False(t, change.ExpiringSoon)
+
+	// Expiry warnings are only sent for sessions that are still active.
+	active := newTestSession(t, "active")
+	require.NoError(t, db.StoreSession(active))
+	require.True(t, receive().Created)
+
+	require.NoError(t, db.NotifyExpiringSoon(session.LocalPublicKey))
+	require.NoError(t, db.NotifyExpiringSoon(active.LocalPublicKey))
+
+	change = receive()
+	require.True(t, change.ExpiringSoon)
+	require.Equal(t, "active", change.Session.Label)
+	require.Equal(t, StateCreated, change.NewState)
+}
+
+// TestListSessionsConcurrentWrites makes sure that listing the sessions while
+// other sessions are added and revoked never returns a partially written
+// session and that the view never goes back in time.
+func TestListSessionsConcurrentWrites(t *testing.T) {
+	db := newTestDB(t)
+
+	const numSessions = 50
+
+	sessions := make([]*Session, numSessions)
+	for i := range sessions {
+		sessions[i] = newTestSession(t, fmt.Sprintf("session-%d", i))
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for _, session := range sessions {
+			if err := db.StoreSession(session); err != nil {
+				t.Errorf("unable to store session: %v", err)
+				return
+			}
+
+			err := db.RevokeSession(session.LocalPublicKey, "test")
+			if err != nil {
+				t.Errorf("unable to revoke session: %v", err)
+				return
+			}
+		}
+	}()
+
+	var lastNumSessions, lastNumRevoked int
+	for finished := false; !finished; {
+		select {
+		case <-done:
+			finished = true
+		default:
+		}
+
+		listed, err := db.ListSessions()
+		require.NoError(t, err)
+
+		var numRevoked int
+		for _, session := range listed {
+			require.NotNil(t, session.LocalPublicKey)
+			require.True(t, strings.HasPrefix(
+				session.Label, "session-",
+			))
+
+			// The state and the revocation details are written
+			// together, so they must always be seen together.
+			if session.State != StateRevoked {
+				require.True(t, session.RevokedAt.IsZero())
+				continue
+			}
+
+			numRevoked++
+			require.False(t, session.RevokedAt.IsZero())
+			require.Equal(t, "test", session.RevokeReason)
+		}
+
+		require.GreaterOrEqual(t, len(listed), lastNumSessions)
+		require.GreaterOrEqual(t, numRevoked, lastNumRevoked)
+		lastNumSessions, lastNumRevoked = len(listed), numRevoked
+	}
+
+	require.Equal(t, numSessions, lastNumSessions)
+	require.Equal(t, numSessions, lastNumRevoked)
+}
+
+// TestCompact makes sure that compacting a database with lots of deleted
+// sessions shrinks it and leaves the remaining sessions intact.
+func TestCompact(t *testing.T) {
+	db := newTestDB(t)
+
+	var keep []*Session
+	for i := 0; i < 200; i++ {
+		session := newTestSession(t, fmt.Sprintf("session-%d", i))
+		session.Description = strings.Repeat("x", 1000)
+		require.NoError(t, db.StoreSession(session))
+
+		if i%10 == 0 {
+			keep = append(keep, session)
+		}
+	}
+
+	// Delete all sessions we don't keep to free up their space.
+	err := db.Update(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		// The bucket must not be modified while iterating over it,
+		// so we collect the keys to delete first.
+		var remove [][]byte
+		err = sessionBucket.ForEach(func(k, _ []byte) error {
+			for _, session := range keep {
+				if bytes.Equal(k, getSessionKey(session)) {
+					return nil
+				}
+			}
+
+			remove = append(remove, append([]byte(nil), k...))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range remove {
+			if err := sessionBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	sizeBefore, sizeAfter, err := db.Compact()
+	require.NoError(t, err)
+	require.Less(t, sizeAfter, sizeBefore)
+
+	sessions, err := db.ListSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, len(keep))
+
+	for _, session := range keep {
+		dbSession, err := db.GetSession(session.LocalPublicKey)
+		require.NoError(t, err)
+		require.Equal(t, session.Label, dbSession.Label)
+		require.Equal(t, session.Description, dbSession.Description)
+	}
+
+	// The compacted database remains writable.
+	require.NoError(t, db.RevokeSession(keep[0].LocalPublicKey, ""))
+}
+
+// TestCompactReopenFailure makes sure that the original database is restored if
+// the compacted one can't be opened and that the database is marked as unusable
+// if neither of them can be opened.
+func TestCompactReopenFailure(t *testing.T) {
+	db := newTestDB(t)
+
+	session := newTestSession(t, "session")
+	require.NoError(t, db.StoreSession(session))
+
+	t.Cleanup(func() {
+		reopenDB = initDB
+	})
+
+	// Only the compacted database fails to open, so we fall back to the
+	// original one.
+	errOpen := errors.New("open failed")
+	numOpens := 0
+	reopenDB = func(path string, firstInit bool) (*bbolt.DB, error) {
+		numOpens++
+		if numOpens == 1 {
+			return nil, errOpen
+		}
+
+		return initDB(path, firstInit)
+	}
+
+	_, _, err := db.Compact()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), errOpen.Error())
+	require.Equal(t, 2, numOpens)
+
+	dbSession, err := db.GetSession(session.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, session.Label, dbSession.Label)
+	require.NoError(t, db.RevokeSession(session.LocalPublicKey, ""))
+	require.NoFileExists(t, db.path+".orig")
+	require.NoFileExists(t, db.path+".compact")
+
+	// If the original database can't be opened either, all transactions
+	// fail instead of using the closed database.
+	reopenDB = func(string, bool) (*bbolt.DB, error) {
+		return nil, errOpen
+	}
+
+	_, _, err = db.Compact()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), errOpen.Error())
+
+	_, err = db.GetSession(session.LocalPublicKey)
+	require.ErrorIs(t, err, ErrDBUnusable)
+
+	_, _, err = db.Compact()
+	require.ErrorIs(t, err, ErrDBUnusable)
+}
+
+// TestInvalidRecords makes sure that session records that can't be used as a
+// session are skipped when listing the sessions and reported separately.
+func TestInvalidRecords(t *testing.T) {
+	db := newTestDB(t)
+
+	valid := newTestSession(t, "valid")
+	require.NoError(t, db.StoreSession(valid))
+
+	unknownType := newTestSession(t, "unknown type")
+	unknownType.Type = Type(99)
+	require.NoError(t, db.StoreSession(unknownType))
+
+	moved := newTestSession(t, "moved")
+	var movedBytes bytes.Buffer
+	require.NoError(t, SerializeSession(&movedBytes, moved))
+
+	corruptKey := []byte("corrupt")
+	movedKey := getSessionKey(valid)[:10]
+	err := db.Update(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		err = sessionBucket.Put(corruptKey, []byte{0xff, 0xff, 0x01})
+		if err != nil {
+			return err
+		}
+
+		return sessionBucket.Put(movedKey, movedBytes.Bytes())
+	})
+	require.NoError(t, err)
+
+	sessions, err := db.ListSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, "valid", sessions[0].Label)
+
+	invalid, err := db.InvalidRecords()
+	require.NoError(t, err)
+	require.Len(t, invalid, 3)
+
+	reasons := make(map[string]string, len(invalid))
+	for _, record := range invalid {
+		reasons[string(record.Key)] = record.Reason
+	}
+	require.Contains(t, reasons, string(corruptKey))
+	require.Contains(t, reasons, string(movedKey))
+	require.Equal(
+		t, "unknown session type 99",
+		reasons[string(getSessionKey(unknownType))],
+	)
+}
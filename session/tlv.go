@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"sort"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
@@ -25,6 +26,39 @@ const (
 	typeRemotePublicKey tlv.Type = 11
 	typeMacaroonRecipe  tlv.Type = 12
 
+	typeInsecureSkipVerify tlv.Type = 13
+	typeDescription        tlv.Type = 14
+	typeRevision           tlv.Type = 15
+	typeFallbackServers    tlv.Type = 16
+	typeSuppressSecret     tlv.Type = 17
+	typeAutoRenewUntil     tlv.Type = 18
+	typeRenewInterval      tlv.Type = 19
+	typeOwner              tlv.Type = 20
+	typeSingleUse          tlv.Type = 21
+	typeInactivityExpiry   tlv.Type = 22
+	typeRevokedAt          tlv.Type = 23
+	typeRevokeReason       tlv.Type = 24
+	typeLastResumeAttempt  tlv.Type = 25
+	typeLastResumeError    tlv.Type = 26
+	typeActivationTime     tlv.Type = 27
+	typeGroupID            tlv.Type = 28
+	typeCreatedAt          tlv.Type = 29
+	typeKeepaliveInterval  tlv.Type = 30
+	typeHandshakeTimeout   tlv.Type = 31
+	typeStartingFrom       tlv.Type = 32
+	typeRestorableUntil    tlv.Type = 33
+	typeMacaroonExpiry     tlv.Type = 34
+	typeRemoteDisplayName  tlv.Type = 35
+	typeMaxStreams         tlv.Type = 36
+	typeRequireApproval    tlv.Type = 37
+	typePriority           tlv.Type = 38
+	typeMnemonicVersion    tlv.Type = 39
+	typeAccessSchedule     tlv.Type = 40
+	typeHardDeadline       tlv.Type = 41
+	typeSealedSecrets      tlv.Type = 42
+	typeMetadata           tlv.Type = 43
+	typeFirstConnectedAt   tlv.Type = 44
+
 	// typeMacaroon is no longer used, but we leave it defined for backwards
 	// compatibility.
 	typeMacaroon tlv.Type = 8
@@ -43,6 +77,15 @@ const (
 // SerializeSession binary serializes the given session to the writer using the
 // tlv format.
 func SerializeSession(w io.Writer, session *Session) error {
+	return serializeSession(w, session, nil)
+}
+
+// serializeSession binary serializes the given session to the writer using the
+// tlv format. If sealed secrets are given, they are written instead of the
+// plaintext secrets of the session.
+func serializeSession(w io.Writer, session *Session,
+	sealedSecrets []byte) error {
+
 	if session == nil {
 		return fmt.Errorf("session cannot be nil")
 	}
@@ -54,6 +97,10 @@ func SerializeSession(w io.Writer, session *Session) error {
 		expiry        = uint64(session.Expiry.Unix())
 		serverAddr    = []byte(session.ServerAddr)
 		devServer     = uint8(0)
+		skipVerify    = uint8(0)
+		suppress      = uint8(0)
+		singleUse     = uint8(0)
+		description   = []byte(session.Description)
 		pairingSecret = session.PairingSecret[:]
 		privateKey    = session.LocalPrivateKey.Serialize()
 	)
@@ -62,6 +109,18 @@ func SerializeSession(w io.Writer, session *Session) error {
 		devServer = 1
 	}
 
+	if session.InsecureSkipVerify {
+		skipVerify = 1
+	}
+
+	if session.SuppressPairingSecret {
+		suppress = 1
+	}
+
+	if session.SingleUse {
+		singleUse = 1
+	}
+
 	tlvRecords := []tlv.Record{
 		tlv.MakePrimitiveRecord(typeLabel, &label),
 		tlv.MakePrimitiveRecord(typeState, &state),
@@ -69,16 +128,22 @@ func SerializeSession(w io.Writer, session *Session) error {
 		tlv.MakePrimitiveRecord(typeExpiry, &expiry),
 		tlv.MakePrimitiveRecord(typeServerAddr, &serverAddr),
 		tlv.MakePrimitiveRecord(typeDevServer, &devServer),
-		tlv.MakePrimitiveRecord(
-			typeMacaroonRootKey, &session.MacaroonRootKey,
-		),
 	}
 
-	tlvRecords = append(
-		tlvRecords,
-		tlv.MakePrimitiveRecord(typePairingSecret, &pairingSecret),
-		tlv.MakePrimitiveRecord(typeLocalPrivateKey, &privateKey),
-	)
+	if sealedSecrets == nil {
+		tlvRecords = append(
+			tlvRecords,
+			tlv.MakePrimitiveRecord(
+				typeMacaroonRootKey, &session.MacaroonRootKey,
+			),
+			tlv.MakePrimitiveRecord(
+				typePairingSecret, &pairingSecret,
+			),
+			tlv.MakePrimitiveRecord(
+				typeLocalPrivateKey, &privateKey,
+			),
+		)
+	}
 
 	if session.RemotePublicKey != nil {
 		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
@@ -99,6 +164,224 @@ func SerializeSession(w io.Writer, session *Session) error {
 		))
 	}
 
+	tlvRecords = append(
+		tlvRecords,
+		tlv.MakePrimitiveRecord(typeInsecureSkipVerify, &skipVerify),
+		tlv.MakePrimitiveRecord(typeDescription, &description),
+		tlv.MakePrimitiveRecord(typeRevision, &session.Revision),
+	)
+
+	if len(session.FallbackServerAddrs) > 0 {
+		tlvRecords = append(tlvRecords, tlv.MakeDynamicRecord(
+			typeFallbackServers, &session.FallbackServerAddrs,
+			func() uint64 {
+				return recordSize(
+					stringsEncoder,
+					&session.FallbackServerAddrs,
+				)
+			},
+			stringsEncoder, stringsDecoder,
+		))
+	}
+
+	tlvRecords = append(
+		tlvRecords,
+		tlv.MakePrimitiveRecord(typeSuppressSecret, &suppress),
+	)
+
+	if session.AutoRenews() {
+		var (
+			renewUntil    = uint64(session.AutoRenewUntil.Unix())
+			renewInterval = uint64(session.RenewInterval)
+		)
+		tlvRecords = append(
+			tlvRecords,
+			tlv.MakePrimitiveRecord(typeAutoRenewUntil, &renewUntil),
+			tlv.MakePrimitiveRecord(
+				typeRenewInterval, &renewInterval,
+			),
+		)
+	}
+
+	if session.Owner != "" {
+		owner := []byte(session.Owner)
+		tlvRecords = append(
+			tlvRecords, tlv.MakePrimitiveRecord(typeOwner, &owner),
+		)
+	}
+
+	tlvRecords = append(
+		tlvRecords, tlv.MakePrimitiveRecord(typeSingleUse, &singleUse),
+	)
+
+	if session.InactivityExpiry != 0 {
+		inactivity := uint64(session.InactivityExpiry)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeInactivityExpiry, &inactivity,
+		))
+	}
+
+	if !session.RevokedAt.IsZero() {
+		revokedAt := uint64(session.RevokedAt.Unix())
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeRevokedAt, &revokedAt,
+		))
+	}
+
+	if session.RevokeReason != "" {
+		reason := []byte(session.RevokeReason)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeRevokeReason, &reason,
+		))
+	}
+
+	if !session.LastResumeAttempt.IsZero() {
+		attempt := uint64(session.LastResumeAttempt.Unix())
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeLastResumeAttempt, &attempt,
+		))
+	}
+
+	if session.LastResumeError != "" {
+		resumeErr := []byte(session.LastResumeError)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeLastResumeError, &resumeErr,
+		))
+	}
+
+	if !session.ActivationTime.IsZero() {
+		activation := uint64(session.ActivationTime.Unix())
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeActivationTime, &activation,
+		))
+	}
+
+	if session.GroupID != "" {
+		groupID := []byte(session.GroupID)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeGroupID, &groupID,
+		))
+	}
+
+	if !session.CreatedAt.IsZero() {
+		createdAt := uint64(session.CreatedAt.Unix())
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeCreatedAt, &createdAt,
+		))
+	}
+
+	if session.KeepaliveInterval != 0 {
+		keepalive := uint64(session.KeepaliveInterval)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeKeepaliveInterval, &keepalive,
+		))
+	}
+
+	if session.HandshakeTimeout != 0 {
+		handshake := uint64(session.HandshakeTimeout)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeHandshakeTimeout, &handshake,
+		))
+	}
+
+	if session.StartingFrom != 0 {
+		startingFrom := uint8(session.StartingFrom)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeStartingFrom, &startingFrom,
+		))
+	}
+
+	if !session.RestorableUntil.IsZero() {
+		restorableUntil := uint64(session.RestorableUntil.Unix())
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeRestorableUntil, &restorableUntil,
+		))
+	}
+
+	if session.MacaroonExpiry != 0 {
+		macExpiry := uint64(session.MacaroonExpiry)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeMacaroonExpiry, &macExpiry,
+		))
+	}
+
+	if session.RemoteDisplayName != "" {
+		displayName := []byte(session.RemoteDisplayName)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeRemoteDisplayName, &displayName,
+		))
+	}
+
+	if session.MaxConcurrentStreams != 0 {
+		maxStreams := session.MaxConcurrentStreams
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeMaxStreams, &maxStreams,
+		))
+	}
+
+	if session.RequireApproval {
+		requireApproval := uint8(1)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeRequireApproval, &requireApproval,
+		))
+	}
+
+	if session.Priority != 0 {
+		priority := session.Priority
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typePriority, &priority,
+		))
+	}
+
+	if session.MnemonicVersion != MnemonicVersionMailbox {
+		mnemonicVersion := uint8(session.MnemonicVersion)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeMnemonicVersion, &mnemonicVersion,
+		))
+	}
+
+	if session.AccessSchedule != nil {
+		schedule, err := serializeAccessSchedule(session.AccessSchedule)
+		if err != nil {
+			return err
+		}
+
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeAccessSchedule, &schedule,
+		))
+	}
+
+	if !session.HardDeadline.IsZero() {
+		hardDeadline := uint64(session.HardDeadline.Unix())
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeHardDeadline, &hardDeadline,
+		))
+	}
+
+	if sealedSecrets != nil {
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeSealedSecrets, &sealedSecrets,
+		))
+	}
+
+	if len(session.Metadata) > 0 {
+		metadata := flattenMetadata(session.Metadata)
+		tlvRecords = append(tlvRecords, tlv.MakeDynamicRecord(
+			typeMetadata, &metadata,
+			func() uint64 {
+				return recordSize(stringsEncoder, &metadata)
+			},
+			stringsEncoder, stringsDecoder,
+		))
+	}
+
+	if !session.FirstConnectedAt.IsZero() {
+		firstConnected := uint64(session.FirstConnectedAt.Unix())
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeFirstConnectedAt, &firstConnected,
+		))
+	}
+
 	tlvStream, err := tlv.NewStream(tlvRecords...)
 	if err != nil {
 		return err
@@ -110,13 +393,36 @@ func SerializeSession(w io.Writer, session *Session) error {
 // DeserializeSession deserializes a session from the given reader, expecting
 // the data to be encoded in the tlv format.
 func DeserializeSession(r io.Reader) (*Session, error) {
+	session, _, err := deserializeSession(r)
+	return session, err
+}
+
+// deserializeSession deserializes a session from the given reader, expecting
+// the data to be encoded in the tlv format. If the secrets of the session are
+// sealed, they are returned without being applied to the session.
+func deserializeSession(r io.Reader) (*Session, []byte, error) {
 	var (
 		session                   = &Session{}
 		label, serverAddr         []byte
+		description, owner        []byte
+		revokeReason, resumeErr   []byte
+		groupID, displayName      []byte
+		accessSchedule, sealed    []byte
 		pairingSecret, privateKey []byte
 		state, typ, devServer     uint8
+		skipVerify, suppress      uint8
+		singleUse, approval       uint8
 		expiry                    uint64
+		renewUntil, renewInterval uint64
+		inactivity, revokedAt     uint64
+		resumeAttempt, activation uint64
+		createdAt, restorable     uint64
+		keepalive, handshake      uint64
+		macExpiry, hardDeadline   uint64
+		firstConnected            uint64
+		startingFrom, mnemonicVer uint8
 		macRecipe                 MacaroonRecipe
+		metadata                  []string
 	)
 	tlvStream, err := tlv.NewStream(
 		tlv.MakePrimitiveRecord(typeLabel, &label),
@@ -137,14 +443,54 @@ func DeserializeSession(r io.Reader) (*Session, error) {
 			typeMacaroonRecipe, &macRecipe, nil,
 			macaroonRecipeEncoder, macaroonRecipeDecoder,
 		),
+		tlv.MakePrimitiveRecord(typeInsecureSkipVerify, &skipVerify),
+		tlv.MakePrimitiveRecord(typeDescription, &description),
+		tlv.MakePrimitiveRecord(typeRevision, &session.Revision),
+		tlv.MakeDynamicRecord(
+			typeFallbackServers, &session.FallbackServerAddrs, nil,
+			stringsEncoder, stringsDecoder,
+		),
+		tlv.MakePrimitiveRecord(typeSuppressSecret, &suppress),
+		tlv.MakePrimitiveRecord(typeAutoRenewUntil, &renewUntil),
+		tlv.MakePrimitiveRecord(typeRenewInterval, &renewInterval),
+		tlv.MakePrimitiveRecord(typeOwner, &owner),
+		tlv.MakePrimitiveRecord(typeSingleUse, &singleUse),
+		tlv.MakePrimitiveRecord(typeInactivityExpiry, &inactivity),
+		tlv.MakePrimitiveRecord(typeRevokedAt, &revokedAt),
+		tlv.MakePrimitiveRecord(typeRevokeReason, &revokeReason),
+		tlv.MakePrimitiveRecord(typeLastResumeAttempt, &resumeAttempt),
+		tlv.MakePrimitiveRecord(typeLastResumeError, &resumeErr),
+		tlv.MakePrimitiveRecord(typeActivationTime, &activation),
+		tlv.MakePrimitiveRecord(typeGroupID, &groupID),
+		tlv.MakePrimitiveRecord(typeCreatedAt, &createdAt),
+		tlv.MakePrimitiveRecord(typeKeepaliveInterval, &keepalive),
+		tlv.MakePrimitiveRecord(typeHandshakeTimeout, &handshake),
+		tlv.MakePrimitiveRecord(typeStartingFrom, &startingFrom),
+		tlv.MakePrimitiveRecord(typeRestorableUntil, &restorable),
+		tlv.MakePrimitiveRecord(typeMacaroonExpiry, &macExpiry),
+		tlv.MakePrimitiveRecord(typeRemoteDisplayName, &displayName),
+		tlv.MakePrimitiveRecord(
+			typeMaxStreams, &session.MaxConcurrentStreams,
+		),
+		tlv.MakePrimitiveRecord(typeRequireApproval, &approval),
+		tlv.MakePrimitiveRecord(typePriority, &session.Priority),
+		tlv.MakePrimitiveRecord(typeMnemonicVersion, &mnemonicVer),
+		tlv.MakePrimitiveRecord(typeAccessSchedule, &accessSchedule),
+		tlv.MakePrimitiveRecord(typeHardDeadline, &hardDeadline),
+		tlv.MakePrimitiveRecord(typeSealedSecrets, &sealed),
+		tlv.MakeDynamicRecord(
+			typeMetadata, &metadata, nil, stringsEncoder,
+			stringsDecoder,
+		),
+		tlv.MakePrimitiveRecord(typeFirstConnectedAt, &firstConnected),
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	parsedTypes, err := tlvStream.DecodeWithParsedTypes(r)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	session.Label = string(label)
@@ -153,13 +499,95 @@ func DeserializeSession(r io.Reader) (*Session, error) {
 	session.Expiry = time.Unix(int64(expiry), 0)
 	session.ServerAddr = string(serverAddr)
 	session.DevServer = devServer == 1
+	session.Description = string(description)
+	session.SuppressPairingSecret = suppress == 1
+	session.Owner = string(owner)
+	session.SingleUse = singleUse == 1
+	session.RequireApproval = approval == 1
+	session.InactivityExpiry = time.Duration(inactivity)
+	session.KeepaliveInterval = time.Duration(keepalive)
+	session.HandshakeTimeout = time.Duration(handshake)
+	session.MacaroonExpiry = time.Duration(macExpiry)
+	session.StartingFrom = State(startingFrom)
+	session.MnemonicVersion = MnemonicVersion(mnemonicVer)
+	session.RevokeReason = string(revokeReason)
+	session.LastResumeError = string(resumeErr)
+	session.GroupID = string(groupID)
+	session.RemoteDisplayName = string(displayName)
+
+	if _, ok := parsedTypes[typeAccessSchedule]; ok {
+		session.AccessSchedule, err = deserializeAccessSchedule(
+			accessSchedule,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid access "+
+				"schedule: %v", err)
+		}
+	}
+
+	if t, ok := parsedTypes[typeMetadata]; ok && t == nil {
+		session.Metadata, err = unflattenMetadata(metadata)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if _, ok := parsedTypes[typeRevokedAt]; ok {
+		session.RevokedAt = time.Unix(int64(revokedAt), 0)
+	}
+
+	if _, ok := parsedTypes[typeLastResumeAttempt]; ok {
+		session.LastResumeAttempt = time.Unix(int64(resumeAttempt), 0)
+	}
+
+	if _, ok := parsedTypes[typeActivationTime]; ok {
+		session.ActivationTime = time.Unix(int64(activation), 0)
+	}
+
+	if _, ok := parsedTypes[typeCreatedAt]; ok {
+		session.CreatedAt = time.Unix(int64(createdAt), 0)
+	}
+
+	if _, ok := parsedTypes[typeRestorableUntil]; ok {
+		session.RestorableUntil = time.Unix(int64(restorable), 0)
+	}
+
+	if _, ok := parsedTypes[typeHardDeadline]; ok {
+		session.HardDeadline = time.Unix(int64(hardDeadline), 0)
+	}
+
+	if _, ok := parsedTypes[typeFirstConnectedAt]; ok {
+		session.FirstConnectedAt = time.Unix(int64(firstConnected), 0)
+	}
+
+	if _, ok := parsedTypes[typeAutoRenewUntil]; ok {
+		session.AutoRenewUntil = time.Unix(int64(renewUntil), 0)
+		session.RenewInterval = time.Duration(renewInterval)
+	}
+
+	// Sessions stored before the skip verify flag was introduced always
+	// skipped the TLS verification for dev servers, so we keep that
+	// behavior if the flag isn't present.
+	session.InsecureSkipVerify = session.DevServer
+	if _, ok := parsedTypes[typeInsecureSkipVerify]; ok {
+		session.InsecureSkipVerify = skipVerify == 1
+	}
 
 	if t, ok := parsedTypes[typeMacaroonRecipe]; ok && t == nil {
 		session.MacaroonRecipe = &macRecipe
 	}
 
+	// A pairing secret of the wrong length can't be turned into a valid
+	// mnemonic, so we leave the secret zeroed instead of copying over a
+	// truncated or padded value.
 	if t, ok := parsedTypes[typePairingSecret]; ok && t == nil {
-		copy(session.PairingSecret[:], pairingSecret)
+		if len(pairingSecret) == len(session.PairingSecret) {
+			copy(session.PairingSecret[:], pairingSecret)
+		} else {
+			log.Warnf("Ignoring pairing secret %v of invalid "+
+				"length %d, expected %d", Redact(pairingSecret),
+				len(pairingSecret), len(session.PairingSecret))
+		}
 	}
 
 	if t, ok := parsedTypes[typeLocalPrivateKey]; ok && t == nil {
@@ -168,7 +596,11 @@ func DeserializeSession(r io.Reader) (*Session, error) {
 		)
 	}
 
-	return session, nil
+	if _, ok := parsedTypes[typeSealedSecrets]; !ok {
+		sealed = nil
+	}
+
+	return session, sealed, nil
 }
 
 // macaroonRecipeEncoder is a custom TLV encoder for a MacaroonRecipe record.
@@ -493,6 +925,104 @@ func caveatsDecoder(r io.Reader, val interface{}, buf *[8]byte,
 	return tlv.NewTypeForDecodingErr(val, "MacaroonCaveat", l, l)
 }
 
+// stringsEncoder is a custom TLV encoder for a list of strings.
+func stringsEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*[]string); ok {
+		for _, str := range *v {
+			// We encode each string with a varint length followed
+			// by the raw bytes.
+			strLen := uint64(len(str))
+			if err := tlv.WriteVarInt(w, strLen, buf); err != nil {
+				return err
+			}
+
+			if _, err := w.Write([]byte(str)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "[]string")
+}
+
+// stringsDecoder is a custom TLV decoder for a list of strings.
+func stringsDecoder(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*[]string); ok {
+		var strs []string
+
+		// Using this information, we'll create a new limited
+		// reader that'll return an EOF once the end has been
+		// reached so the stream stops consuming bytes.
+		innerReader := io.LimitedReader{
+			R: r,
+			N: int64(l),
+		}
+
+		for {
+			strLen, err := tlv.ReadVarInt(&innerReader, buf)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+
+			if strLen > uint64(innerReader.N) {
+				return fmt.Errorf("string length %d exceeds "+
+					"record length", strLen)
+			}
+
+			str := make([]byte, strLen)
+			if _, err := io.ReadFull(&innerReader, str); err != nil {
+				return err
+			}
+
+			strs = append(strs, string(str))
+		}
+
+		*v = strs
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "[]string", l, l)
+}
+
+// flattenMetadata turns the given metadata into a list of alternating keys and
+// values, sorted by key so the same metadata is always encoded the same way.
+func flattenMetadata(metadata map[string]string) []string {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	flat := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		flat = append(flat, key, metadata[key])
+	}
+
+	return flat
+}
+
+// unflattenMetadata turns a list of alternating keys and values back into the
+// metadata it was created from.
+func unflattenMetadata(flat []string) (map[string]string, error) {
+	if len(flat)%2 != 0 {
+		return nil, fmt.Errorf("invalid metadata, key %q has no value",
+			flat[len(flat)-1])
+	}
+
+	metadata := make(map[string]string, len(flat)/2)
+	for i := 0; i < len(flat); i += 2 {
+		metadata[flat[i]] = flat[i+1]
+	}
+
+	return metadata, nil
+}
+
 // recordSize returns the amount of bytes this TLV record will occupy when
 // encoded.
 func recordSize(encoder tlv.Encoder, v interface{}) uint64 {
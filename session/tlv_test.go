@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightninglabs/lightning-node-connect/mailbox"
 	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -53,10 +54,33 @@ var (
 // and deserialized from and to the tlv binary format successfully.
 func TestSerializeDeserializeSession(t *testing.T) {
 	tests := []struct {
-		name     string
-		sessType Type
-		perms    []bakery.Op
-		caveats  []macaroon.Caveat
+		name      string
+		sessType  Type
+		perms     []bakery.Op
+		caveats   []macaroon.Caveat
+		servers   []string
+		singleUse bool
+		inactive  time.Duration
+		revokedAt time.Time
+		reason    string
+		resumedAt time.Time
+		resumeErr string
+		activates time.Time
+		group     string
+		keepalive time.Duration
+		handshake time.Duration
+		starting  bool
+		prevState State
+		restoreBy time.Time
+		macExpiry time.Duration
+		remote    string
+		streams   uint32
+		approval  bool
+		priority  uint32
+		mnemonic  MnemonicVersion
+		deadline  time.Time
+		metadata  map[string]string
+		connected time.Time
 	}{
 		{
 			name:     "session 1",
@@ -68,6 +92,51 @@ func TestSerializeDeserializeSession(t *testing.T) {
 			perms:    perms,
 			caveats:  caveats,
 		},
+		{
+			name:     "session 3",
+			sessType: TypeMacaroonAdmin,
+			servers: []string{
+				"fallback.one:443", "fallback.two:443",
+			},
+			singleUse: true,
+			inactive:  72 * time.Hour,
+			activates: time.Unix(1700000000, 0),
+			group:     "customer",
+			keepalive: 30 * time.Second,
+			handshake: 5 * time.Second,
+			macExpiry: time.Hour,
+			remote:    "alice's node",
+			streams:   8,
+			approval:  true,
+			priority:  10,
+			mnemonic:  MnemonicVersionMailbox + 1,
+			deadline:  time.Unix(1800000000, 0),
+			metadata: map[string]string{
+				"owner": "teamA",
+				"env":   "",
+			},
+			connected: time.Unix(1700100000, 0),
+		},
+		{
+			name:      "revoked session",
+			sessType:  TypeMacaroonReadonly,
+			revokedAt: time.Unix(1650000000, 0),
+			reason:    "compromised device",
+			resumedAt: time.Unix(1640000000, 0),
+			resumeErr: "error baking macaroon",
+		},
+		{
+			name:      "softly revoked session",
+			sessType:  TypeMacaroonAdmin,
+			revokedAt: time.Unix(1650000000, 0),
+			restoreBy: time.Unix(1650086400, 0),
+		},
+		{
+			name:      "starting session",
+			sessType:  TypeMacaroonAdmin,
+			starting:  true,
+			prevState: StateInUse,
+		},
 	}
 
 	for _, test := range tests {
@@ -84,6 +153,32 @@ func TestSerializeDeserializeSession(t *testing.T) {
 				btcec.S256(), testRootKey,
 			)
 			session.RemotePublicKey = remotePubKey
+			session.FallbackServerAddrs = test.servers
+			session.Owner = test.name
+			session.SingleUse = test.singleUse
+			session.InactivityExpiry = test.inactive
+			session.RevokedAt = test.revokedAt
+			session.RevokeReason = test.reason
+			session.LastResumeAttempt = test.resumedAt
+			session.LastResumeError = test.resumeErr
+			session.ActivationTime = test.activates
+			session.GroupID = test.group
+			session.KeepaliveInterval = test.keepalive
+			session.HandshakeTimeout = test.handshake
+			session.RestorableUntil = test.restoreBy
+			session.MacaroonExpiry = test.macExpiry
+			session.RemoteDisplayName = test.remote
+			session.MaxConcurrentStreams = test.streams
+			session.RequireApproval = test.approval
+			session.Priority = test.priority
+			session.MnemonicVersion = test.mnemonic
+			session.HardDeadline = test.deadline
+			session.Metadata = test.metadata
+			session.FirstConnectedAt = test.connected
+			if test.starting {
+				session.State = StateStarting
+				session.StartingFrom = test.prevState
+			}
 
 			var buf bytes.Buffer
 			require.NoError(t, SerializeSession(&buf, session))
@@ -99,8 +194,44 @@ func TestSerializeDeserializeSession(t *testing.T) {
 				t, session.Expiry.Unix(),
 				deserializedSession.Expiry.Unix(),
 			)
+			require.True(t, session.RevokedAt.Equal(
+				deserializedSession.RevokedAt,
+			))
+			require.True(t, session.LastResumeAttempt.Equal(
+				deserializedSession.LastResumeAttempt,
+			))
+			require.True(t, session.ActivationTime.Equal(
+				deserializedSession.ActivationTime,
+			))
+			require.Equal(
+				t, session.CreatedAt.Unix(),
+				deserializedSession.CreatedAt.Unix(),
+			)
+			require.True(t, session.RestorableUntil.Equal(
+				deserializedSession.RestorableUntil,
+			))
+			require.True(t, session.HardDeadline.Equal(
+				deserializedSession.HardDeadline,
+			))
+			require.True(t, session.FirstConnectedAt.Equal(
+				deserializedSession.FirstConnectedAt,
+			))
 			session.Expiry = time.Time{}
 			deserializedSession.Expiry = time.Time{}
+			session.RevokedAt = time.Time{}
+			deserializedSession.RevokedAt = time.Time{}
+			session.LastResumeAttempt = time.Time{}
+			deserializedSession.LastResumeAttempt = time.Time{}
+			session.ActivationTime = time.Time{}
+			deserializedSession.ActivationTime = time.Time{}
+			session.CreatedAt = time.Time{}
+			deserializedSession.CreatedAt = time.Time{}
+			session.RestorableUntil = time.Time{}
+			deserializedSession.RestorableUntil = time.Time{}
+			session.HardDeadline = time.Time{}
+			deserializedSession.HardDeadline = time.Time{}
+			session.FirstConnectedAt = time.Time{}
+			deserializedSession.FirstConnectedAt = time.Time{}
 			require.Equal(t, session, deserializedSession)
 		})
 	}
@@ -231,3 +362,69 @@ func TestSerializeDeserializeMacaroonRecipe(t *testing.T) {
 	// The two states should match.
 	require.Equal(t, recipe, recipe2)
 }
+
+// TestDeserializeSessionInvalidPairingSecret makes sure that a session with a
+// pairing secret of the wrong length can still be deserialized but ends up
+// with a zeroed secret.
+func TestDeserializeSessionInvalidPairingSecret(t *testing.T) {
+	session, err := NewSession(
+		"malformed", TypeMacaroonAdmin,
+		time.Date(99999, 1, 1, 0, 0, 0, 0, time.UTC),
+		"foo.bar.baz:1234", true, nil, nil,
+	)
+	require.NoError(t, err)
+
+	var (
+		shortSecret = session.PairingSecret[:5]
+		privateKey  = session.LocalPrivateKey.Serialize()
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typePairingSecret, &shortSecret),
+		tlv.MakePrimitiveRecord(typeLocalPrivateKey, &privateKey),
+	)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, tlvStream.Encode(&buf))
+
+	deserializedSession, err := DeserializeSession(&buf)
+	require.NoError(t, err)
+
+	require.Equal(
+		t, [mailbox.NumPasswordBytes]byte{},
+		deserializedSession.PairingSecret,
+	)
+	require.Equal(
+		t, session.LocalPublicKey, deserializedSession.LocalPublicKey,
+	)
+}
+
+// TestDeserializeSessionLegacySkipVerify makes sure that a dev server session
+// that was stored before the insecure skip verify flag existed still skips the
+// TLS verification.
+func TestDeserializeSessionLegacySkipVerify(t *testing.T) {
+	session, err := NewSession(
+		"legacy", TypeMacaroonAdmin,
+		time.Date(99999, 1, 1, 0, 0, 0, 0, time.UTC),
+		"foo.bar.baz:1234", true, nil, nil,
+	)
+	require.NoError(t, err)
+
+	var (
+		devServer  = uint8(1)
+		privateKey = session.LocalPrivateKey.Serialize()
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeDevServer, &devServer),
+		tlv.MakePrimitiveRecord(typeLocalPrivateKey, &privateKey),
+	)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, tlvStream.Encode(&buf))
+
+	deserializedSession, err := DeserializeSession(&buf)
+	require.NoError(t, err)
+	require.True(t, deserializedSession.DevServer)
+	require.True(t, deserializedSession.InsecureSkipVerify)
+}
@@ -0,0 +1,121 @@
+package session
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/lightninglabs/lightning-node-connect/mailbox"
+)
+
+const (
+	// ConnectURIScheme is the scheme of a session's connect URI.
+	ConnectURIScheme = "lnc"
+
+	// connectURISecretParam is the query parameter that carries the hex
+	// encoded pairing secret.
+	connectURISecretParam = "secret"
+
+	// connectURIDevParam is the query parameter that is set if the mailbox
+	// server is a development server.
+	connectURIDevParam = "dev"
+)
+
+// ConnectURI holds everything a client needs to pair with a session. It is
+// encoded as lnc://<mailbox server address>?secret=<hex secret>&dev=true.
+type ConnectURI struct {
+	// MailboxServerAddr is the address of the mailbox server the session
+	// connects to.
+	MailboxServerAddr string
+
+	// PairingSecret is the pairing secret of the session. It is nil if the
+	// secret was left out of the URI.
+	PairingSecret []byte
+
+	// DevServer is true if the mailbox server is a development server.
+	DevServer bool
+}
+
+// ConnectURI returns the connect URI of the session. The pairing secret is
+// only included if includeSecret is set.
+func (s *Session) ConnectURI(includeSecret bool) *ConnectURI {
+	uri := &ConnectURI{
+		MailboxServerAddr: s.ServerAddr,
+		DevServer:         s.DevServer,
+	}
+	if includeSecret {
+		uri.PairingSecret = append([]byte(nil), s.PairingSecret[:]...)
+	}
+
+	return uri
+}
+
+// String encodes the connect URI in its textual form.
+func (c *ConnectURI) String() string {
+	query := url.Values{}
+	if c.PairingSecret != nil {
+		query.Set(connectURISecretParam, hex.EncodeToString(
+			c.PairingSecret,
+		))
+	}
+	if c.DevServer {
+		query.Set(connectURIDevParam, "true")
+	}
+
+	uri := url.URL{
+		Scheme:   ConnectURIScheme,
+		Host:     c.MailboxServerAddr,
+		RawQuery: query.Encode(),
+	}
+
+	return uri.String()
+}
+
+// ParseConnectURI parses a connect URI in its textual form.
+func ParseConnectURI(uriStr string) (*ConnectURI, error) {
+	uri, err := url.Parse(uriStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid connect URI: %v", err)
+	}
+
+	if uri.Scheme != ConnectURIScheme {
+		return nil, fmt.Errorf("invalid connect URI scheme %q, expected "+
+			"%q", uri.Scheme, ConnectURIScheme)
+	}
+
+	if uri.Host == "" {
+		return nil, fmt.Errorf("connect URI is missing the mailbox " +
+			"server address")
+	}
+
+	result := &ConnectURI{
+		MailboxServerAddr: uri.Host,
+	}
+
+	query := uri.Query()
+	if secretHex := query.Get(connectURISecretParam); secretHex != "" {
+		secret, err := hex.DecodeString(secretHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pairing secret: %v",
+				err)
+		}
+
+		if len(secret) != mailbox.NumPasswordBytes {
+			return nil, fmt.Errorf("invalid pairing secret length "+
+				"%d, expected %d", len(secret),
+				mailbox.NumPasswordBytes)
+		}
+
+		result.PairingSecret = secret
+	}
+
+	if dev := query.Get(connectURIDevParam); dev != "" {
+		result.DevServer, err = strconv.ParseBool(dev)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dev flag: %v", err)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestConnectURI makes sure that a session's connect URI parses back to the
+// same fields and that the pairing secret is only included on request.
+func TestConnectURI(t *testing.T) {
+	session, err := NewSession(
+		"uri", TypeMacaroonAdmin, time.Now().Add(time.Hour),
+		"mailbox.terminal.lightning.today:443", true, nil, nil,
+	)
+	require.NoError(t, err)
+
+	uri := session.ConnectURI(true)
+	parsed, err := ParseConnectURI(uri.String())
+	require.NoError(t, err)
+	require.Equal(t, uri, parsed)
+	require.Equal(t, session.ServerAddr, parsed.MailboxServerAddr)
+	require.Equal(t, session.PairingSecret[:], parsed.PairingSecret)
+	require.True(t, parsed.DevServer)
+
+	redacted := session.ConnectURI(false)
+	require.NotContains(t, redacted.String(), "secret")
+	parsed, err = ParseConnectURI(redacted.String())
+	require.NoError(t, err)
+	require.Equal(t, redacted, parsed)
+	require.Nil(t, parsed.PairingSecret)
+
+	invalid := []string{
+		"https://mailbox.terminal.lightning.today:443",
+		"lnc://?secret=00",
+		"lnc://mailbox:443?secret=zz",
+		"lnc://mailbox:443?secret=0011",
+		"lnc://mailbox:443?dev=maybe",
+	}
+	for _, uri := range invalid {
+		_, err := ParseConnectURI(uri)
+		require.Error(t, err, uri)
+	}
+}
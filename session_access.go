@@ -0,0 +1,140 @@
+package terminal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// sessionAccess checks whether a macaroon with the given permissions grants
+// access to the given URI that requires the given permissions. Just like lnd's
+// macaroon validation, the URI is granted either if all of its required
+// permissions are included or if the special uri:<URI> permission is. The
+// required permissions that aren't included are returned.
+func sessionAccess(granted, required []bakery.Op, uri string) (bool,
+	[]bakery.Op) {
+
+	grantedSet := make(map[bakery.Op]bool, len(granted))
+	for _, op := range granted {
+		grantedSet[op] = true
+	}
+
+	var missing []bakery.Op
+	for _, op := range required {
+		if !grantedSet[op] {
+			missing = append(missing, op)
+		}
+	}
+	if len(missing) == 0 {
+		return true, nil
+	}
+
+	uriOp := bakery.Op{
+		Entity: macaroons.PermissionEntityCustomURI,
+		Action: uri,
+	}
+	if grantedSet[uriOp] {
+		return true, nil
+	}
+
+	return false, missing
+}
+
+// marshalRPCPermissions converts the given permissions into their RPC
+// counterpart.
+func marshalRPCPermissions(perms []bakery.Op) []*litrpc.MacaroonPermission {
+	rpcPerms := make([]*litrpc.MacaroonPermission, 0, len(perms))
+	for _, perm := range perms {
+		rpcPerms = append(rpcPerms, &litrpc.MacaroonPermission{
+			Entity: perm.Entity,
+			Action: perm.Action,
+		})
+	}
+
+	return rpcPerms
+}
+
+// TestSessionAccess checks whether the macaroon a session's client is served
+// would permit a call to the given URI, without calling it. The URI is checked
+// against the permissions the session's macaroon is baked with. The caveats of
+// the macaroon are returned but not evaluated, since they restrict what a call
+// may do rather than which calls are permitted.
+func (s *sessionRpcServer) TestSessionAccess(_ context.Context,
+	req *litrpc.TestSessionAccessRequest) (*litrpc.TestSessionAccessResponse,
+	error) {
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error "+
+			"parsing public key: %v", err)
+	}
+
+	required, ok := getAllMethodPermissions()[req.Uri]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown URI "+
+			"%q", req.Uri)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	resp := &litrpc.TestSessionAccessResponse{
+		RequiredPermissions: marshalRPCPermissions(required),
+	}
+
+	now := time.Now()
+	switch {
+	case sess.State == session.StateRevoked:
+		resp.Reason = "session is revoked"
+		return resp, nil
+
+	case sess.State == session.StateExpired || !sess.Expiry.After(now):
+		resp.Reason = "session is expired"
+		return resp, nil
+
+	case sess.AccessSchedule != nil && !sess.AccessSchedule.Allows(now):
+		resp.Reason = errOutsideAccessWindow.Error()
+		return resp, nil
+	}
+
+	// UI password sessions aren't served a macaroon. Their calls are made
+	// with the macaroon of the daemon each call is for, which grants
+	// everything.
+	if sess.Type == session.TypeUIPassword {
+		resp.Allowed = true
+		return resp, nil
+	}
+
+	recipe := s.sessionRecipe(sess)
+	resp.Caveats = marshalRPCRecipe(recipe).Caveats
+
+	allowed, missing := sessionAccess(recipe.Permissions, required, req.Uri)
+	resp.Allowed = allowed
+	resp.MissingPermissions = marshalRPCPermissions(missing)
+	if !allowed {
+		names := make([]string, 0, len(missing))
+		for _, perm := range missing {
+			names = append(names, perm.Entity+":"+perm.Action)
+		}
+		resp.Reason = fmt.Sprintf("missing permissions %s",
+			strings.Join(names, ", "))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,106 @@
+package terminal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+const (
+	// testReadURI is the URI of an RPC that only reads.
+	testReadURI = "/lnrpc.Lightning/GetInfo"
+
+	// testWriteURI is the URI of an RPC that writes.
+	testWriteURI = "/lnrpc.Lightning/SendCoins"
+)
+
+// TestSessionAccessDryRun makes sure that the access of a session to an RPC is
+// checked against the permissions of the session's macaroon.
+func TestSessionAccessDryRun(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	testAccess := func(sess *session.Session,
+		uri string) *litrpc.TestSessionAccessResponse {
+
+		resp, err := s.TestSessionAccess(
+			ctx, &litrpc.TestSessionAccessRequest{
+				LocalPublicKey: sess.LocalPublicKey.
+					SerializeCompressed(),
+				Uri: uri,
+			},
+		)
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	// A readonly session may read but not write.
+	readOnly := newTestSession(t, "readonly", session.TypeMacaroonReadonly)
+	require.NoError(t, s.db.StoreSession(readOnly))
+
+	resp := testAccess(readOnly, testReadURI)
+	require.True(t, resp.Allowed)
+	require.Empty(t, resp.MissingPermissions)
+
+	resp = testAccess(readOnly, testWriteURI)
+	require.False(t, resp.Allowed)
+	require.NotEmpty(t, resp.RequiredPermissions)
+	require.NotEmpty(t, resp.MissingPermissions)
+	require.NotEmpty(t, resp.Reason)
+	for _, perm := range resp.MissingPermissions {
+		require.NotEqual(t, "read", perm.Action)
+	}
+
+	// An admin session may do both.
+	admin := newTestSession(t, "admin", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(admin))
+	require.True(t, testAccess(admin, testReadURI).Allowed)
+	require.True(t, testAccess(admin, testWriteURI).Allowed)
+
+	// A revoked session may do neither.
+	require.NoError(t, s.db.RevokeSession(admin.LocalPublicKey, ""))
+	resp = testAccess(admin, testReadURI)
+	require.False(t, resp.Allowed)
+	require.Equal(t, "session is revoked", resp.Reason)
+
+	// Unknown URIs and sessions are rejected.
+	_, err := s.TestSessionAccess(ctx, &litrpc.TestSessionAccessRequest{
+		LocalPublicKey: readOnly.LocalPublicKey.SerializeCompressed(),
+		Uri:            "/lnrpc.Lightning/Unknown",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	unknown := newTestSession(t, "unknown", session.TypeMacaroonAdmin)
+	_, err = s.TestSessionAccess(ctx, &litrpc.TestSessionAccessRequest{
+		LocalPublicKey: unknown.LocalPublicKey.SerializeCompressed(),
+		Uri:            testReadURI,
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestSessionAccessCustomURI makes sure that the special uri permission grants
+// access to exactly its URI.
+func TestSessionAccessCustomURI(t *testing.T) {
+	granted := []bakery.Op{{
+		Entity: macaroons.PermissionEntityCustomURI,
+		Action: testWriteURI,
+	}}
+	required := getAllMethodPermissions()[testWriteURI]
+
+	allowed, missing := sessionAccess(granted, required, testWriteURI)
+	require.True(t, allowed)
+	require.Empty(t, missing)
+
+	required = getAllMethodPermissions()[testReadURI]
+	allowed, missing = sessionAccess(granted, required, testReadURI)
+	require.False(t, allowed)
+	require.Equal(t, required, missing)
+}
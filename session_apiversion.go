@@ -0,0 +1,73 @@
+package terminal
+
+import (
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// sessionAPIVersion1 is the original version of the Session message,
+	// which only contains the fields label to remote_public_key.
+	sessionAPIVersion1 uint32 = 1
+
+	// sessionAPIVersion2 contains all fields of the Session message up to
+	// access_schedule.
+	sessionAPIVersion2 uint32 = 2
+
+	// sessionAPIVersion3 adds the hard_deadline field.
+	sessionAPIVersion3 uint32 = 3
+
+	// sessionAPIVersion4 adds the session_id field.
+	sessionAPIVersion4 uint32 = 4
+
+	// sessionAPIVersion5 adds the mnemonic_warning field.
+	sessionAPIVersion5 uint32 = 5
+
+	// sessionAPIVersion6 adds the metadata field.
+	sessionAPIVersion6 uint32 = 6
+
+	// latestSessionAPIVersion is the latest version of the Session message.
+	// It must be bumped and get an entry in sessionAPIVersionMaxFields
+	// whenever a field is added to the message.
+	latestSessionAPIVersion = sessionAPIVersion6
+)
+
+// sessionAPIVersionMaxFields maps each version of the Session message to the
+// highest field number it contains. Field numbers are never reused, so a
+// version contains all fields up to its highest one.
+var sessionAPIVersionMaxFields = map[uint32]protoreflect.FieldNumber{
+	sessionAPIVersion1: 10,
+	sessionAPIVersion2: 43,
+	sessionAPIVersion3: 44,
+	sessionAPIVersion4: 45,
+	sessionAPIVersion5: 46,
+	sessionAPIVersion6: 47,
+}
+
+// negotiateSessionAPIVersion returns the version of the Session message to
+// return to a client that supports up to the requested version. This is the
+// latest version if the request didn't name one or named a version that is
+// newer than the latest one.
+func negotiateSessionAPIVersion(requested uint32) uint32 {
+	if requested == 0 || requested > latestSessionAPIVersion {
+		return latestSessionAPIVersion
+	}
+
+	return requested
+}
+
+// projectRPCSession clears all fields of the given session that were added
+// after the given version of the Session message, so a client that only knows
+// that version gets the session exactly in the shape it expects.
+func projectRPCSession(sess *litrpc.Session, version uint32) {
+	maxField := sessionAPIVersionMaxFields[version]
+
+	msg := sess.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field.Number() > maxField {
+			msg.Clear(field)
+		}
+	}
+}
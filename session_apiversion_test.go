@@ -0,0 +1,100 @@
+package terminal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSessionAPIVersionFields makes sure that the latest version of the
+// Session message contains all of its fields, so adding a field without
+// bumping the version is noticed.
+func TestSessionAPIVersionFields(t *testing.T) {
+	fields := (&litrpc.Session{}).ProtoReflect().Descriptor().Fields()
+	maxField := sessionAPIVersionMaxFields[latestSessionAPIVersion]
+	for i := 0; i < fields.Len(); i++ {
+		require.LessOrEqual(t, fields.Get(i).Number(), maxField)
+	}
+
+	version := sessionAPIVersion1
+	for ; version <= latestSessionAPIVersion; version++ {
+		require.Contains(t, sessionAPIVersionMaxFields, version)
+	}
+}
+
+// TestListSessionsAPIVersion makes sure that sessions are projected into the
+// requested version of the Session message.
+func TestListSessionsAPIVersion(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	sess := newTestSession(t, "versioned", session.TypeMacaroonAdmin)
+	sess.Description = "added in version 2"
+	sess.GroupID = "group"
+	require.NoError(t, s.db.StoreSession(sess))
+	pubKey := sess.LocalPublicKey.SerializeCompressed()
+
+	// The original version only has the original fields.
+	listResp, err := s.ListSessions(
+		context.Background(), &litrpc.ListSessionsRequest{
+			ApiVersion: sessionAPIVersion1,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sessionAPIVersion1, listResp.ApiVersion)
+	require.Len(t, listResp.Sessions, 1)
+
+	v1 := listResp.Sessions[0]
+	require.Equal(t, "versioned", v1.Label)
+	require.Equal(t, pubKey, v1.LocalPublicKey)
+	require.NotEmpty(t, v1.PairingSecretMnemonic)
+	require.NotZero(t, v1.ExpiryTimestampSeconds)
+	require.Empty(t, v1.Description)
+	require.Empty(t, v1.GroupId)
+	require.Empty(t, v1.MailboxServerAddrs)
+
+	getResp, err := s.GetSession(
+		context.Background(), &litrpc.GetSessionRequest{
+			LocalPublicKey: pubKey,
+			ApiVersion:     sessionAPIVersion1,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sessionAPIVersion1, getResp.ApiVersion)
+	require.Equal(t, "versioned", getResp.Session.Label)
+	require.Empty(t, getResp.Session.Description)
+
+	// Version 2 includes the newer fields as well.
+	listResp, err = s.ListSessions(
+		context.Background(), &litrpc.ListSessionsRequest{
+			ApiVersion: sessionAPIVersion2,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sessionAPIVersion2, listResp.ApiVersion)
+	require.Len(t, listResp.Sessions, 1)
+
+	v2 := listResp.Sessions[0]
+	require.Equal(t, "versioned", v2.Label)
+	require.Equal(t, "added in version 2", v2.Description)
+	require.Equal(t, "group", v2.GroupId)
+	require.NotEmpty(t, v2.MailboxServerAddrs)
+
+	// Without a version or with a version that is newer than the latest
+	// one, the latest version is used.
+	for _, requested := range []uint32{0, latestSessionAPIVersion + 1} {
+		getResp, err := s.GetSession(
+			context.Background(), &litrpc.GetSessionRequest{
+				LocalPublicKey: pubKey,
+				ApiVersion:     requested,
+			},
+		)
+		require.NoError(t, err)
+		require.Equal(t, latestSessionAPIVersion, getResp.ApiVersion)
+		require.Equal(
+			t, "added in version 2", getResp.Session.Description,
+		)
+	}
+}
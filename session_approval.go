@@ -0,0 +1,171 @@
+package terminal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// awaitApproval blocks the connection of a session's remote until the session
+// is approved. It returns right away if the session doesn't require approval.
+// An error is returned if the session is denied or stopped before it was
+// approved.
+func (s *sessionRpcServer) awaitApproval(pubKey *btcec.PublicKey,
+	quit <-chan struct{}, sessLog btclog.Logger) error {
+
+	// The decision channel is registered before the session is marked as
+	// pending, so an approval can't be missed in between.
+	id := string(pubKey.SerializeCompressed())
+	decision := make(chan bool, 1)
+
+	s.activeSessionsMtx.Lock()
+	s.pendingApprovals[id] = decision
+	s.activeSessionsMtx.Unlock()
+
+	defer func() {
+		s.activeSessionsMtx.Lock()
+		defer s.activeSessionsMtx.Unlock()
+
+		if s.pendingApprovals[id] == decision {
+			delete(s.pendingApprovals, id)
+		}
+	}()
+
+	pending, err := s.db.MarkPendingApproval(pubKey)
+	if err != nil {
+		return fmt.Errorf("error marking session as pending approval: "+
+			"%v", err)
+	}
+	if !pending {
+		return nil
+	}
+
+	sessLog.Infof("Remote connected, waiting for approval")
+
+	select {
+	case approved := <-decision:
+		if !approved {
+			return fmt.Errorf("session was denied")
+		}
+
+		sessLog.Infof("Session approved")
+		return nil
+
+	case <-quit:
+		return fmt.Errorf("session stopped before it was approved")
+
+	case <-s.quit:
+		return fmt.Errorf("server shutting down")
+	}
+}
+
+// resolveApproval hands the given decision to the remote of the session with
+// the given local public key if it is currently waiting for approval.
+func (s *sessionRpcServer) resolveApproval(pubKey *btcec.PublicKey,
+	approved bool) {
+
+	id := string(pubKey.SerializeCompressed())
+
+	s.activeSessionsMtx.Lock()
+	decision, ok := s.pendingApprovals[id]
+	delete(s.pendingApprovals, id)
+	s.activeSessionsMtx.Unlock()
+
+	if !ok {
+		return
+	}
+
+	select {
+	case decision <- approved:
+	default:
+	}
+}
+
+// ApproveSession approves the remote of a session that waits for approval, so
+// it is served from now on. The approval is permanent, later connections of
+// the session don't need to be approved again.
+func (s *sessionRpcServer) ApproveSession(_ context.Context,
+	req *litrpc.ApproveSessionRequest) (*litrpc.ApproveSessionResponse,
+	error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := parseCompressedPubKey(
+		"local_public_key", req.LocalPublicKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.ApproveSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, session.ErrStateMismatch):
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is not waiting for approval")
+
+	case err != nil:
+		return nil, fmt.Errorf("error approving session: %v", err)
+	}
+
+	s.resolveApproval(pubKey, true)
+
+	return &litrpc.ApproveSessionResponse{}, nil
+}
+
+// DenySession rejects the remote of a session that requires approval and
+// revokes the session.
+func (s *sessionRpcServer) DenySession(ctx context.Context,
+	req *litrpc.DenySessionRequest) (*litrpc.DenySessionResponse, error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := parseCompressedPubKey(
+		"local_public_key", req.LocalPublicKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	if !sess.RequireApproval || sess.State == session.StateRevoked {
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"doesn't require approval")
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = revokeReasonDenied
+	}
+
+	// The waiting remote is rejected first, so stopping the session doesn't
+	// have to wait for its handshake.
+	s.resolveApproval(pubKey, false)
+
+	if err := s.revokeSession(ctx, pubKey, reason); err != nil {
+		return nil, err
+	}
+
+	return &litrpc.DenySessionResponse{}, nil
+}
@@ -0,0 +1,188 @@
+package terminal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// addApprovalSession adds a session that requires approval and returns its
+// local public key.
+func addApprovalSession(t *testing.T, s *sessionRpcServer) *btcec.PublicKey {
+	ctx := context.Background()
+	resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:       "approval",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr: "localhost:1234",
+		RequireApproval:   true,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Session.RequireApproval)
+
+	pubKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+
+	return pubKey
+}
+
+// connectPending connects the remote of the given session in the background and
+// waits until it is pending approval. The returned channel is closed once the
+// connection attempt returns.
+func connectPending(t *testing.T, s *sessionRpcServer,
+	pubKey *btcec.PublicKey) chan struct{} {
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.sessionServer.(*mockSessionServer).connect(pubKey)
+	}()
+
+	require.Eventually(t, func() bool {
+		sess, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+
+		return sess.State == session.StatePendingApproval
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return done
+}
+
+// mockAuthData returns the authentication data the mock session server hands
+// out for the session with the given key.
+func mockAuthData(s *sessionRpcServer, pubKey *btcec.PublicKey) []byte {
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	mockServer.mu.Lock()
+	defer mockServer.mu.Unlock()
+
+	return mockServer.authData[string(pubKey.SerializeCompressed())]
+}
+
+// TestApproveSession makes sure that the remote of a session that requires
+// approval isn't served before the session was approved.
+func TestApproveSession(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	pubKey := addApprovalSession(t, s)
+	require.True(t, s.sessionServer.(*mockSessionServer).isActive(pubKey))
+	require.Nil(t, mockAuthData(s, pubKey))
+
+	// A session can't be approved before its remote connected.
+	req := &litrpc.ApproveSessionRequest{
+		LocalPublicKey: pubKey.SerializeCompressed(),
+	}
+	_, err := s.ApproveSession(ctx, req)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	done := connectPending(t, s, pubKey)
+
+	// The remote keeps waiting while the session isn't approved.
+	select {
+	case <-done:
+		t.Fatalf("remote served before approval")
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.Nil(t, mockAuthData(s, pubKey))
+
+	_, err = s.ApproveSession(ctx, req)
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("remote not served after approval")
+	}
+	require.NotNil(t, mockAuthData(s, pubKey))
+
+	// Once approved, the remote completes its handshake and the session is
+	// in use.
+	require.Eventually(t, func() bool {
+		sess, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+
+		return sess.State == session.StateInUse
+	}, 5*time.Second, 10*time.Millisecond)
+
+	sess, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.False(t, sess.RequireApproval)
+
+	// The approval is permanent, so there's nothing left to approve or
+	// deny.
+	_, err = s.ApproveSession(ctx, req)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = s.DenySession(ctx, &litrpc.DenySessionRequest{
+		LocalPublicKey: pubKey.SerializeCompressed(),
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// TestDenySession makes sure that denying the remote of a session rejects its
+// connection and revokes the session.
+func TestDenySession(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	pubKey := addApprovalSession(t, s)
+	done := connectPending(t, s, pubKey)
+
+	_, err := s.DenySession(ctx, &litrpc.DenySessionRequest{
+		LocalPublicKey: pubKey.SerializeCompressed(),
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("remote still waiting after denial")
+	}
+	require.Nil(t, mockAuthData(s, pubKey))
+
+	sess, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateRevoked, sess.State)
+	require.Equal(t, revokeReasonDenied, sess.RevokeReason)
+	require.False(t, s.sessionServer.(*mockSessionServer).isActive(pubKey))
+
+	// A revoked session can't be denied again.
+	_, err = s.DenySession(ctx, &litrpc.DenySessionRequest{
+		LocalPublicKey: pubKey.SerializeCompressed(),
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// TestPendingApprovalStopped makes sure that a remote that waits for approval
+// is rejected once its session is stopped for another reason.
+func TestPendingApprovalStopped(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	pubKey := addApprovalSession(t, s)
+	done := connectPending(t, s, pubKey)
+
+	_, err := s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: pubKey.SerializeCompressed(),
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("remote still waiting after revocation")
+	}
+	require.Nil(t, mockAuthData(s, pubKey))
+}
@@ -0,0 +1,120 @@
+package terminal
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+)
+
+const (
+	// icsTimeFormat is the format of UTC date-time values in an iCalendar
+	// document.
+	icsTimeFormat = "20060102T150405Z"
+
+	// icsMaxLineLength is the maximum number of octets in a line of an
+	// iCalendar document. Longer lines are folded.
+	icsMaxLineLength = 75
+
+	// icsProductID identifies LiT as the creator of a calendar.
+	icsProductID = "-//Lightning Labs//Lightning Terminal//EN"
+)
+
+// icsEscaper escapes the characters that have a special meaning in the text
+// values of an iCalendar document.
+var icsEscaper = strings.NewReplacer(
+	`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`,
+)
+
+// icsWriter builds an iCalendar document line by line.
+type icsWriter struct {
+	b strings.Builder
+}
+
+// line appends a content line, folding it if it is too long.
+func (w *icsWriter) line(name, value string) {
+	line := name + ":" + value
+	for len(line) > icsMaxLineLength {
+		// A line is never folded in the middle of a UTF-8 sequence.
+		cut := icsMaxLineLength
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		w.b.WriteString(line[:cut] + "\r\n")
+
+		// Continuation lines start with a space that counts towards
+		// their length.
+		line = " " + line[cut:]
+	}
+
+	w.b.WriteString(line + "\r\n")
+}
+
+// sessionsCalendar returns an iCalendar document with one event at the expiry
+// time of each of the given sessions.
+func sessionsCalendar(sessions []*session.Session, now time.Time) string {
+	w := &icsWriter{}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", icsProductID)
+	w.line("CALSCALE", "GREGORIAN")
+
+	stamp := now.UTC().Format(icsTimeFormat)
+	for _, sess := range sessions {
+		pubKey := hex.EncodeToString(
+			sess.LocalPublicKey.SerializeCompressed(),
+		)
+		description := fmt.Sprintf("Label: %s\nLocal public key: %s",
+			sess.Label, pubKey)
+
+		w.line("BEGIN", "VEVENT")
+		w.line("UID", pubKey+"@lightning-terminal")
+		w.line("DTSTAMP", stamp)
+		w.line("DTSTART", sess.Expiry.UTC().Format(icsTimeFormat))
+		w.line("SUMMARY", icsEscaper.Replace(fmt.Sprintf(
+			"LiT session %s expires", sess.Label,
+		)))
+		w.line("DESCRIPTION", icsEscaper.Replace(description))
+		w.line("END", "VEVENT")
+	}
+
+	w.line("END", "VCALENDAR")
+
+	return w.b.String()
+}
+
+// GetSessionsCalendar returns an iCalendar document with an event at the
+// expiry time of each non-revoked session that matches the filters.
+func (s *sessionRpcServer) GetSessionsCalendar(ctx context.Context,
+	req *litrpc.GetSessionsCalendarRequest) (
+	*litrpc.GetSessionsCalendarResponse, error) {
+
+	filter := req.Filter
+	if filter == nil {
+		filter = &litrpc.ListSessionsRequest{}
+	}
+
+	sessions, err := s.filterSessions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	unrevoked := make([]*session.Session, 0, len(sessions))
+	for _, sess := range sessions {
+		if sess.State == session.StateRevoked {
+			continue
+		}
+
+		unrevoked = append(unrevoked, sess)
+	}
+
+	return &litrpc.GetSessionsCalendarResponse{
+		Calendar: sessionsCalendar(unrevoked, time.Now()),
+	}, nil
+}
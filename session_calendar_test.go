@@ -0,0 +1,119 @@
+package terminal
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+)
+
+// icsUnescaper reverts the escaping of iCalendar text values.
+var icsUnescaper = strings.NewReplacer(
+	`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n",
+)
+
+// parseICSEvents parses the given iCalendar document and returns the
+// properties of each of its events.
+func parseICSEvents(t *testing.T, calendar string) []map[string]string {
+	require.True(t, strings.HasSuffix(calendar, "\r\n"))
+	for _, line := range strings.Split(calendar, "\r\n") {
+		require.LessOrEqual(t, len(line), icsMaxLineLength)
+	}
+
+	// Folded lines continue on the next line after a single space.
+	unfolded := strings.ReplaceAll(calendar, "\r\n ", "")
+	lines := strings.Split(strings.TrimSuffix(unfolded, "\r\n"), "\r\n")
+	require.Equal(t, "BEGIN:VCALENDAR", lines[0])
+	require.Equal(t, "END:VCALENDAR", lines[len(lines)-1])
+
+	var (
+		events []map[string]string
+		event  map[string]string
+	)
+	for _, line := range lines[1 : len(lines)-1] {
+		parts := strings.SplitN(line, ":", 2)
+		require.Len(t, parts, 2, line)
+		name, value := parts[0], parts[1]
+
+		switch {
+		case line == "BEGIN:VEVENT":
+			require.Nil(t, event)
+			event = make(map[string]string)
+
+		case line == "END:VEVENT":
+			require.NotNil(t, event)
+			events = append(events, event)
+			event = nil
+
+		case event != nil:
+			event[name] = icsUnescaper.Replace(value)
+		}
+	}
+	require.Nil(t, event)
+
+	return events
+}
+
+// TestGetSessionsCalendar makes sure that the calendar contains an event at the
+// expiry of each non-revoked session that matches the filters.
+func TestGetSessionsCalendar(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	expiry := time.Date(2030, 5, 17, 12, 30, 0, 0, time.UTC)
+	addSession := func(label string) *session.Session {
+		sess := newTestSession(t, label, session.TypeMacaroonAdmin)
+		sess.Expiry = expiry
+		require.NoError(t, s.db.StoreSession(sess))
+
+		return sess
+	}
+
+	first := addSession("calendar, first; \"quoted\"")
+	second := addSession("calendar with a very long label that has to be " +
+		"folded across several lines, including ümlauts")
+	revoked := addSession("calendar revoked")
+	require.NoError(t, s.db.RevokeSession(revoked.LocalPublicKey, ""))
+	addSession("unrelated")
+
+	resp, err := s.GetSessionsCalendar(
+		context.Background(), &litrpc.GetSessionsCalendarRequest{
+			Filter: &litrpc.ListSessionsRequest{
+				LabelQuery: "calendar",
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	events := parseICSEvents(t, resp.Calendar)
+	require.Len(t, events, 2)
+
+	byUID := make(map[string]map[string]string)
+	for _, event := range events {
+		byUID[event["UID"]] = event
+	}
+
+	for _, sess := range []*session.Session{first, second} {
+		pubKey := hex.EncodeToString(
+			sess.LocalPublicKey.SerializeCompressed(),
+		)
+		event, ok := byUID[pubKey+"@lightning-terminal"]
+		require.True(t, ok)
+
+		require.Equal(t, "20300517T123000Z", event["DTSTART"])
+		require.Contains(t, event["DESCRIPTION"], sess.Label)
+		require.Contains(t, event["DESCRIPTION"], pubKey)
+		require.Contains(t, event["SUMMARY"], sess.Label)
+	}
+
+	// Without a filter, all non-revoked sessions are included.
+	resp, err = s.GetSessionsCalendar(
+		context.Background(), &litrpc.GetSessionsCalendarRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, parseICSEvents(t, resp.Calendar), 3)
+}
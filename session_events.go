@@ -0,0 +1,112 @@
+package terminal
+
+import (
+	"errors"
+
+	"github.com/lightninglabs/lightning-terminal/session"
+)
+
+// SessionEventHandler is notified about the events on the mailbox connection
+// of every running session. It lets operators react to the activity of
+// sessions, for example to feed their own monitoring. The methods are called
+// from the goroutine of the session, so they must not block.
+type SessionEventHandler interface {
+	// OnRemoteConnected is called once a client completed the handshake
+	// with the given session.
+	OnRemoteConnected(sess *session.Session)
+
+	// OnRemoteDisconnected is called once the connection of a client of
+	// the given session was closed.
+	OnRemoteDisconnected(sess *session.Session)
+
+	// OnStreamOpened is called once a client of the given session started
+	// a call.
+	OnStreamOpened(sess *session.Session)
+}
+
+// noopSessionEventHandler is the default session event handler that ignores
+// all events.
+type noopSessionEventHandler struct{}
+
+// OnRemoteConnected ignores the event.
+//
+// NOTE: This is part of the SessionEventHandler interface.
+func (noopSessionEventHandler) OnRemoteConnected(*session.Session) {}
+
+// OnRemoteDisconnected ignores the event.
+//
+// NOTE: This is part of the SessionEventHandler interface.
+func (noopSessionEventHandler) OnRemoteDisconnected(*session.Session) {}
+
+// OnStreamOpened ignores the event.
+//
+// NOTE: This is part of the SessionEventHandler interface.
+func (noopSessionEventHandler) OnStreamOpened(*session.Session) {}
+
+// A compile-time check to make sure our noopSessionEventHandler satisfies the
+// SessionEventHandler interface.
+var _ SessionEventHandler = (*noopSessionEventHandler)(nil)
+
+// SetSessionEventHandler sets the handler that is notified about the
+// connection events of every running session. It must be called before Run.
+// By default all events are ignored.
+func (g *LightningTerminal) SetSessionEventHandler(
+	handler SessionEventHandler) {
+
+	g.sessionEventHandler = handler
+}
+
+// handleConnectionEvent passes the given connection event of the given session
+// on to the session event handler. A connected client is recorded on the
+// session first, so the handler already sees the session as in use.
+func (s *sessionRpcServer) handleConnectionEvent(sess *session.Session,
+	event session.ConnectionEvent) {
+
+	switch event {
+	case session.EventRemoteConnected:
+		s.markConnected(sess)
+		s.eventHandler.OnRemoteConnected(sess)
+
+	case session.EventRemoteDisconnected:
+		s.eventHandler.OnRemoteDisconnected(sess)
+
+	case session.EventStreamOpened:
+		s.eventHandler.OnStreamOpened(sess)
+	}
+}
+
+// markConnected records that a client completed the handshake with the given
+// running session. The session is in use and counts as paired from then on.
+// Nothing is written if the session was already marked as connected.
+func (s *sessionRpcServer) markConnected(sess *session.Session) {
+	pubKey := sess.LocalPublicKey
+	sessLog := sessionLogger(sess)
+
+	if sess.State == session.StateInUse &&
+		!sess.FirstConnectedAt.IsZero() {
+
+		return
+	}
+
+	err := s.db.MarkSessionConnected(pubKey)
+	switch {
+	case errors.Is(err, session.ErrStateMismatch):
+		// The session was revoked or suspended in the meantime, so
+		// there's nothing left to record.
+		sessLog.Debugf("Not marking session as connected: %v", err)
+		return
+
+	case err != nil:
+		sessLog.Errorf("Unable to mark session as connected: %v", err)
+		return
+	}
+
+	stored, err := s.db.GetSession(pubKey)
+	if err != nil {
+		sessLog.Errorf("Unable to fetch connected session: %v", err)
+		return
+	}
+
+	sess.State = stored.State
+	sess.FirstConnectedAt = stored.FirstConnectedAt
+}
@@ -0,0 +1,113 @@
+package terminal
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingEventHandler is a session event handler that records the events it
+// is notified about.
+type recordingEventHandler struct {
+	mu     sync.Mutex
+	events []string
+}
+
+// record adds the given event of the given session to the recorded events.
+func (h *recordingEventHandler) record(sess *session.Session, event string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.events = append(h.events, sess.Label+": "+event)
+}
+
+// recorded returns a copy of the recorded events.
+func (h *recordingEventHandler) recorded() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return append([]string(nil), h.events...)
+}
+
+// OnRemoteConnected records the event.
+//
+// NOTE: This is part of the SessionEventHandler interface.
+func (h *recordingEventHandler) OnRemoteConnected(sess *session.Session) {
+	h.record(sess, "connected")
+}
+
+// OnRemoteDisconnected records the event.
+//
+// NOTE: This is part of the SessionEventHandler interface.
+func (h *recordingEventHandler) OnRemoteDisconnected(sess *session.Session) {
+	h.record(sess, "disconnected")
+}
+
+// OnStreamOpened records the event.
+//
+// NOTE: This is part of the SessionEventHandler interface.
+func (h *recordingEventHandler) OnStreamOpened(sess *session.Session) {
+	h.record(sess, "stream opened")
+}
+
+// TestSessionEventHandler makes sure that the session event handler is notified
+// about the connection events of a running session in the order they happen.
+func TestSessionEventHandler(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mock := s.sessionServer.(*mockSessionServer)
+
+	handler := &recordingEventHandler{}
+	s.eventHandler = handler
+
+	sess := newTestSession(t, "events", session.TypeMacaroonAdmin)
+	require.NoError(t, s.storeAndStartSession(sess, 0))
+
+	pubKey := sess.LocalPublicKey
+	mock.connectClient(pubKey)
+	mock.connect(pubKey)
+	mock.sendEvent(pubKey, session.EventStreamOpened)
+	mock.disconnectClient(pubKey)
+
+	expected := []string{
+		"events: connected",
+		"events: stream opened",
+		"events: disconnected",
+	}
+	require.Eventually(t, func() bool {
+		return len(handler.recorded()) == len(expected)
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, expected, handler.recorded())
+}
+
+// TestRemoteConnectedMarksSession makes sure that a session is persisted as in
+// use once a client completed the handshake with it, together with the time of
+// the first connection.
+func TestRemoteConnectedMarksSession(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mock := s.sessionServer.(*mockSessionServer)
+
+	sess := newTestSession(t, "connected", session.TypeMacaroonAdmin)
+	require.NoError(t, s.storeAndStartSession(sess, 0))
+
+	pubKey := sess.LocalPublicKey
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateCreated, stored.State)
+	require.True(t, stored.FirstConnectedAt.IsZero())
+
+	mock.connect(pubKey)
+
+	require.Eventually(t, func() bool {
+		stored, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+
+		return stored.State == session.StateInUse
+	}, 5*time.Second, 10*time.Millisecond)
+
+	stored, err = s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.False(t, stored.FirstConnectedAt.IsZero())
+}
@@ -0,0 +1,107 @@
+package terminal
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/session"
+)
+
+const (
+	// expiryScanInterval is the interval at which sessions that aren't
+	// running are checked for an upcoming expiry.
+	expiryScanInterval = time.Minute
+)
+
+// startExpiryScan starts periodically warning subscribers about sessions that
+// expire soon but aren't running, so their expiry isn't watched by a session
+// goroutine. Nothing is started if expiry warnings are disabled.
+func (s *sessionRpcServer) startExpiryScan() {
+	if s.cfg.ExpiryWarning <= 0 {
+		return
+	}
+
+	s.wg.Add(1)
+	atomic.AddInt64(&s.numWaitGroupGoroutines, 1)
+	go func() {
+		defer func() {
+			atomic.AddInt64(&s.numWaitGroupGoroutines, -1)
+			s.wg.Done()
+		}()
+
+		ticker := time.NewTicker(expiryScanInterval)
+		defer ticker.Stop()
+
+		for {
+			s.scanExpiringSessions(time.Now())
+
+			select {
+			case <-ticker.C:
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+}
+
+// scanExpiringSessions warns subscribers about each session that isn't running,
+// isn't revoked or expired and expires within the configured lead time from
+// the given time. Each session is only warned about once per expiry, so a
+// session whose expiry is extended is warned about again once its new expiry
+// comes close.
+func (s *sessionRpcServer) scanExpiringSessions(now time.Time) {
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		log.Errorf("Unable to list sessions for expiry warnings: %v",
+			err)
+		return
+	}
+
+	known := make(map[string]struct{}, len(sessions))
+	for _, sess := range sessions {
+		if sess.State == session.StateRevoked ||
+			sess.State == session.StateExpired {
+
+			continue
+		}
+
+		id := string(sess.LocalPublicKey.SerializeCompressed())
+		known[id] = struct{}{}
+
+		// Running sessions are warned about by their own goroutine.
+		if s.isActive(sess.LocalPublicKey) {
+			continue
+		}
+
+		if !sess.Expiry.After(now) ||
+			sess.Expiry.Sub(now) > s.cfg.ExpiryWarning {
+
+			continue
+		}
+
+		warned, ok := s.expiryWarnings[id]
+		if ok && warned.Equal(sess.Expiry) {
+			continue
+		}
+
+		sessLog := sessionLogger(sess)
+		sessLog.Debugf("Notifying subscribers about expiring session")
+
+		err := s.db.NotifyExpiringSoon(sess.LocalPublicKey)
+		if err != nil {
+			sessLog.Debugf("Error notifying about expiring "+
+				"session: %v", err)
+			continue
+		}
+
+		s.expiryWarnings[id] = sess.Expiry
+	}
+
+	// Sessions that are gone, revoked or expired won't be warned about
+	// anymore, so there is no need to remember them.
+	for id := range s.expiryWarnings {
+		if _, ok := known[id]; !ok {
+			delete(s.expiryWarnings, id)
+		}
+	}
+}
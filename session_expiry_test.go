@@ -0,0 +1,60 @@
+package terminal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScanExpiringSessions makes sure that the expiry scan warns exactly once
+// about a session that isn't running and expires within the lead time.
+func TestScanExpiringSessions(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.ExpiryWarning = time.Hour
+
+	now := time.Now()
+	addSession := func(label string, expiry time.Time) *session.Session {
+		sess := newTestSession(t, label, session.TypeMacaroonAdmin)
+		sess.Expiry = expiry
+		require.NoError(t, s.db.StoreSession(sess))
+
+		return sess
+	}
+
+	stopped := addSession("stopped", now.Add(30*time.Minute))
+	addSession("later", now.Add(2*time.Hour))
+	revoked := addSession("revoked", now.Add(30*time.Minute))
+	require.NoError(t, s.db.RevokeSession(revoked.LocalPublicKey, ""))
+
+	sub, err := s.db.(*session.DB).SubscribeStateChanges()
+	require.NoError(t, err)
+	defer sub.Cancel()
+
+	// Scanning again doesn't warn about the same session twice.
+	s.scanExpiringSessions(now)
+	s.scanExpiringSessions(now.Add(time.Minute))
+
+	var warnings []*session.StateChange
+	timeout := time.After(100 * time.Millisecond)
+collect:
+	for {
+		select {
+		case update := <-sub.Updates():
+			change := update.(*session.StateChange)
+			if change.ExpiringSoon {
+				warnings = append(warnings, change)
+			}
+
+		case <-timeout:
+			break collect
+		}
+	}
+
+	require.Len(t, warnings, 1)
+	require.Equal(t, "stopped", warnings[0].Session.Label)
+	require.True(t, stopped.LocalPublicKey.IsEqual(
+		warnings[0].Session.LocalPublicKey,
+	))
+}
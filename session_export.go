@@ -0,0 +1,157 @@
+package terminal
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// exportChunkEvents is the maximum number of audit events that are
+	// sent in a single chunk of an export.
+	exportChunkEvents = 500
+)
+
+// exportCSVHeader is the header line of an audit event export in the CSV
+// format.
+var exportCSVHeader = []string{
+	"timestamp", "timestamp_seconds", "type", "local_public_key",
+}
+
+// exportedEvent is a single audit event in the form it is exported in.
+type exportedEvent struct {
+	Timestamp        string `json:"timestamp"`
+	TimestampSeconds int64  `json:"timestamp_seconds"`
+	Type             string `json:"type"`
+	LocalPublicKey   string `json:"local_public_key"`
+}
+
+// newExportedEvent converts the given audit event into its exported form.
+func newExportedEvent(event *session.AuditEvent) (*exportedEvent, error) {
+	rpcType, err := marshalRPCEventType(event.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return &exportedEvent{
+		Timestamp:        event.Timestamp.UTC().Format(time.RFC3339),
+		TimestampSeconds: event.Timestamp.Unix(),
+		Type:             rpcType.String(),
+		LocalPublicKey: hex.EncodeToString(
+			event.LocalPublicKey.SerializeCompressed(),
+		),
+	}, nil
+}
+
+// writeExportedEvent appends the given event to the buffer in the given format.
+func writeExportedEvent(buf *bytes.Buffer, format litrpc.EventExportFormat,
+	event *exportedEvent) error {
+
+	switch format {
+	case litrpc.EventExportFormat_EXPORT_FORMAT_CSV:
+		return writeCSVLine(buf, []string{
+			event.Timestamp,
+			strconv.FormatInt(event.TimestampSeconds, 10),
+			event.Type, event.LocalPublicKey,
+		})
+
+	case litrpc.EventExportFormat_EXPORT_FORMAT_JSON:
+		return json.NewEncoder(buf).Encode(event)
+
+	default:
+		return fmt.Errorf("unknown export format %v", format)
+	}
+}
+
+// writeCSVLine appends a single line of comma separated values to the buffer.
+func writeCSVLine(buf *bytes.Buffer, values []string) error {
+	w := csv.NewWriter(buf)
+	if err := w.Write(values); err != nil {
+		return err
+	}
+	w.Flush()
+
+	return w.Error()
+}
+
+// ExportSessionEvents streams the audit events selected by the request in the
+// requested format. Large exports are split into chunks of whole events.
+func (s *sessionRpcServer) ExportSessionEvents(
+	req *litrpc.ExportSessionEventsRequest,
+	stream litrpc.Sessions_ExportSessionEventsServer) error {
+
+	if _, ok := litrpc.EventExportFormat_name[int32(req.Format)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown export "+
+			"format %d", req.Format)
+	}
+
+	if req.StartTimestampSeconds != 0 && req.EndTimestampSeconds != 0 &&
+		req.StartTimestampSeconds > req.EndTimestampSeconds {
+
+		return status.Error(codes.InvalidArgument, "start_timestamp_"+
+			"seconds must not be after end_timestamp_seconds")
+	}
+
+	filter, err := auditEventFilter(
+		req.LocalPublicKey, req.StartTimestampSeconds,
+		req.EndTimestampSeconds,
+	)
+	if err != nil {
+		return err
+	}
+
+	events, err := s.db.ListAuditEvents(filter)
+	if err != nil {
+		return fmt.Errorf("error listing session events: %v", err)
+	}
+
+	send := func(chunk *bytes.Buffer) error {
+		err := stream.Send(&litrpc.ExportSessionEventsResponse{
+			Data: append([]byte(nil), chunk.Bytes()...),
+		})
+		chunk.Reset()
+
+		return err
+	}
+
+	var chunk bytes.Buffer
+	if req.Format == litrpc.EventExportFormat_EXPORT_FORMAT_CSV {
+		if err := writeCSVLine(&chunk, exportCSVHeader); err != nil {
+			return err
+		}
+	}
+
+	for idx, event := range events {
+		exported, err := newExportedEvent(event)
+		if err != nil {
+			return err
+		}
+
+		err = writeExportedEvent(&chunk, req.Format, exported)
+		if err != nil {
+			return fmt.Errorf("error exporting session event: %v",
+				err)
+		}
+
+		if (idx+1)%exportChunkEvents == 0 {
+			if err := send(&chunk); err != nil {
+				return err
+			}
+		}
+	}
+
+	if chunk.Len() == 0 {
+		return nil
+	}
+
+	return send(&chunk)
+}
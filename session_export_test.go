@@ -0,0 +1,178 @@
+package terminal
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockExportStream is a Sessions_ExportSessionEventsServer that collects all
+// chunks it is sent.
+type mockExportStream struct {
+	grpc.ServerStream
+
+	chunks [][]byte
+}
+
+// Send records the given chunk.
+func (m *mockExportStream) Send(
+	resp *litrpc.ExportSessionEventsResponse) error {
+
+	m.chunks = append(m.chunks, resp.Data)
+	return nil
+}
+
+// Context returns the context of the stream.
+func (m *mockExportStream) Context() context.Context {
+	return context.Background()
+}
+
+// data returns the concatenation of all received chunks.
+func (m *mockExportStream) data() []byte {
+	return bytes.Join(m.chunks, nil)
+}
+
+// exportEvents runs an export with the given request and returns the stream
+// the chunks were sent to.
+func exportEvents(t *testing.T, s *sessionRpcServer,
+	req *litrpc.ExportSessionEventsRequest) *mockExportStream {
+
+	stream := &mockExportStream{}
+	require.NoError(t, s.ExportSessionEvents(req, stream))
+
+	return stream
+}
+
+// TestExportSessionEvents makes sure that the audit events of a session can be
+// exported both as CSV and as JSON lines.
+func TestExportSessionEvents(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	sess := newTestSession(t, "exported", session.TypeMacaroonAdmin)
+	pubKey := sess.LocalPublicKey
+	other := newTestSession(t, "other", session.TypeMacaroonAdmin)
+
+	require.NoError(t, s.db.AddAuditEvent(pubKey, session.AuditEventCreated))
+	require.NoError(t, s.db.AddAuditEvent(
+		other.LocalPublicKey, session.AuditEventCreated,
+	))
+	require.NoError(t, s.db.AddAuditEvent(pubKey, session.AuditEventRevoked))
+
+	pubKeyHex := hex.EncodeToString(pubKey.SerializeCompressed())
+	pubKeyBytes := pubKey.SerializeCompressed()
+
+	stream := exportEvents(t, s, &litrpc.ExportSessionEventsRequest{
+		LocalPublicKey: pubKeyBytes,
+		Format:         litrpc.EventExportFormat_EXPORT_FORMAT_CSV,
+	})
+	require.Len(t, stream.chunks, 1)
+
+	records, err := csv.NewReader(bytes.NewReader(stream.data())).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, exportCSVHeader, records[0])
+	require.Equal(t, "EVENT_CREATED", records[1][2])
+	require.Equal(t, "EVENT_REVOKED", records[2][2])
+	for _, record := range records[1:] {
+		require.Equal(t, pubKeyHex, record[3])
+
+		_, err := time.Parse(time.RFC3339, record[0])
+		require.NoError(t, err)
+	}
+
+	stream = exportEvents(t, s, &litrpc.ExportSessionEventsRequest{
+		Format: litrpc.EventExportFormat_EXPORT_FORMAT_JSON,
+	})
+	lines := strings.Split(strings.TrimSpace(string(stream.data())), "\n")
+	require.Len(t, lines, 3)
+
+	var events []*exportedEvent
+	for _, line := range lines {
+		event := &exportedEvent{}
+		require.NoError(t, json.Unmarshal([]byte(line), event))
+		events = append(events, event)
+	}
+	require.Equal(t, "EVENT_CREATED", events[0].Type)
+	require.Equal(t, pubKeyHex, events[0].LocalPublicKey)
+	require.Equal(t, "EVENT_REVOKED", events[2].Type)
+	require.InDelta(
+		t, time.Now().Unix(), events[2].TimestampSeconds, 60,
+	)
+}
+
+// TestExportSessionEventsEmpty makes sure that an export of a time range
+// without events only contains the CSV header or nothing at all.
+func TestExportSessionEventsEmpty(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	sess := newTestSession(t, "exported", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.AddAuditEvent(
+		sess.LocalPublicKey, session.AuditEventCreated,
+	))
+
+	past := uint64(time.Now().Add(-time.Hour).Unix())
+	stream := exportEvents(t, s, &litrpc.ExportSessionEventsRequest{
+		EndTimestampSeconds: past,
+		Format:              litrpc.EventExportFormat_EXPORT_FORMAT_CSV,
+	})
+	require.Equal(t, "timestamp,timestamp_seconds,type,local_public_key\n",
+		string(stream.data()))
+
+	stream = exportEvents(t, s, &litrpc.ExportSessionEventsRequest{
+		EndTimestampSeconds: past,
+		Format:              litrpc.EventExportFormat_EXPORT_FORMAT_JSON,
+	})
+	require.Empty(t, stream.chunks)
+}
+
+// TestExportSessionEventsValidation makes sure that unknown formats and
+// inverted time ranges are rejected.
+func TestExportSessionEventsValidation(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	err := s.ExportSessionEvents(&litrpc.ExportSessionEventsRequest{
+		Format: litrpc.EventExportFormat(5),
+	}, &mockExportStream{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	now := uint64(time.Now().Unix())
+	err = s.ExportSessionEvents(&litrpc.ExportSessionEventsRequest{
+		StartTimestampSeconds: now,
+		EndTimestampSeconds:   now - 60,
+	}, &mockExportStream{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestExportSessionEventsChunks makes sure that large exports are split into
+// chunks of whole events.
+func TestExportSessionEventsChunks(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	sess := newTestSession(t, "busy", session.TypeMacaroonAdmin)
+	for i := 0; i < exportChunkEvents+1; i++ {
+		require.NoError(t, s.db.AddAuditEvent(
+			sess.LocalPublicKey, session.AuditEventRenewed,
+		))
+	}
+
+	stream := exportEvents(t, s, &litrpc.ExportSessionEventsRequest{
+		Format: litrpc.EventExportFormat_EXPORT_FORMAT_JSON,
+	})
+	require.Len(t, stream.chunks, 2)
+	require.Equal(
+		t, exportChunkEvents, bytes.Count(stream.chunks[0], []byte("\n")),
+	)
+	require.Equal(t, 1, bytes.Count(stream.chunks[1], []byte("\n")))
+}
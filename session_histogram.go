@@ -0,0 +1,118 @@
+package terminal
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxExpiryHistogramBoundary is the largest bucket boundary in seconds that
+// still fits into a time.Duration.
+const maxExpiryHistogramBoundary = uint64(math.MaxInt64 / int64(time.Second))
+
+// defaultExpiryHistogramBoundaries are the bucket boundaries of the expiry
+// histogram if none are requested.
+var defaultExpiryHistogramBoundaries = []time.Duration{
+	24 * time.Hour,
+	7 * 24 * time.Hour,
+	30 * 24 * time.Hour,
+	90 * 24 * time.Hour,
+}
+
+// expiryHistogram counts the non-revoked sessions of the given ones that
+// already expired at the given time and sorts the others into buckets by how
+// long from now they expire. The boundaries must be strictly increasing.
+func expiryHistogram(sessions []*session.Session, boundaries []time.Duration,
+	now time.Time) *litrpc.GetExpiryHistogramResponse {
+
+	buckets := make([]*litrpc.ExpiryHistogramBucket, len(boundaries)+1)
+	var lower time.Duration
+	for i := range buckets {
+		bucket := &litrpc.ExpiryHistogramBucket{
+			MinSeconds: uint64(lower / time.Second),
+		}
+		if i < len(boundaries) {
+			bucket.MaxSeconds = uint64(boundaries[i] / time.Second)
+			lower = boundaries[i]
+		}
+
+		buckets[i] = bucket
+	}
+
+	resp := &litrpc.GetExpiryHistogramResponse{
+		Buckets: buckets,
+	}
+	for _, sess := range sessions {
+		if sess.State == session.StateRevoked {
+			continue
+		}
+
+		resp.Total++
+
+		if sess.State == session.StateExpired ||
+			!sess.Expiry.After(now) {
+
+			resp.Expired++
+			continue
+		}
+
+		// The bucket of a session is the first one whose upper bound
+		// lies after the session's expiry.
+		remaining := sess.Expiry.Sub(now)
+		i := 0
+		for i < len(boundaries) && remaining >= boundaries[i] {
+			i++
+		}
+		buckets[i].Count++
+	}
+
+	return resp
+}
+
+// GetExpiryHistogram returns the number of non-revoked sessions that expire in
+// each of the requested time ranges from now, and the number of those that
+// already expired.
+func (s *sessionRpcServer) GetExpiryHistogram(_ context.Context,
+	req *litrpc.GetExpiryHistogramRequest) (
+	*litrpc.GetExpiryHistogramResponse, error) {
+
+	boundaries := defaultExpiryHistogramBoundaries
+	if len(req.BucketBoundariesSeconds) > 0 {
+		boundaries = make(
+			[]time.Duration, len(req.BucketBoundariesSeconds),
+		)
+		var prev uint64
+		for i, seconds := range req.BucketBoundariesSeconds {
+			switch {
+			case seconds <= prev:
+				return nil, status.Error(codes.InvalidArgument,
+					"bucket boundaries must be positive "+
+						"and strictly increasing")
+
+			case seconds > maxExpiryHistogramBoundary:
+				return nil, status.Errorf(
+					codes.InvalidArgument, "bucket "+
+						"boundary %d exceeds maximum "+
+						"of %d", seconds,
+					maxExpiryHistogramBoundary,
+				)
+			}
+			prev = seconds
+
+			boundaries[i] = time.Duration(seconds) * time.Second
+		}
+	}
+
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sessions: %v", err)
+	}
+
+	return expiryHistogram(sessions, boundaries, time.Now()), nil
+}
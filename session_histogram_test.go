@@ -0,0 +1,97 @@
+package terminal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetExpiryHistogram makes sure that the expiry histogram counts each
+// non-revoked session in the bucket of its expiry.
+func TestGetExpiryHistogram(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	day := 24 * time.Hour
+	now := time.Now()
+	addSession := func(label string, expiry time.Time) *session.Session {
+		sess := newTestSession(t, label, session.TypeMacaroonAdmin)
+		sess.Expiry = expiry
+		require.NoError(t, s.db.StoreSession(sess))
+
+		return sess
+	}
+
+	addSession("expired", now.Add(-time.Hour))
+	addSession("hours", now.Add(time.Hour))
+	addSession("days", now.Add(3*day))
+	addSession("weeks", now.Add(10*day))
+	addSession("weeks too", now.Add(20*day))
+	addSession("months", now.Add(60*day))
+	addSession("years", now.Add(400*day))
+	addSession("years too", now.Add(800*day))
+	revoked := addSession("revoked", now.Add(3*day))
+	require.NoError(t, s.db.RevokeSession(revoked.LocalPublicKey, ""))
+
+	daySeconds := uint64(day / time.Second)
+	resp, err := s.GetExpiryHistogram(
+		context.Background(), &litrpc.GetExpiryHistogramRequest{},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 8, resp.Total)
+	require.EqualValues(t, 1, resp.Expired)
+	require.Equal(t, []*litrpc.ExpiryHistogramBucket{{
+		MinSeconds: 0,
+		MaxSeconds: daySeconds,
+		Count:      1,
+	}, {
+		MinSeconds: daySeconds,
+		MaxSeconds: 7 * daySeconds,
+		Count:      1,
+	}, {
+		MinSeconds: 7 * daySeconds,
+		MaxSeconds: 30 * daySeconds,
+		Count:      2,
+	}, {
+		MinSeconds: 30 * daySeconds,
+		MaxSeconds: 90 * daySeconds,
+		Count:      1,
+	}, {
+		MinSeconds: 90 * daySeconds,
+		Count:      2,
+	}}, resp.Buckets)
+
+	// Custom boundaries result in one bucket more than there are
+	// boundaries.
+	resp, err = s.GetExpiryHistogram(
+		context.Background(), &litrpc.GetExpiryHistogramRequest{
+			BucketBoundariesSeconds: []uint64{365 * daySeconds},
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.Expired)
+	require.Len(t, resp.Buckets, 2)
+	require.EqualValues(t, 5, resp.Buckets[0].Count)
+	require.EqualValues(t, 2, resp.Buckets[1].Count)
+
+	// Boundaries that are zero, not strictly increasing or too large are
+	// refused.
+	for _, boundaries := range [][]uint64{
+		{0},
+		{daySeconds, daySeconds},
+		{7 * daySeconds, daySeconds},
+		{maxExpiryHistogramBoundary + 1},
+	} {
+		_, err = s.GetExpiryHistogram(
+			context.Background(), &litrpc.GetExpiryHistogramRequest{
+				BucketBoundariesSeconds: boundaries,
+			},
+		)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
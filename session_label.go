@@ -0,0 +1,121 @@
+package terminal
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/lightninglabs/lightning-terminal/session"
+)
+
+const (
+	// autoLabelPrefix is the prefix of all generated session labels.
+	autoLabelPrefix = "session-"
+
+	// autoLabelPubKeyBytes is the number of bytes of the local public key,
+	// after its parity byte, that a generated label of the pubkey scheme
+	// contains.
+	autoLabelPubKeyBytes = 4
+)
+
+// autoLabelEnabled returns true if sessions that are added without a label
+// get a generated one.
+func (s *sessionRpcServer) autoLabelEnabled() bool {
+	return s.cfg.AutoLabel != "" && s.cfg.AutoLabel != autoLabelNone
+}
+
+// reserveLabel generates a label for the given session according to the
+// configured auto label scheme. The label is neither used by a stored session
+// nor reserved for another session that is being added. It stays reserved
+// until it is released with releaseLabel, which must happen once the session
+// is stored or adding it failed.
+func (s *sessionRpcServer) reserveLabel(sess *session.Session) (string,
+	error) {
+
+	s.pendingLabelsMtx.Lock()
+	defer s.pendingLabelsMtx.Unlock()
+
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		return "", fmt.Errorf("error fetching sessions: %v", err)
+	}
+
+	taken := make(map[string]struct{}, len(sessions)+len(s.pendingLabels))
+	for _, other := range sessions {
+		taken[other.Label] = struct{}{}
+	}
+	for label := range s.pendingLabels {
+		taken[label] = struct{}{}
+	}
+	isTaken := func(label string) bool {
+		_, ok := taken[label]
+		return ok
+	}
+
+	var label string
+	switch s.cfg.AutoLabel {
+	// The start of the public key is random, so a number is only appended
+	// in the rare case of a collision.
+	case autoLabelPubKey:
+		pubKey := sess.LocalPublicKey.SerializeCompressed()
+		base := autoLabelPrefix + hex.EncodeToString(
+			pubKey[1:1+autoLabelPubKeyBytes],
+		)
+
+		label = base
+		for n := 2; isTaken(label); n++ {
+			label = fmt.Sprintf("%s-%d", base, n)
+		}
+
+	// Sessions are never deleted, so counting from the number of existing
+	// sessions keeps the numbers increasing.
+	case autoLabelCounter:
+		n := len(sessions) + 1
+		label = fmt.Sprintf("%s%d", autoLabelPrefix, n)
+		for isTaken(label) {
+			n++
+			label = fmt.Sprintf("%s%d", autoLabelPrefix, n)
+		}
+
+	default:
+		return "", fmt.Errorf("unknown auto label scheme %q",
+			s.cfg.AutoLabel)
+	}
+
+	s.pendingLabels[label] = struct{}{}
+
+	return label, nil
+}
+
+// releaseLabel releases a label that was reserved with reserveLabel.
+func (s *sessionRpcServer) releaseLabel(label string) {
+	s.pendingLabelsMtx.Lock()
+	defer s.pendingLabelsMtx.Unlock()
+
+	delete(s.pendingLabels, label)
+}
+
+// applyAutoLabel gives the given session a generated label if it has none and
+// labels are generated. The returned function releases the reserved label and
+// must be called once the session is stored or adding it failed.
+func (s *sessionRpcServer) applyAutoLabel(sess *session.Session) (func(),
+	error) {
+
+	if sess.Label != "" || !s.autoLabelEnabled() {
+		return func() {}, nil
+	}
+
+	label, err := s.reserveLabel(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateLabel(label); err != nil {
+		s.releaseLabel(label)
+		return nil, err
+	}
+	sess.Label = label
+
+	return func() {
+		s.releaseLabel(label)
+	}, nil
+}
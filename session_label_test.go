@@ -0,0 +1,66 @@
+package terminal
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAutoLabel makes sure that sessions added without a label get a
+// deterministic generated label that no other session uses, and that the
+// generated label is stored.
+func TestAutoLabel(t *testing.T) {
+	t.Run("counter", func(t *testing.T) {
+		s := newTestSessionRpcServer(t)
+		s.cfg.AutoLabel = autoLabelCounter
+
+		first := addTestUISession(t, s, "")
+		require.Equal(t, "session-1", first.Label)
+
+		// A label that is taken already is skipped.
+		taken := newTestSession(t, "session-3", session.TypeUIPassword)
+		require.NoError(t, s.db.StoreSession(taken))
+
+		second := addTestUISession(t, s, "")
+		require.Equal(t, "session-4", second.Label)
+
+		// Explicit labels are left alone.
+		explicit := addTestUISession(t, s, "explicit")
+		require.Equal(t, "explicit", explicit.Label)
+
+		stored, err := s.db.ListSessions()
+		require.NoError(t, err)
+
+		labels := make(map[string]int)
+		for _, sess := range stored {
+			labels[sess.Label]++
+		}
+		require.Equal(t, map[string]int{
+			"session-1": 1,
+			"session-3": 1,
+			"session-4": 1,
+			"explicit":  1,
+		}, labels)
+	})
+
+	t.Run("pubkey", func(t *testing.T) {
+		s := newTestSessionRpcServer(t)
+		s.cfg.AutoLabel = autoLabelPubKey
+
+		sess := addTestUISession(t, s, "")
+		require.Equal(
+			t, "session-"+hex.EncodeToString(
+				sess.LocalPublicKey[1:1+autoLabelPubKeyBytes],
+			), sess.Label,
+		)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		s := newTestSessionRpcServer(t)
+		s.cfg.AutoLabel = autoLabelNone
+
+		require.Empty(t, addTestUISession(t, s, "").Label)
+	})
+}
@@ -0,0 +1,84 @@
+package terminal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateMetadataUpdate makes sure that the given metadata keys to set and
+// remove are non-empty and that no key is both set and removed.
+func validateMetadataUpdate(set map[string]string, remove []string) error {
+	if len(set) == 0 && len(remove) == 0 {
+		return status.Error(codes.InvalidArgument, "no metadata keys "+
+			"to set or delete given")
+	}
+
+	for key := range set {
+		if key == "" {
+			return status.Error(codes.InvalidArgument, "metadata "+
+				"keys must not be empty")
+		}
+	}
+
+	for _, key := range remove {
+		if key == "" {
+			return status.Error(codes.InvalidArgument, "metadata "+
+				"keys must not be empty")
+		}
+
+		if _, ok := set[key]; ok {
+			return status.Errorf(codes.InvalidArgument, "metadata "+
+				"key %q can't be both set and deleted", key)
+		}
+	}
+
+	return nil
+}
+
+// UpdateSessionsMetadata sets and deletes the given metadata keys of all
+// sessions that match the given filter in a single transaction. Sessions whose
+// metadata already is in the requested shape aren't counted as updated.
+func (s *sessionRpcServer) UpdateSessionsMetadata(ctx context.Context,
+	req *litrpc.UpdateSessionsMetadataRequest) (
+	*litrpc.UpdateSessionsMetadataResponse, error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	if err := validateMetadataUpdate(req.Set, req.DeleteKeys); err != nil {
+		return nil, err
+	}
+
+	filter := req.Filter
+	if filter == nil {
+		filter = &litrpc.ListSessionsRequest{}
+	}
+
+	sessions, err := s.filterSessions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*btcec.PublicKey, 0, len(sessions))
+	for _, sess := range sessions {
+		keys = append(keys, sess.LocalPublicKey)
+	}
+
+	numUpdated, err := s.db.UpdateSessionsMetadata(
+		keys, req.Set, req.DeleteKeys,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error updating session metadata: %v",
+			err)
+	}
+
+	return &litrpc.UpdateSessionsMetadataResponse{
+		NumUpdated: uint32(numUpdated),
+	}, nil
+}
@@ -0,0 +1,114 @@
+package terminal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestUpdateSessionsMetadata makes sure that the metadata of exactly the
+// sessions that match the filter is updated and that the change persists.
+func TestUpdateSessionsMetadata(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	labels := []string{"team a 1", "team a 2", "other"}
+	sessions := make(map[string]*session.Session, len(labels))
+	for _, label := range labels {
+		sess := newTestSession(t, label, session.TypeMacaroonAdmin)
+		require.NoError(t, s.db.StoreSession(sess))
+		sessions[label] = sess
+	}
+
+	update := func(filter *litrpc.ListSessionsRequest, set map[string]string,
+		remove []string) uint32 {
+
+		resp, err := s.UpdateSessionsMetadata(
+			ctx, &litrpc.UpdateSessionsMetadataRequest{
+				Filter:     filter,
+				Set:        set,
+				DeleteKeys: remove,
+			},
+		)
+		require.NoError(t, err)
+
+		return resp.NumUpdated
+	}
+	metadata := func(label string) map[string]string {
+		stored, err := s.db.GetSession(sessions[label].LocalPublicKey)
+		require.NoError(t, err)
+
+		return stored.Metadata
+	}
+
+	// Tag the sessions by their label first.
+	numUpdated := update(
+		&litrpc.ListSessionsRequest{LabelQuery: "team a"},
+		map[string]string{"owner": "teamA", "tier": "gold"}, nil,
+	)
+	require.EqualValues(t, 2, numUpdated)
+	numUpdated = update(
+		&litrpc.ListSessionsRequest{LabelQuery: "other"},
+		map[string]string{"owner": "teamB", "tier": "gold"}, nil,
+	)
+	require.EqualValues(t, 1, numUpdated)
+
+	// Now rename the owner of all sessions of team A and drop their tier.
+	numUpdated = update(
+		&litrpc.ListSessionsRequest{
+			Metadata: map[string]string{"owner": "teamA"},
+		},
+		map[string]string{"owner": "platform"}, []string{"tier"},
+	)
+	require.EqualValues(t, 2, numUpdated)
+
+	for _, label := range labels[:2] {
+		require.Equal(
+			t, map[string]string{"owner": "platform"},
+			metadata(label),
+		)
+	}
+	require.Equal(
+		t, map[string]string{"owner": "teamB", "tier": "gold"},
+		metadata("other"),
+	)
+
+	// The metadata is returned with the sessions and can be filtered on.
+	listResp, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{
+		Metadata: map[string]string{"owner": "platform"},
+	})
+	require.NoError(t, err)
+	require.Len(t, listResp.Sessions, 2)
+	for _, sess := range listResp.Sessions {
+		require.Equal(t, "platform", sess.Metadata["owner"])
+	}
+
+	// Running the same update again doesn't change anything.
+	numUpdated = update(
+		&litrpc.ListSessionsRequest{
+			Metadata: map[string]string{"owner": "platform"},
+		},
+		map[string]string{"owner": "platform"}, []string{"tier"},
+	)
+	require.Zero(t, numUpdated)
+
+	// Invalid updates are rejected.
+	invalid := []*litrpc.UpdateSessionsMetadataRequest{
+		{},
+		{Set: map[string]string{"": "value"}},
+		{DeleteKeys: []string{""}},
+		{
+			Set:        map[string]string{"owner": "value"},
+			DeleteKeys: []string{"owner"},
+		},
+	}
+	for _, req := range invalid {
+		_, err := s.UpdateSessionsMetadata(ctx, req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
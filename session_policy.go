@@ -0,0 +1,62 @@
+package terminal
+
+import (
+	"context"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+)
+
+// SessionPolicy decides whether a new session may be created. It lets
+// operators enforce their own rules on top of the built-in validation of add
+// session requests.
+type SessionPolicy interface {
+	// CheckAddSession is called with every add session request that passed
+	// the built-in validation. Sessions that are created without such a
+	// request, like clones or imported macaroons, are described by an
+	// equivalent request. A non-nil error aborts the creation of the
+	// session.
+	CheckAddSession(ctx context.Context,
+		req *litrpc.AddSessionRequest) error
+}
+
+// permissiveSessionPolicy is the default session policy that allows all
+// sessions.
+type permissiveSessionPolicy struct{}
+
+// CheckAddSession allows every session.
+//
+// NOTE: This is part of the SessionPolicy interface.
+func (permissiveSessionPolicy) CheckAddSession(context.Context,
+	*litrpc.AddSessionRequest) error {
+
+	return nil
+}
+
+// A compile-time check to make sure our permissiveSessionPolicy satisfies the
+// SessionPolicy interface.
+var _ SessionPolicy = (*permissiveSessionPolicy)(nil)
+
+// SetSessionPolicy sets the policy that is consulted before every new session
+// is created. It must be called before Run. By default all sessions that pass
+// the built-in validation are allowed.
+func (g *LightningTerminal) SetSessionPolicy(policy SessionPolicy) {
+	g.sessionPolicy = policy
+}
+
+// RecipeInterceptor is called with the recipe of every session macaroon right
+// before it is baked. It lets operators augment the recipes of all sessions
+// centrally, for example with caveats every macaroon of their organization
+// must carry. The returned recipe is baked instead of the given one, which may
+// be modified in place. A non-nil error aborts the baking.
+type RecipeInterceptor func(ctx context.Context, sess *session.Session,
+	recipe *session.MacaroonRecipe) (*session.MacaroonRecipe, error)
+
+// SetRecipeInterceptor sets the interceptor that is called with the recipe of
+// every session macaroon before it is baked. It must be called before Run. By
+// default the recipes are baked unchanged.
+func (g *LightningTerminal) SetRecipeInterceptor(
+	interceptor RecipeInterceptor) {
+
+	g.recipeInterceptor = interceptor
+}
@@ -0,0 +1,217 @@
+package terminal
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+// noAdminPolicy is a session policy that rejects all admin sessions.
+type noAdminPolicy struct{}
+
+// CheckAddSession rejects admin sessions.
+func (noAdminPolicy) CheckAddSession(_ context.Context,
+	req *litrpc.AddSessionRequest) error {
+
+	if req.SessionType == litrpc.SessionType_TYPE_MACAROON_ADMIN {
+		return errors.New("admin sessions are not allowed")
+	}
+
+	return nil
+}
+
+// TestAddSessionPolicy makes sure that AddSession consults the configured
+// session policy and only creates the sessions it allows.
+func TestAddSessionPolicy(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.policy = noAdminPolicy{}
+
+	addSession := func(typ litrpc.SessionType) error {
+		_, err := s.AddSession(
+			context.Background(), &litrpc.AddSessionRequest{
+				Label:       "policy",
+				SessionType: typ,
+				ExpiryTimestampSeconds: uint64(
+					time.Now().Add(time.Hour).Unix(),
+				),
+				MailboxServerAddr: "localhost:1234",
+			},
+		)
+
+		return err
+	}
+
+	err := addSession(litrpc.SessionType_TYPE_MACAROON_ADMIN)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Contains(t, err.Error(), "admin sessions are not allowed")
+
+	err = addSession(litrpc.SessionType_TYPE_MACAROON_READONLY)
+	require.NoError(t, err)
+
+	resp, err := s.ListSessions(
+		context.Background(), &litrpc.ListSessionsRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 1)
+	require.Equal(
+		t, litrpc.SessionType_TYPE_MACAROON_READONLY,
+		resp.Sessions[0].SessionType,
+	)
+}
+
+// recordingPolicy is a session policy that records every request it is called
+// with and rejects all of them.
+type recordingPolicy struct {
+	reqs []*litrpc.AddSessionRequest
+}
+
+// CheckAddSession records the given request and rejects it.
+func (r *recordingPolicy) CheckAddSession(_ context.Context,
+	req *litrpc.AddSessionRequest) error {
+
+	r.reqs = append(r.reqs, req)
+	return errors.New("no new sessions")
+}
+
+// TestCloneAndImportSessionPolicy makes sure that the session policy is also
+// consulted before a session is cloned or a macaroon is imported as a session,
+// with a request that describes the new session.
+func TestCloneAndImportSessionPolicy(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	orig := newTestSession(t, "orig", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(orig))
+
+	s.policy = noAdminPolicy{}
+	_, err := s.CloneSession(ctx, &litrpc.CloneSessionRequest{
+		LocalPublicKey: orig.LocalPublicKey.SerializeCompressed(),
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Contains(t, err.Error(), "admin sessions are not allowed")
+
+	macID, err := proto.Marshal(&lnrpc.MacaroonId{
+		Nonce:     []byte("nonce"),
+		StorageId: []byte("0"),
+		Ops: []*lnrpc.Op{{
+			Entity:  "info",
+			Actions: []string{"read"},
+		}},
+	})
+	require.NoError(t, err)
+	mac, err := macaroon.New(
+		[]byte("root key"),
+		append([]byte{byte(bakery.LatestVersion)}, macID...), "lnd",
+		macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+	macBytes, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	policy := &recordingPolicy{}
+	s.policy = policy
+	_, err = s.ImportMacaroonAsSession(
+		ctx, &litrpc.ImportMacaroonAsSessionRequest{
+			Macaroon: hex.EncodeToString(macBytes),
+			Label:    "imported",
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		},
+	)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	require.Len(t, policy.reqs, 1)
+	req := policy.reqs[0]
+	require.Equal(t, "imported", req.Label)
+	require.Equal(
+		t, litrpc.SessionType_TYPE_MACAROON_CUSTOM, req.SessionType,
+	)
+	require.Equal(t, []*litrpc.MacaroonPermission{{
+		Entity: "info",
+		Action: "read",
+	}}, req.MacaroonCustomPermissions)
+
+	// Neither session was stored.
+	sessions, err := s.db.ListSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+}
+
+// TestRecipeInterceptor makes sure that the recipe interceptor is called before
+// the macaroon of every macaroon session is baked and that the caveats it adds
+// end up in the baked macaroons, both for new and for resumed sessions.
+func TestRecipeInterceptor(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	orgCaveat := macaroon.Caveat{Id: []byte("ipaddr 10.0.0.1")}
+	s.recipeInterceptor = func(_ context.Context, _ *session.Session,
+		recipe *session.MacaroonRecipe) (*session.MacaroonRecipe,
+		error) {
+
+		recipe.Caveats = append(recipe.Caveats, orgCaveat)
+		return recipe, nil
+	}
+
+	var baked []*session.MacaroonRecipe
+	s.superMacBaker = func(_ context.Context, _ uint64,
+		recipe *session.MacaroonRecipe) (string, error) {
+
+		baked = append(baked, recipe)
+		return "mac", nil
+	}
+
+	for _, typ := range []litrpc.SessionType{
+		litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		litrpc.SessionType_TYPE_MACAROON_READONLY,
+		litrpc.SessionType_TYPE_UI_PASSWORD,
+	} {
+		_, err := s.AddSession(
+			context.Background(), &litrpc.AddSessionRequest{
+				Label:       "intercepted",
+				SessionType: typ,
+				ExpiryTimestampSeconds: uint64(
+					time.Now().Add(time.Hour).Unix(),
+				),
+				MailboxServerAddr: "localhost:1234",
+			},
+		)
+		require.NoError(t, err)
+	}
+
+	resumed := newTestSession(t, "resumed", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(resumed))
+	require.NoError(t, s.resumeSession(resumed, 0))
+
+	// UI password sessions don't bake a macaroon, so only the other
+	// sessions were intercepted.
+	require.Len(t, baked, 3)
+	for _, recipe := range baked {
+		require.Contains(t, recipe.Caveats, orgCaveat)
+	}
+
+	// An error of the interceptor aborts the baking.
+	s.recipeInterceptor = func(context.Context, *session.Session,
+		*session.MacaroonRecipe) (*session.MacaroonRecipe, error) {
+
+		return nil, errors.New("no caveats for you")
+	}
+	failed := newTestSession(t, "failed", session.TypeMacaroonAdmin)
+	_, err := s.sessionAuthData(failed)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no caveats for you")
+	require.Len(t, baked, 3)
+}
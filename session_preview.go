@@ -0,0 +1,135 @@
+package terminal
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// previewMacaroonLocation is the location of preview macaroons. It matches the
+// location of lnd's macaroons, so a preview looks exactly like the macaroon a
+// session receives.
+const previewMacaroonLocation = "lnd"
+
+// bakePreviewMacaroon bakes a macaroon with the given recipe under a random
+// root key that only lives in memory, so the macaroon can be inspected but
+// never used. It is returned hex encoded.
+func bakePreviewMacaroon(ctx context.Context,
+	recipe *session.MacaroonRecipe) (string, error) {
+
+	previewBakery := bakery.New(bakery.BakeryParams{
+		Location:     previewMacaroonLocation,
+		RootKeyStore: bakery.NewMemRootKeyStore(),
+	})
+
+	mac, err := previewBakery.Oven.NewMacaroon(
+		ctx, bakery.LatestVersion, nil, recipe.Permissions...,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	for _, caveat := range recipe.Caveats {
+		if err := mac.M().AddFirstPartyCaveat(caveat.Id); err != nil {
+			return "", err
+		}
+	}
+
+	macBytes, err := mac.M().MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(macBytes), nil
+}
+
+// PreviewSessionMacaroon resolves the permissions and caveats the macaroon of
+// a session added with the given request would be baked with, without adding
+// the session. If requested, a macaroon with that recipe is baked under a
+// throwaway root key.
+func (s *sessionRpcServer) PreviewSessionMacaroon(ctx context.Context,
+	req *litrpc.PreviewSessionMacaroonRequest) (
+	*litrpc.PreviewSessionMacaroonResponse, error) {
+
+	addReq := req.Session
+	if addReq == nil {
+		return nil, status.Error(codes.InvalidArgument, "no session "+
+			"given")
+	}
+
+	typ, err := unmarshalRPCType(addReq.SessionType)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if typ == session.TypeUIPassword {
+		return nil, status.Error(codes.InvalidArgument, "UI password "+
+			"sessions don't use a macaroon")
+	}
+
+	if !isSupportedSessionType(typ) {
+		return nil, status.Error(codes.InvalidArgument, "invalid "+
+			"session type, only admin, readonly and custom "+
+			"macaroon types can be previewed")
+	}
+
+	perms, err := s.addSessionPermissions(addReq, typ)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSessionPermissions(typ, perms); err != nil {
+		return nil, err
+	}
+
+	// The recipe is resolved from a session that is set up exactly like
+	// a new session is, so the preview can't drift from what AddSession
+	// stores.
+	sess := &session.Session{
+		Label: addReq.Label,
+		Type:  typ,
+		MacaroonExpiry: time.Duration(
+			addReq.MacaroonExpirySeconds,
+		) * time.Second,
+		GroupID: addReq.GroupId,
+	}
+	if perms != nil {
+		sess.MacaroonRecipe = &session.MacaroonRecipe{
+			Permissions: perms,
+		}
+	}
+	if addReq.AutoRenewUntil != 0 {
+		sess.AutoRenewUntil = time.Unix(int64(addReq.AutoRenewUntil), 0)
+		sess.RenewInterval = time.Duration(
+			addReq.RenewIntervalSeconds,
+		) * time.Second
+	}
+
+	// The macaroon is previewed as it would be baked right now, including
+	// its expiry and the changes of the recipe interceptor.
+	recipe, err := s.bakeRecipe(ctx, sess, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &litrpc.PreviewSessionMacaroonResponse{
+		Recipe: marshalRPCRecipe(recipe),
+	}
+	if !req.Bake {
+		return resp, nil
+	}
+
+	resp.Macaroon, err = bakePreviewMacaroon(ctx, recipe)
+	if err != nil {
+		return nil, fmt.Errorf("error baking preview macaroon: %v", err)
+	}
+
+	return resp, nil
+}
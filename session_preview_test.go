@@ -0,0 +1,136 @@
+package terminal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/macaroon.v2"
+)
+
+// TestPreviewSessionMacaroon makes sure that the previewed macaroon recipe
+// matches the one of a session added with the same request and that the
+// preview doesn't add a session.
+func TestPreviewSessionMacaroon(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	templates, err := parsePermissionTemplates([]string{
+		"info=info:read,offchain:read",
+	})
+	require.NoError(t, err)
+	s.cfg.permissionTemplates = templates
+
+	tests := []*litrpc.AddSessionRequest{{
+		Label:       "readonly",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_READONLY,
+	}, {
+		Label:       "admin",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+	}, {
+		Label:              "custom",
+		SessionType:        litrpc.SessionType_TYPE_MACAROON_CUSTOM,
+		PermissionTemplate: "info",
+	}}
+
+	for _, req := range tests {
+		req.ExpiryTimestampSeconds = uint64(
+			time.Now().Add(time.Hour).Unix(),
+		)
+		req.MailboxServerAddr = "localhost:1234"
+
+		preview, err := s.PreviewSessionMacaroon(
+			ctx, &litrpc.PreviewSessionMacaroonRequest{
+				Session: req,
+				Bake:    true,
+			},
+		)
+		require.NoError(t, err)
+		require.NotEmpty(t, preview.Recipe.Permissions)
+
+		// The preview macaroon carries the previewed caveats.
+		mac, err := session.ParseMacaroon(preview.Macaroon)
+		require.NoError(t, err)
+		require.Equal(t, previewMacaroonLocation, mac.Location())
+		require.Len(t, mac.Caveats(), len(preview.Recipe.Caveats))
+
+		// Nothing is stored by the preview.
+		listResp, err := s.ListSessions(
+			ctx, &litrpc.ListSessionsRequest{LabelQuery: req.Label},
+		)
+		require.NoError(t, err)
+		require.Empty(t, listResp.Sessions)
+
+		addResp, err := s.AddSession(ctx, req)
+		require.NoError(t, err)
+
+		permsResp, err := s.GetSessionPermissions(
+			ctx, &litrpc.GetSessionPermissionsRequest{
+				LocalPublicKey: addResp.Session.LocalPublicKey,
+			},
+		)
+		require.NoError(t, err)
+		require.ElementsMatch(
+			t, permsResp.Permissions, preview.Recipe.Permissions,
+		)
+		require.Equal(t, permsResp.Caveats, preview.Recipe.Caveats)
+
+		// A custom session stores exactly the previewed recipe.
+		if req.SessionType != litrpc.SessionType_TYPE_MACAROON_CUSTOM {
+			continue
+		}
+
+		pubKey, err := btcec.ParsePubKey(
+			addResp.Session.LocalPublicKey, btcec.S256(),
+		)
+		require.NoError(t, err)
+		stored, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+		require.Equal(
+			t, preview.Recipe, marshalRPCRecipe(stored.MacaroonRecipe),
+		)
+	}
+
+	// The preview includes the expiry caveat and the changes of the recipe
+	// interceptor, just like the baked macaroon.
+	s.recipeInterceptor = func(_ context.Context, sess *session.Session,
+		recipe *session.MacaroonRecipe) (*session.MacaroonRecipe,
+		error) {
+
+		recipe.Caveats = append(recipe.Caveats, macaroon.Caveat{
+			Id: []byte("label " + sess.Label),
+		})
+		return recipe, nil
+	}
+	readonly := litrpc.SessionType_TYPE_MACAROON_READONLY
+	expiringReq := &litrpc.AddSessionRequest{
+		Label:                 "expiring",
+		SessionType:           readonly,
+		MacaroonExpirySeconds: 60,
+	}
+	preview, err := s.PreviewSessionMacaroon(
+		ctx, &litrpc.PreviewSessionMacaroonRequest{
+			Session: expiringReq,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, preview.Recipe.Caveats, 2)
+	require.Contains(t, preview.Recipe.Caveats[0], "time-before")
+	require.Equal(t, "label expiring", preview.Recipe.Caveats[1])
+
+	// UI password sessions don't use a macaroon.
+	_, err = s.PreviewSessionMacaroon(
+		ctx, &litrpc.PreviewSessionMacaroonRequest{
+			Session: &litrpc.AddSessionRequest{
+				SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+			},
+		},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
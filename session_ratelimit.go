@@ -0,0 +1,112 @@
+package terminal
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+const (
+	// maxRateLimitBuckets is the number of buckets above which the buckets
+	// that are completely refilled are dropped again, so callers that
+	// stopped sending requests don't accumulate.
+	maxRateLimitBuckets = 1000
+)
+
+// tokenBucket holds the tokens that are left for a single caller.
+type tokenBucket struct {
+	tokens     float64
+	lastUpdate time.Time
+}
+
+// rateLimiter is a token bucket rate limiter that keeps a separate bucket for
+// each caller. Each bucket holds up to burst tokens and is refilled with rate
+// tokens per second. It is safe for concurrent use.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+	clock clock.Clock
+
+	buckets map[string]*tokenBucket
+	mu      sync.Mutex
+}
+
+// newRateLimiter creates a new rate limiter that allows each caller to send
+// rate requests per second on average and up to burst requests at once. A rate
+// of 0 disables the limiter, in which case nil is returned.
+func newRateLimiter(rate float64, burst uint32,
+	clock clock.Clock) *rateLimiter {
+
+	if rate == 0 {
+		return nil
+	}
+
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		clock:   clock,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// reserve takes a token from the bucket of the given caller. If the bucket is
+// empty, false is returned together with the time until the next token is
+// available. An empty key refers to the bucket that is shared by all callers
+// whose identity is unknown.
+func (r *rateLimiter) reserve(key string) (bool, time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.clock.Now()
+	bucket, ok := r.buckets[key]
+	if !ok {
+		r.prune(now)
+
+		bucket = &tokenBucket{
+			tokens:     r.burst,
+			lastUpdate: now,
+		}
+		r.buckets[key] = bucket
+	}
+
+	r.refill(bucket, now)
+
+	if bucket.tokens < 1 {
+		wait := (1 - bucket.tokens) / r.rate * float64(time.Second)
+		return false, time.Duration(math.Ceil(wait))
+	}
+
+	bucket.tokens--
+
+	return true, 0
+}
+
+// refill adds the tokens that accumulated since the last update of the given
+// bucket, without exceeding the burst size.
+func (r *rateLimiter) refill(bucket *tokenBucket, now time.Time) {
+	elapsed := now.Sub(bucket.lastUpdate).Seconds()
+	if elapsed > 0 {
+		bucket.tokens = math.Min(
+			r.burst, bucket.tokens+elapsed*r.rate,
+		)
+		bucket.lastUpdate = now
+	}
+}
+
+// prune drops all completely refilled buckets once there are too many of them.
+// A dropped bucket is recreated in the same full state on the caller's next
+// request. The caller must hold the mutex.
+func (r *rateLimiter) prune(now time.Time) {
+	if len(r.buckets) < maxRateLimitBuckets {
+		return
+	}
+
+	for key, bucket := range r.buckets {
+		r.refill(bucket, now)
+		if bucket.tokens >= r.burst {
+			delete(r.buckets, key)
+		}
+	}
+}
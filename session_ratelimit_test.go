@@ -0,0 +1,120 @@
+package terminal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestRateLimiter makes sure that a bucket allows up to burst requests at once,
+// is refilled over time and that each caller has its own bucket.
+func TestRateLimiter(t *testing.T) {
+	testClock := clock.NewTestClock(time.Unix(1000, 0))
+	limiter := newRateLimiter(2, 3, testClock)
+
+	for i := 0; i < 3; i++ {
+		ok, _ := limiter.reserve("alice")
+		require.True(t, ok)
+	}
+
+	ok, retryAfter := limiter.reserve("alice")
+	require.False(t, ok)
+	require.Equal(t, 500*time.Millisecond, retryAfter)
+
+	// Other callers aren't affected.
+	ok, _ = limiter.reserve("bob")
+	require.True(t, ok)
+
+	// After half a second, exactly one token was added.
+	testClock.SetTime(testClock.Now().Add(500 * time.Millisecond))
+	ok, _ = limiter.reserve("alice")
+	require.True(t, ok)
+	ok, _ = limiter.reserve("alice")
+	require.False(t, ok)
+
+	// A long pause refills the bucket only up to the burst size.
+	testClock.SetTime(testClock.Now().Add(time.Hour))
+	for i := 0; i < 3; i++ {
+		ok, _ := limiter.reserve("alice")
+		require.True(t, ok)
+	}
+	ok, _ = limiter.reserve("alice")
+	require.False(t, ok)
+
+	require.Nil(t, newRateLimiter(0, 3, testClock))
+}
+
+// TestAddSessionRateLimit makes sure that AddSession rejects requests that
+// exceed the rate limit of the caller and accepts them again once the bucket
+// was refilled.
+func TestAddSessionRateLimit(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	testClock := clock.NewTestClock(time.Now())
+	s.addLimiter = newRateLimiter(1, 2, testClock)
+
+	alice := callerContext(t, "alice")
+	bob := callerContext(t, "bob")
+	addSession := func(ctx context.Context) error {
+		_, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:       "rate limited",
+			SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		})
+
+		return err
+	}
+
+	// The burst is allowed, the next request is rejected.
+	require.NoError(t, addSession(alice))
+	require.NoError(t, addSession(alice))
+	err := addSession(alice)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.Contains(t, err.Error(), "retry in 1s")
+
+	// Other callers and callers without identity have their own bucket.
+	require.NoError(t, addSession(bob))
+	require.NoError(t, addSession(context.Background()))
+
+	testClock.SetTime(testClock.Now().Add(time.Second))
+	require.NoError(t, addSession(alice))
+	err = addSession(alice)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+// TestCloneSessionRateLimit makes sure that cloning a session takes from the
+// same rate limit bucket of the caller as adding one.
+func TestCloneSessionRateLimit(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	testClock := clock.NewTestClock(time.Now())
+	s.addLimiter = newRateLimiter(1, 1, testClock)
+
+	alice := callerContext(t, "alice")
+	resp, err := s.AddSession(alice, &litrpc.AddSessionRequest{
+		Label:       "orig",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr: "localhost:1234",
+	})
+	require.NoError(t, err)
+
+	cloneReq := &litrpc.CloneSessionRequest{
+		LocalPublicKey: resp.Session.LocalPublicKey,
+	}
+	_, err = s.CloneSession(alice, cloneReq)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	testClock.SetTime(testClock.Now().Add(time.Second))
+	_, err = s.CloneSession(alice, cloneReq)
+	require.NoError(t, err)
+}
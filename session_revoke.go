@@ -0,0 +1,142 @@
+package terminal
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// revokeConfirmationTTL is the time during which a confirmation token
+	// handed out by PrepareRevokeSession can be used to revoke its session.
+	revokeConfirmationTTL = 2 * time.Minute
+
+	// revokeConfirmationTokenLen is the number of random bytes of a
+	// revocation confirmation token.
+	revokeConfirmationTokenLen = 16
+)
+
+// revokeConfirmation is a pending confirmation of a session revocation.
+type revokeConfirmation struct {
+	// pubKey is the serialized local public key of the session whose
+	// revocation is confirmed.
+	pubKey []byte
+
+	// expiry is the time at which the confirmation expires.
+	expiry time.Time
+}
+
+// PrepareRevokeSession hands out a short-lived token that confirms the
+// revocation of the given session, together with a summary of the session so
+// the caller can double check it is about to revoke the right one.
+func (s *sessionRpcServer) PrepareRevokeSession(_ context.Context,
+	req *litrpc.PrepareRevokeSessionRequest) (
+	*litrpc.PrepareRevokeSessionResponse, error) {
+
+	pubKey, err := parseCompressedPubKey(
+		"local_public_key", req.LocalPublicKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	if sess.State == session.StateRevoked {
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is already revoked")
+	}
+
+	rpcType, err := marshalRPCType(sess.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	token := make([]byte, revokeConfirmationTokenLen)
+	if _, err := rand.Read(token); err != nil {
+		return nil, fmt.Errorf("error generating confirmation token: "+
+			"%v", err)
+	}
+
+	now := time.Now()
+	expiry := now.Add(revokeConfirmationTTL)
+	localKey := pubKey.SerializeCompressed()
+
+	s.revokeConfirmationsMtx.Lock()
+	for id, confirmation := range s.revokeConfirmations {
+		if !confirmation.expiry.After(now) {
+			delete(s.revokeConfirmations, id)
+		}
+	}
+	s.revokeConfirmations[string(token)] = revokeConfirmation{
+		pubKey: localKey,
+		expiry: expiry,
+	}
+	s.revokeConfirmationsMtx.Unlock()
+
+	return &litrpc.PrepareRevokeSessionResponse{
+		ConfirmationToken: token,
+		ExpiresAt:         uint64(expiry.Unix()),
+		Label:             sess.Label,
+		SessionType:       rpcType,
+		LocalPublicKey:    localKey,
+	}, nil
+}
+
+// confirmRevocation checks that the given token confirms the revocation of the
+// session with the given local public key at the given time. A token is only
+// required if revocations must be confirmed, but a given token is always
+// checked. A valid token is used up.
+func (s *sessionRpcServer) confirmRevocation(pubKey *btcec.PublicKey,
+	token []byte, now time.Time) error {
+
+	if len(token) == 0 {
+		if s.cfg.RequireRevokeConfirmation {
+			return status.Error(codes.FailedPrecondition, "the "+
+				"revocation must be confirmed with a token "+
+				"from PrepareRevokeSession")
+		}
+
+		return nil
+	}
+
+	s.revokeConfirmationsMtx.Lock()
+	defer s.revokeConfirmationsMtx.Unlock()
+
+	confirmation, ok := s.revokeConfirmations[string(token)]
+	switch {
+	case !ok:
+		return status.Error(codes.InvalidArgument, "unknown "+
+			"confirmation token")
+
+	case !bytes.Equal(confirmation.pubKey, pubKey.SerializeCompressed()):
+		return status.Error(codes.InvalidArgument, "confirmation "+
+			"token is for a different session")
+
+	case !confirmation.expiry.After(now):
+		delete(s.revokeConfirmations, string(token))
+
+		return status.Error(codes.FailedPrecondition, "confirmation "+
+			"token expired")
+	}
+
+	delete(s.revokeConfirmations, string(token))
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package terminal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestRevokeSessionConfirmation makes sure that a session can only be revoked
+// with a valid confirmation token for it once revocations must be confirmed.
+func TestRevokeSessionConfirmation(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.RequireRevokeConfirmation = true
+	ctx := context.Background()
+
+	addSession := func(label string) *session.Session {
+		sess := newTestSession(t, label, session.TypeMacaroonAdmin)
+		require.NoError(t, s.db.StoreSession(sess))
+
+		return sess
+	}
+	target := addSession("target")
+	other := addSession("other")
+
+	revoke := func(sess *session.Session, token []byte) error {
+		_, err := s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+			LocalPublicKey:    sess.LocalPublicKey.SerializeCompressed(),
+			ConfirmationToken: token,
+		})
+
+		return err
+	}
+	prepare := func(
+		sess *session.Session) *litrpc.PrepareRevokeSessionResponse {
+
+		resp, err := s.PrepareRevokeSession(
+			ctx, &litrpc.PrepareRevokeSessionRequest{
+				LocalPublicKey: sess.LocalPublicKey.
+					SerializeCompressed(),
+			},
+		)
+		require.NoError(t, err)
+
+		return resp
+	}
+	requireState := func(sess *session.Session, state session.State) {
+		stored, err := s.db.GetSession(sess.LocalPublicKey)
+		require.NoError(t, err)
+		require.Equal(t, state, stored.State)
+	}
+
+	// Without a token, the session can't be revoked.
+	err := revoke(target, nil)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	requireState(target, session.StateCreated)
+
+	// The summary describes the session the token is for.
+	resp := prepare(target)
+	require.Equal(t, "target", resp.Label)
+	require.Equal(t, litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		resp.SessionType)
+	require.Equal(t, target.LocalPublicKey.SerializeCompressed(),
+		resp.LocalPublicKey)
+	require.Len(t, resp.ConfirmationToken, revokeConfirmationTokenLen)
+
+	// A token for one session doesn't confirm the revocation of another
+	// one, and an unknown token confirms nothing.
+	err = revoke(other, resp.ConfirmationToken)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	requireState(other, session.StateCreated)
+
+	err = revoke(target, []byte("unknown"))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	requireState(target, session.StateCreated)
+
+	// An expired token is rejected.
+	expired := prepare(other)
+	s.revokeConfirmationsMtx.Lock()
+	confirmation := s.revokeConfirmations[string(expired.ConfirmationToken)]
+	confirmation.expiry = time.Now().Add(-time.Second)
+	s.revokeConfirmations[string(expired.ConfirmationToken)] = confirmation
+	s.revokeConfirmationsMtx.Unlock()
+
+	err = revoke(other, expired.ConfirmationToken)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	requireState(other, session.StateCreated)
+
+	// The matching token revokes the session, but only once.
+	require.NoError(t, revoke(target, resp.ConfirmationToken))
+	requireState(target, session.StateRevoked)
+
+	err = revoke(target, resp.ConfirmationToken)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A revoked session can't be prepared for revocation again.
+	_, err = s.PrepareRevokeSession(
+		ctx, &litrpc.PrepareRevokeSessionRequest{
+			LocalPublicKey: target.LocalPublicKey.
+				SerializeCompressed(),
+		},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// TestRevokeSessionConfirmationOptional makes sure that sessions can be revoked
+// without a token unless revocations must be confirmed.
+func TestRevokeSessionConfirmationOptional(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	sess := newTestSession(t, "optional", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(sess))
+
+	_, err := s.RevokeSession(
+		context.Background(), &litrpc.RevokeSessionRequest{
+			LocalPublicKey: sess.LocalPublicKey.SerializeCompressed(),
+		},
+	)
+	require.NoError(t, err)
+}
@@ -2,29 +2,293 @@ package terminal
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"math/rand"
+	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
+	"unicode/utf8"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btclog"
 	"github.com/lightninglabs/lightning-node-connect/mailbox"
 	"github.com/lightninglabs/lightning-terminal/litrpc"
 	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+	"gopkg.in/macaroon.v2"
 )
 
+// mailboxSessionServer is the interface of the server that manages the mailbox
+// connections of all active sessions.
+type mailboxSessionServer interface {
+	// StartSession starts the mailbox connection of the given session and
+	// returns a channel that is closed once the session is stopped. If
+	// the timeout is non-zero, it waits for a mailbox server to be reached
+	// and fails with an error wrapping context.DeadlineExceeded if none
+	// was reached in time.
+	StartSession(sess *session.Session, authData []byte,
+		timeout time.Duration) (chan struct{}, error)
+
+	// StartLazySession starts the mailbox connection of the given session
+	// and only creates its authentication data with the given function
+	// once the first client connects. The timeout is applied like for
+	// StartSession.
+	StartLazySession(sess *session.Session, authData session.AuthDataFunc,
+		timeout time.Duration) (chan struct{}, error)
+
+	// StopSession stops the mailbox connection of the session with the
+	// given local public key.
+	StopSession(localPublicKey *btcec.PublicKey) error
+
+	// ActiveServerAddr returns the address of the mailbox server the
+	// session with the given local public key is currently connected to.
+	ActiveServerAddr(localPublicKey *btcec.PublicKey) string
+
+	// UpdateAuthData replaces the authentication data the running session
+	// with the given local public key hands out to connecting clients,
+	// without restarting its mailbox connection.
+	UpdateAuthData(localPublicKey *btcec.PublicKey, authData []byte) error
+
+	// Connections returns a channel that receives a signal each time a
+	// client connects to the running session with the given local public
+	// key.
+	Connections(localPublicKey *btcec.PublicKey) <-chan struct{}
+
+	// ConnectionInfo returns the details of the live mailbox connection of
+	// the session with the given local public key. Nil is returned if the
+	// session isn't running.
+	ConnectionInfo(localPublicKey *btcec.PublicKey) *session.ConnectionInfo
+
+	// Drain makes the running session with the given local public key stop
+	// accepting new clients, while connected clients can keep using it.
+	Drain(localPublicKey *btcec.PublicKey) error
+
+	// Idle returns a channel that is closed once no client is connected to
+	// the running session with the given local public key anymore.
+	Idle(localPublicKey *btcec.PublicKey) <-chan struct{}
+
+	// Events returns a channel that receives the connection events of the
+	// running session with the given local public key.
+	Events(localPublicKey *btcec.PublicKey) <-chan session.ConnectionEvent
+}
+
+// sessionStore is the interface of the persistent storage of all sessions.
+type sessionStore interface {
+	session.Store
+
+	// Compact compacts the underlying database file and returns its size
+	// before and after the compaction.
+	Compact() (int64, int64, error)
+}
+
+const (
+	// defaultCloneLabelSuffix is the suffix that is appended to the label
+	// of a cloned session if no explicit label is requested.
+	defaultCloneLabelSuffix = "-clone"
+
+	// stopSessionRetries is the number of times stopping the mailbox
+	// connection of a revoked session is retried.
+	stopSessionRetries = 3
+
+	// stopSessionBackoff is the initial time we wait before retrying to
+	// stop the mailbox connection of a revoked session. It is doubled for
+	// each further attempt.
+	stopSessionBackoff = 100 * time.Millisecond
+
+	// revokeReasonExpired is the reason recorded for sessions that are
+	// revoked because they expired.
+	revokeReasonExpired = "expired"
+
+	// revokeReasonSingleUse is the reason recorded for single-use sessions
+	// that are revoked once their connection ended.
+	revokeReasonSingleUse = "single-use session disconnected"
+
+	// revokeReasonReplaced is the reason recorded for sessions that were
+	// replaced by a new session.
+	revokeReasonReplaced = "replaced"
+
+	// revokeReasonReplaceFailed is the reason recorded for replacement
+	// sessions that are revoked again because the old session couldn't be
+	// revoked.
+	revokeReasonReplaceFailed = "replacing the old session failed"
+
+	// revokeReasonStartFailed is the reason recorded for new sessions that
+	// are revoked again because they couldn't be started.
+	revokeReasonStartFailed = "starting the session failed"
+
+	// revokeReasonDenied is the reason recorded for sessions that are
+	// revoked because their remote was denied, unless another reason is
+	// given.
+	revokeReasonDenied = "denied"
+
+	// revokeReasonUnpaired is the reason recorded for sessions that are
+	// revoked because they weren't paired within the unpaired timeout.
+	revokeReasonUnpaired = "never paired"
+)
+
+// errNoMacaroonBaker is returned if the authentication data of a macaroon
+// session is needed but the server has no way to bake macaroons.
+var errNoMacaroonBaker = errors.New("macaroon baking not available")
+
+// sessionTypeInfo holds the human-readable details of a session type that are
+// reported by ListSessionTypes. The alias is the short name the type can be
+// referred to by in ParseSessionTypeString.
+type sessionTypeInfo struct {
+	typ         session.Type
+	alias       string
+	name        string
+	description string
+}
+
+// supportedSessionTypes is the list of all session types that can be created
+// in LiT.
+var supportedSessionTypes = []sessionTypeInfo{{
+	typ:   session.TypeUIPassword,
+	alias: "password",
+	name:  "UI password",
+	description: "Full access to the LiT web UI using the UI password, " +
+		"does not use a macaroon.",
+}, {
+	typ:   session.TypeMacaroonAdmin,
+	alias: "admin",
+	name:  "Admin macaroon",
+	description: "Read and write access to all RPCs of lnd and the " +
+		"integrated daemons.",
+}, {
+	typ:   session.TypeMacaroonReadonly,
+	alias: "readonly",
+	name:  "Read-only macaroon",
+	description: "Read-only access to all RPCs of lnd and the " +
+		"integrated daemons.",
+}, {
+	typ:   session.TypeMacaroonCustom,
+	alias: "custom",
+	name:  "Custom macaroon",
+	description: "Access restricted to the permissions given when the " +
+		"session is added.",
+}}
+
+// isSupportedSessionType returns true if sessions of the given type can be
+// created in LiT.
+func isSupportedSessionType(typ session.Type) bool {
+	for _, info := range supportedSessionTypes {
+		if info.typ == typ {
+			return true
+		}
+	}
+
+	return false
+}
+
 // sessionRpcServer is the gRPC server for the Session RPC interface.
 type sessionRpcServer struct {
+	// numSessionGoroutines is the number of session goroutines that are
+	// currently running. It must be used atomically.
+	numSessionGoroutines int64
+
+	// numWaitGroupGoroutines is the number of goroutines that are
+	// currently tracked by wg. It must be used atomically.
+	numWaitGroupGoroutines int64
+
+	// ready is set to 1 once all dependencies of the server, like the lnd
+	// connection macaroons are baked with, are initialized. It must be
+	// used atomically.
+	ready int32
+
 	litrpc.UnimplementedSessionsServer
 
-	basicAuth string
+	cfg *SessionConfig
+
+	// basicAuth is the basic authentication of UI password sessions. It
+	// is guarded by basicAuthMtx since it can be rotated at runtime.
+	basicAuth    string
+	basicAuthMtx sync.RWMutex
+
+	// rpcEndpoint is the dialable address of the LiT RPC listener that the
+	// macaroons of macaroon sessions are valid for.
+	rpcEndpoint string
+
+	// setProxyBasicAuth is called with the new basic authentication once
+	// the UI password was rotated, so the proxy accepts it. It may be nil.
+	setProxyBasicAuth func(basicAuth string)
 
-	db            *session.DB
-	sessionServer *session.Server
+	db            sessionStore
+	sessionServer mailboxSessionServer
 
 	superMacBaker func(ctx context.Context, rootKeyID uint64,
 		recipe *session.MacaroonRecipe) (string, error)
 
+	// activeSessions maps the serialized local public key of each session
+	// whose mailbox connection is currently running to the channel that
+	// is closed once the session is stopped.
+	activeSessions    map[string]chan struct{}
+	activeSessionsMtx sync.Mutex
+
+	// pendingActivations maps the serialized local public key of each
+	// session that waits for its activation time to the channel that
+	// cancels the wait. It is guarded by activeSessionsMtx.
+	pendingActivations map[string]chan struct{}
+
+	// pendingApprovals maps the serialized local public key of each
+	// session whose remote waits for approval to the channel the decision
+	// is sent on. It is guarded by activeSessionsMtx.
+	pendingApprovals map[string]chan bool
+
+	// expiryWarnings maps the serialized local public key of each session
+	// the expiry scan warned about to the expiry it was warned about. It
+	// is only accessed by the expiry scan.
+	expiryWarnings map[string]time.Time
+
+	// storeMtx serializes storing new and restoring revoked sessions, so
+	// the limit of active sessions can't be exceeded by concurrent
+	// requests.
+	storeMtx sync.Mutex
+
+	// addLimiter limits the rate at which each caller can add sessions.
+	// It is nil if the rate limit is disabled.
+	addLimiter *rateLimiter
+
+	// policy is consulted before a new session is created.
+	policy SessionPolicy
+
+	// subserverPermissions maps the name of each enabled LiT subserver to
+	// the macaroon permissions of its RPC methods, keyed by their URI.
+	subserverPermissions map[string]map[string][]bakery.Op
+
+	// recipeInterceptor is called with the recipe of every session
+	// macaroon right before it is baked. It may be nil, in which case the
+	// recipes are baked unchanged.
+	recipeInterceptor RecipeInterceptor
+
+	// revokeConfirmations maps each confirmation token handed out by
+	// PrepareRevokeSession to the session it confirms the revocation of.
+	revokeConfirmations    map[string]revokeConfirmation
+	revokeConfirmationsMtx sync.Mutex
+
+	// pendingLabels holds the generated labels of sessions that are being
+	// added but aren't stored yet, so concurrently added sessions don't get
+	// the same label.
+	pendingLabels    map[string]struct{}
+	pendingLabelsMtx sync.Mutex
+
+	// eventHandler is notified about the connection events of every
+	// running session.
+	eventHandler SessionEventHandler
+
 	quit     chan struct{}
 	wg       sync.WaitGroup
 	stopOnce sync.Once
@@ -38,189 +302,4571 @@ func (s *sessionRpcServer) stop() {
 	})
 }
 
-// AddSession adds and starts a new Terminal Connect session.
-func (s *sessionRpcServer) AddSession(_ context.Context,
-	req *litrpc.AddSessionRequest) (*litrpc.AddSessionResponse, error) {
+// AddSession adds and starts a new Terminal Connect session.
+func (s *sessionRpcServer) AddSession(ctx context.Context,
+	req *litrpc.AddSessionRequest) (*litrpc.AddSessionResponse, error) {
+
+	return s.addSession(ctx, req, nil)
+}
+
+// addSession adds and starts a new Terminal Connect session. If a local public
+// key is given to replace, the session with that key is revoked in the same
+// transaction the new session is stored in.
+func (s *sessionRpcServer) addSession(ctx context.Context,
+	req *litrpc.AddSessionRequest,
+	replaces *btcec.PublicKey) (*litrpc.AddSessionResponse, error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	expiry := time.Unix(int64(req.ExpiryTimestampSeconds), 0)
+
+	typ, err := unmarshalRPCType(req.SessionType)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := s.addSessionPermissions(req, typ)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateAutoRenew(req, typ, expiry); err != nil {
+		return nil, err
+	}
+
+	if err := validateMacaroonExpiry(req, typ, expiry); err != nil {
+		return nil, err
+	}
+
+	err = validateMacaroonRootKeyID(req.MacaroonRootKeyId, typ)
+	if err != nil {
+		return nil, err
+	}
+
+	localKey, err := s.sessionKeyFromSeed(req.LocalKeySeed, typ)
+	if err != nil {
+		return nil, err
+	}
+
+	var activation time.Time
+	if req.ActivationTimestampSeconds != 0 {
+		activation = time.Unix(int64(req.ActivationTimestampSeconds), 0)
+		if !activation.Before(expiry) {
+			return nil, status.Error(codes.InvalidArgument,
+				"activation must be before the expiry")
+		}
+	}
+
+	schedule, err := unmarshalAccessSchedule(req.AccessSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	var hardDeadline time.Time
+	if req.HardDeadline != 0 {
+		hardDeadline = time.Unix(int64(req.HardDeadline), 0)
+		err := validateHardDeadline(expiry, hardDeadline)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.validateNewSession(ctx, req, typ, perms); err != nil {
+		return nil, err
+	}
+
+	owner := req.OwnerId
+	if owner == "" {
+		owner, err = callerIdentity(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	serverAddrs := mailboxServerAddrs(req)
+
+	var sess *session.Session
+	if localKey != nil {
+		sess, err = session.NewSessionWithKey(
+			localKey, req.Label, typ, expiry, serverAddrs[0],
+			req.DevServer, perms, nil,
+		)
+	} else {
+		sess, err = session.NewSession(
+			req.Label, typ, expiry, serverAddrs[0], req.DevServer,
+			perms, nil,
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error creating new session: %v", err)
+	}
+	releaseLabel, err := s.applyAutoLabel(sess)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseLabel()
+
+	sess.InsecureSkipVerify = req.InsecureSkipVerify
+	sess.Description = req.Description
+	sess.FallbackServerAddrs = serverAddrs[1:]
+	sess.SuppressPairingSecret = req.SuppressPairingSecret
+	sess.Owner = owner
+	sess.SingleUse = req.SingleUse
+	sess.InactivityExpiry = time.Duration(
+		req.InactivityExpirySeconds,
+	) * time.Second
+	if req.MacaroonRootKeyId != 0 {
+		sess.MacaroonRootKey = req.MacaroonRootKeyId
+	}
+	sess.ActivationTime = activation
+	sess.GroupID = req.GroupId
+	sess.RemoteDisplayName = req.RemoteDisplayName
+	sess.MaxConcurrentStreams = req.MaxConcurrentStreams
+	sess.RequireApproval = req.RequireApproval
+	sess.Priority = req.Priority
+	sess.AccessSchedule = schedule
+	sess.HardDeadline = hardDeadline
+	sess.KeepaliveInterval = time.Duration(
+		req.KeepaliveIntervalSeconds,
+	) * time.Second
+	sess.HandshakeTimeout = time.Duration(
+		req.HandshakeTimeoutSeconds,
+	) * time.Second
+	sess.MacaroonExpiry = time.Duration(
+		req.MacaroonExpirySeconds,
+	) * time.Second
+	if req.AutoRenewUntil != 0 {
+		sess.AutoRenewUntil = time.Unix(int64(req.AutoRenewUntil), 0)
+		sess.RenewInterval = time.Duration(
+			req.RenewIntervalSeconds,
+		) * time.Second
+	}
+
+	startTimeout := s.cfg.StartTimeout
+	if req.StartTimeoutSeconds != 0 {
+		startTimeout = time.Duration(req.StartTimeoutSeconds) *
+			time.Second
+	}
+
+	err = s.storeAndStart(sess, startTimeout, replaces)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSession, err := marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+
+	resp := &litrpc.AddSessionResponse{
+		Session: rpcSession,
+	}
+	if typ != session.TypeUIPassword {
+		resp.RpcEndpoint = s.rpcEndpoint
+	}
+
+	return resp, nil
+}
+
+// validateNewSession runs the checks every new session has to pass before it
+// is stored, no matter whether it is added, cloned or imported. The session is
+// described by the given add session request, which is what the session policy
+// is consulted with once all other checks passed. The given permissions are
+// the ones the session's macaroon is restricted to.
+func (s *sessionRpcServer) validateNewSession(ctx context.Context,
+	req *litrpc.AddSessionRequest, typ session.Type,
+	perms []bakery.Op) error {
+
+	if err := s.checkAddRateLimit(ctx); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	expiry := time.Unix(int64(req.ExpiryTimestampSeconds), 0)
+	if now.After(expiry) {
+		return status.Error(codes.InvalidArgument, "expiry must be in "+
+			"the future")
+	}
+
+	if err := s.validateExpiry(now, expiry); err != nil {
+		return err
+	}
+
+	// A generated label is validated once it is known.
+	if req.Label != "" || !s.autoLabelEnabled() {
+		if err := s.validateLabel(req.Label); err != nil {
+			return err
+		}
+	}
+
+	err := validateLength(
+		"description", req.Description, s.cfg.MaxDescriptionLength,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = validateLength(
+		"remote display name", req.RemoteDisplayName,
+		s.cfg.MaxLabelLength,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !isSupportedSessionType(typ) {
+		return fmt.Errorf("invalid session type, only UI password, " +
+			"admin, readonly and custom macaroon types supported " +
+			"in LiT")
+	}
+
+	if err := s.checkMacaroonBaker(typ); err != nil {
+		return err
+	}
+
+	if err := s.checkSessionPermissions(typ, perms); err != nil {
+		return err
+	}
+
+	if err := s.validateDevServer(req.DevServer); err != nil {
+		return err
+	}
+
+	if req.InsecureSkipVerify && !req.DevServer {
+		return status.Error(codes.InvalidArgument, "skipping the TLS "+
+			"verification is only allowed for dev servers")
+	}
+
+	if err := s.policy.CheckAddSession(ctx, req); err != nil {
+		return status.Errorf(codes.PermissionDenied, "session "+
+			"rejected by policy: %v", err)
+	}
+
+	return nil
+}
+
+// markReady marks the server as ready to accept RPCs that start sessions or
+// bake macaroons, once all its dependencies are initialized.
+func (s *sessionRpcServer) markReady() {
+	atomic.StoreInt32(&s.ready, 1)
+}
+
+// checkReady returns an Unavailable error if the server isn't ready yet to
+// start sessions or bake macaroons. Revoking and pausing sessions only touches
+// the session store and the mailbox connections, so they don't need to wait.
+func (s *sessionRpcServer) checkReady() error {
+	if atomic.LoadInt32(&s.ready) == 0 {
+		return status.Error(codes.Unavailable, "session server not "+
+			"ready yet, try again once LiT finished starting up")
+	}
+
+	return nil
+}
+
+// checkAddRateLimit takes a token from the add session rate limit bucket of the
+// caller. A ResourceExhausted error that tells the caller when to retry is
+// returned if the bucket is empty.
+func (s *sessionRpcServer) checkAddRateLimit(ctx context.Context) error {
+	if s.addLimiter == nil {
+		return nil
+	}
+
+	caller, err := callerIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	ok, retryAfter := s.addLimiter.reserve(caller)
+	if !ok {
+		return status.Errorf(codes.ResourceExhausted, "session add "+
+			"rate limit exceeded, retry in %v", retryAfter)
+	}
+
+	return nil
+}
+
+// customPermissions converts the explicit macaroon permissions of an
+// AddSession request. They are only allowed for custom sessions. Nil is
+// returned if no permissions are given.
+func customPermissions(perms []*litrpc.MacaroonPermission,
+	typ session.Type) ([]bakery.Op, error) {
+
+	if len(perms) == 0 {
+		return nil, nil
+	}
+
+	if typ != session.TypeMacaroonCustom {
+		return nil, status.Error(codes.InvalidArgument, "custom "+
+			"macaroon permissions are only allowed for custom "+
+			"sessions")
+	}
+
+	ops := make([]bakery.Op, 0, len(perms))
+	for _, perm := range perms {
+		if perm.Entity == "" || perm.Action == "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"custom macaroon permissions need an entity "+
+					"and an action")
+		}
+
+		ops = append(ops, bakery.Op{
+			Entity: perm.Entity,
+			Action: perm.Action,
+		})
+	}
+
+	return ops, nil
+}
+
+// validateAutoRenew makes sure the auto renewal parameters of an add session
+// request are sane.
+func validateAutoRenew(req *litrpc.AddSessionRequest, typ session.Type,
+	expiry time.Time) error {
+
+	if req.AutoRenewUntil == 0 && req.RenewIntervalSeconds == 0 {
+		return nil
+	}
+
+	if req.AutoRenewUntil == 0 || req.RenewIntervalSeconds == 0 {
+		return status.Error(codes.InvalidArgument, "auto_renew_until "+
+			"and renew_interval_seconds must be set together")
+	}
+
+	if typ != session.TypeMacaroonAdmin &&
+		typ != session.TypeMacaroonReadonly &&
+		typ != session.TypeMacaroonCustom {
+
+		return status.Error(codes.InvalidArgument, "auto renewal is "+
+			"only supported for macaroon sessions")
+	}
+
+	renewUntil := time.Unix(int64(req.AutoRenewUntil), 0)
+	if !renewUntil.After(time.Now()) || renewUntil.After(expiry) {
+		return status.Error(codes.InvalidArgument, "auto_renew_until "+
+			"must be in the future and not after the expiry")
+	}
+
+	return nil
+}
+
+// validateMacaroonExpiry makes sure the macaroon expiry of an add session
+// request is only set for macaroon sessions and doesn't let the macaroon
+// outlive the session.
+func validateMacaroonExpiry(req *litrpc.AddSessionRequest, typ session.Type,
+	expiry time.Time) error {
+
+	if req.MacaroonExpirySeconds == 0 {
+		return nil
+	}
+
+	if typ == session.TypeUIPassword {
+		return status.Error(codes.InvalidArgument, "a macaroon expiry "+
+			"is only supported for macaroon sessions")
+	}
+
+	macExpiry := time.Duration(req.MacaroonExpirySeconds) * time.Second
+	if time.Now().Add(macExpiry).After(expiry) {
+		return status.Error(codes.InvalidArgument, "macaroon_expiry_"+
+			"seconds must not exceed the expiry of the session")
+	}
+
+	return nil
+}
+
+// validateMacaroonRootKeyID makes sure an explicit macaroon root key ID is only
+// requested for custom sessions and lies within the range of super macaroon
+// root key IDs, as LiT otherwise wouldn't recognize the baked macaroon. An ID
+// of 0 selects the root key ID derived from the session's key.
+func validateMacaroonRootKeyID(rootKeyID uint64, typ session.Type) error {
+	if rootKeyID == 0 {
+		return nil
+	}
+
+	if typ != session.TypeMacaroonCustom {
+		return status.Error(codes.InvalidArgument, "a macaroon root "+
+			"key ID is only allowed for custom sessions")
+	}
+
+	if !session.IsSuperMacaroonRootKeyID(rootKeyID) {
+		return status.Errorf(codes.InvalidArgument, "macaroon root "+
+			"key ID %d must have the super macaroon prefix %x",
+			rootKeyID, session.SuperMacaroonRootKeyPrefix)
+	}
+
+	return nil
+}
+
+// templatePermissions returns the permissions of the permission template with
+// the given name. Templates are only allowed for custom sessions. Nil is
+// returned if no template is given.
+func (s *sessionRpcServer) templatePermissions(name string,
+	typ session.Type) ([]bakery.Op, error) {
+
+	if name == "" {
+		return nil, nil
+	}
+
+	if typ != session.TypeMacaroonCustom {
+		return nil, status.Error(codes.InvalidArgument, "a permission "+
+			"template is only allowed for custom sessions")
+	}
+
+	perms, ok := s.cfg.permissionTemplates[name]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown "+
+			"permission template %q", name)
+	}
+
+	return perms, nil
+}
+
+// subserverMethodPermissions returns the macaroon permissions the given methods
+// of LiT's subservers require, keyed by the name of the subserver. A method is
+// either given by its full URI or just by its name, which must then be unique
+// among the methods of the subserver. Subserver methods can only be given for
+// custom sessions.
+func (s *sessionRpcServer) subserverMethodPermissions(
+	methods map[string]*litrpc.SubserverMethods,
+	typ session.Type) ([]bakery.Op, error) {
+
+	if len(methods) == 0 {
+		return nil, nil
+	}
+
+	if typ != session.TypeMacaroonCustom {
+		return nil, status.Error(codes.InvalidArgument, "subserver "+
+			"methods are only allowed for custom sessions")
+	}
+
+	// The subservers are resolved in a fixed order, so the resulting
+	// permissions don't depend on the iteration order of the map.
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var perms []bakery.Op
+	for _, name := range names {
+		uriPerms, ok := s.subserverPermissions[name]
+		if !ok {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"subserver %q is not enabled", name)
+		}
+
+		if len(methods[name].GetMethods()) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "no "+
+				"methods given for subserver %q", name)
+		}
+
+		for _, method := range methods[name].Methods {
+			uri, err := resolveSubserverMethod(uriPerms, method)
+			if err != nil {
+				return nil, status.Errorf(
+					codes.InvalidArgument, "invalid %s "+
+						"method: %v", name, err,
+				)
+			}
+
+			perms = append(perms, uriPerms[uri]...)
+		}
+	}
+
+	return perms, nil
+}
+
+// resolveSubserverMethod returns the URI of the given method of a subserver
+// with the given method permissions. The method is either a full URI or the
+// name of a method that is unique among the subserver's methods.
+func resolveSubserverMethod(uriPerms map[string][]bakery.Op,
+	method string) (string, error) {
+
+	if _, ok := uriPerms[method]; ok {
+		return method, nil
+	}
+
+	var matches []string
+	for uri := range uriPerms {
+		if strings.HasSuffix(uri, "/"+method) {
+			matches = append(matches, uri)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("unknown method %q", method)
+
+	case 1:
+		return matches[0], nil
+
+	default:
+		sort.Strings(matches)
+		return "", fmt.Errorf("method %q is ambiguous, use one of %s",
+			method, strings.Join(matches, ", "))
+	}
+}
+
+// validateDevServer makes sure that sessions only use a development mailbox
+// server if that is explicitly allowed.
+func (s *sessionRpcServer) validateDevServer(devServer bool) error {
+	if devServer && !s.cfg.AllowDevSessions {
+		return status.Error(codes.PermissionDenied, "sessions with a "+
+			"dev server are not allowed, enable allowdevsessions "+
+			"to create them")
+	}
+
+	return nil
+}
+
+// checkCustomPermissions makes sure that the macaroon of a custom session with
+// the given permissions doesn't exceed the configured permission limits.
+func (s *sessionRpcServer) checkCustomPermissions(perms []bakery.Op) error {
+	maxPerms := s.cfg.MaxCustomPerms
+	if maxPerms != 0 && len(perms) > int(maxPerms) {
+		return status.Errorf(codes.PermissionDenied, "custom sessions "+
+			"may grant at most %d permissions, got %d", maxPerms,
+			len(perms))
+	}
+
+	denied := make(map[bakery.Op]bool, len(s.cfg.customDeny))
+	for _, op := range s.cfg.customDeny {
+		denied[op] = true
+	}
+
+	for _, op := range perms {
+		if denied[op] {
+			return status.Errorf(codes.PermissionDenied, "custom "+
+				"sessions must not grant the permission %s:%s",
+				op.Entity, op.Action)
+		}
+	}
+
+	return nil
+}
+
+// dedupPermissions returns the given permissions with each permission only
+// included once, in the order of their first occurrence.
+func dedupPermissions(perms []bakery.Op) []bakery.Op {
+	if len(perms) == 0 {
+		return perms
+	}
+
+	seen := make(map[bakery.Op]bool, len(perms))
+	unique := make([]bakery.Op, 0, len(perms))
+	for _, op := range perms {
+		if seen[op] {
+			continue
+		}
+
+		seen[op] = true
+		unique = append(unique, op)
+	}
+
+	return unique
+}
+
+// sessionKeyFromSeed derives the local key of a new custom session from the
+// given seed and makes sure that no session with that key exists yet. Nil is
+// returned if no seed is given, in which case a random key is used.
+func (s *sessionRpcServer) sessionKeyFromSeed(seed []byte,
+	typ session.Type) (*btcec.PrivateKey, error) {
+
+	if len(seed) == 0 {
+		return nil, nil
+	}
+
+	if typ != session.TypeMacaroonCustom {
+		return nil, status.Error(codes.InvalidArgument, "a local key "+
+			"seed is only allowed for custom sessions")
+	}
+
+	localKey, err := session.DeriveLocalKey(seed)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"local key seed: %v", err)
+	}
+
+	_, err = s.db.GetSession(localKey.PubKey())
+	switch {
+	case err == nil:
+		return nil, status.Error(codes.AlreadyExists, "a session "+
+			"with the key derived from the seed already exists")
+
+	case !errors.Is(err, session.ErrSessionNotFound):
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	return localKey, nil
+}
+
+// mailboxServerAddrs returns the de-duplicated list of mailbox server addresses
+// of an add session request in the order they should be tried in. The list
+// always contains at least one entry.
+func mailboxServerAddrs(req *litrpc.AddSessionRequest) []string {
+	var addrs []string
+	if req.MailboxServerAddr != "" {
+		addrs = append(addrs, req.MailboxServerAddr)
+	}
+
+	for _, addr := range req.MailboxServerAddrs {
+		duplicate := false
+		for _, existing := range addrs {
+			if existing == addr {
+				duplicate = true
+				break
+			}
+		}
+
+		if !duplicate {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	if len(addrs) == 0 {
+		addrs = []string{""}
+	}
+
+	return addrs
+}
+
+// AddSessions creates and starts multiple sessions at once. Each session is
+// added independently, so a single invalid request doesn't prevent the others
+// from being created. The results are returned in the order of the requests.
+func (s *sessionRpcServer) AddSessions(ctx context.Context,
+	req *litrpc.AddSessionsRequest) (*litrpc.AddSessionsResponse, error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	resp := &litrpc.AddSessionsResponse{
+		Results: make([]*litrpc.AddSessionResult, len(req.Sessions)),
+	}
+	for idx, addReq := range req.Sessions {
+		result := &litrpc.AddSessionResult{}
+		resp.Results[idx] = result
+
+		if addReq == nil {
+			result.Error = "missing session parameters"
+			continue
+		}
+
+		addResp, err := s.AddSession(ctx, addReq)
+		if err != nil {
+			result.Error = err.Error()
+			continue
+		}
+
+		result.Session = addResp.Session
+	}
+
+	return resp, nil
+}
+
+// CloneSession creates and starts a new session with the same configuration as
+// an existing one but with a fresh pairing secret and local key.
+func (s *sessionRpcServer) CloneSession(ctx context.Context,
+	req *litrpc.CloneSessionRequest) (*litrpc.CloneSessionResponse, error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %v", err)
+	}
+
+	orig, err := s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	// If no explicit expiry is requested, the clone is valid for the same
+	// duration the original session has left, so it expires at the same
+	// time.
+	expiry := orig.Expiry
+	if req.ExpiryTimestampSeconds != 0 {
+		expiry = time.Unix(int64(req.ExpiryTimestampSeconds), 0)
+	}
+
+	// A clone inherits the hard deadline, otherwise cloning would be a way
+	// around it.
+	if err := validateHardDeadline(expiry, orig.HardDeadline); err != nil {
+		return nil, err
+	}
+
+	label := req.Label
+	if label == "" {
+		label = orig.Label + defaultCloneLabelSuffix
+	}
+
+	var perms []bakery.Op
+	var caveats []macaroon.Caveat
+	if orig.MacaroonRecipe != nil {
+		perms = orig.MacaroonRecipe.Permissions
+		caveats = orig.MacaroonRecipe.Caveats
+	}
+
+	sess, err := session.NewSession(
+		label, orig.Type, expiry, orig.ServerAddr, orig.DevServer,
+		perms, caveats,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new session: %v", err)
+	}
+	sess.InsecureSkipVerify = orig.InsecureSkipVerify
+	sess.Description = orig.Description
+	sess.FallbackServerAddrs = orig.FallbackServerAddrs
+	sess.SuppressPairingSecret = orig.SuppressPairingSecret
+	sess.SingleUse = orig.SingleUse
+	sess.InactivityExpiry = orig.InactivityExpiry
+	sess.KeepaliveInterval = orig.KeepaliveInterval
+	sess.HandshakeTimeout = orig.HandshakeTimeout
+	sess.MacaroonExpiry = orig.MacaroonExpiry
+	sess.MaxConcurrentStreams = orig.MaxConcurrentStreams
+	sess.RequireApproval = orig.RequireApproval
+	sess.Priority = orig.Priority
+	sess.AccessSchedule = orig.AccessSchedule
+	sess.HardDeadline = orig.HardDeadline
+	sess.GroupID = orig.GroupID
+
+	// The metadata is copied, so later updates of one session's metadata
+	// don't show up in the other.
+	if len(orig.Metadata) != 0 {
+		sess.Metadata = make(map[string]string, len(orig.Metadata))
+		for key, value := range orig.Metadata {
+			sess.Metadata[key] = value
+		}
+	}
+	if orig.AutoRenews() && !orig.AutoRenewUntil.After(expiry) {
+		sess.AutoRenewUntil = orig.AutoRenewUntil
+		sess.RenewInterval = orig.RenewInterval
+	}
+
+	// The clone belongs to whoever created it. It is a new session like
+	// any other, so it has to pass the same checks as one that is added,
+	// against the limits that apply now rather than when the original was
+	// created.
+	sess.Owner, err = callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addReq, err := addSessionRequest(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateNewSession(ctx, addReq, sess.Type, perms)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.storeAndStartSession(sess, s.cfg.StartTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSession, err := marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+
+	return &litrpc.CloneSessionResponse{
+		Session: rpcSession,
+	}, nil
+}
+
+// storeAndStartSession persists a newly created session and then starts it,
+// giving up on starting it after the given timeout.
+func (s *sessionRpcServer) storeAndStartSession(sess *session.Session,
+	startTimeout time.Duration) error {
+
+	return s.storeAndStart(sess, startTimeout, nil)
+}
+
+// storeAndStart persists a newly created session and then starts it, giving up
+// on starting it after the given timeout. If a local public key is given to
+// replace, that session is revoked atomically with storing the new one. Its
+// mailbox connection is only stopped once the new session was started. If the
+// new session can't be started, it is revoked again so the caller's error
+// doesn't leave a stored session behind that nobody knows about. A replaced
+// session is then moved back to the state it was in, so the caller keeps a
+// valid session.
+func (s *sessionRpcServer) storeAndStart(sess *session.Session,
+	startTimeout time.Duration, replaces *btcec.PublicKey) error {
+
+	s.storeMtx.Lock()
+	defer s.storeMtx.Unlock()
+
+	if err := s.checkActiveSessionLimit(); err != nil {
+		return err
+	}
+
+	// The replaced session is fetched before it is revoked, so it can be
+	// reverted to its previous state if the new session fails to start.
+	var (
+		oldSess *session.Session
+		err     error
+	)
+	if replaces != nil {
+		oldSess, err = s.db.GetSession(replaces)
+		if err != nil {
+			return fmt.Errorf("error fetching session to replace: "+
+				"%v", err)
+		}
+
+		err = s.db.ReplaceSession(replaces, sess, revokeReasonReplaced)
+	} else {
+		err = s.db.StoreSession(sess)
+	}
+	switch {
+	case errors.Is(err, session.ErrSessionExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+
+	case errors.Is(err, session.ErrStateMismatch):
+		return status.Error(codes.FailedPrecondition, "session to "+
+			"replace is already revoked")
+
+	case errors.Is(err, syscall.ENOSPC):
+		return status.Errorf(codes.ResourceExhausted, "session "+
+			"database is full: %v", err)
+
+	case errors.Is(err, bbolt.ErrDatabaseReadOnly):
+		return status.Error(codes.Internal, "session database is "+
+			"read-only")
+
+	case err != nil:
+		return status.Errorf(codes.Internal, "error storing session: "+
+			"%v", err)
+	}
+
+	err = s.resumeSession(sess, startTimeout)
+
+	switch {
+	case err != nil && oldSess != nil:
+		s.revertReplacement(oldSess, sess)
+
+	case err != nil:
+		// Whatever went wrong, the session must neither stay usable
+		// nor be left running untracked, so we roll back its creation.
+		undoErr := s.revokeSession(
+			context.Background(), sess.LocalPublicKey,
+			revokeReasonStartFailed,
+		)
+		if undoErr != nil {
+			log.Errorf("Unable to revoke failed session: %v",
+				undoErr)
+		}
+
+	case oldSess != nil:
+		// The replaced session kept running until now, so its clients
+		// could still use it while the new session was started.
+		oldLog := sessionLogger(oldSess)
+		oldLog.Infof("Revoked replaced session")
+
+		s.stopRevokedSession(context.Background(), replaces, oldLog)
+	}
+
+	switch {
+	case status.Code(err) == codes.DeadlineExceeded:
+		return err
+
+	case err != nil:
+		return fmt.Errorf("error starting session: %v", err)
+	}
+
+	return nil
+}
+
+// revertReplacement rolls back the replacement of the given old session by the
+// given new session that couldn't be started. The new session is revoked and
+// stopped, while the old session, whose mailbox connection was never stopped,
+// gets back the state it had before it was replaced.
+func (s *sessionRpcServer) revertReplacement(oldSess,
+	newSess *session.Session) {
+
+	oldLog := sessionLogger(oldSess)
+	err := s.db.RevertReplaceSession(
+		oldSess.LocalPublicKey, oldSess.State, newSess.LocalPublicKey,
+		revokeReasonStartFailed,
+	)
+	if err != nil {
+		oldLog.Errorf("Unable to revert replaced session: %v", err)
+
+		// The new session must not stay usable either way and the old
+		// one is revoked, so neither of them may keep running.
+		undoErr := s.revokeSession(
+			context.Background(), newSess.LocalPublicKey,
+			revokeReasonStartFailed,
+		)
+		if undoErr != nil {
+			log.Errorf("Unable to revoke failed session: %v",
+				undoErr)
+		}
+		s.stopRevokedSession(
+			context.Background(), oldSess.LocalPublicKey, oldLog,
+		)
+
+		return
+	}
+	oldLog.Infof("Reverted replaced session after its replacement " +
+		"failed to start")
+
+	s.stopRevokedSession(
+		context.Background(), newSess.LocalPublicKey,
+		sessionLogger(newSess),
+	)
+}
+
+// isActiveState returns true if a session in the given state counts as active,
+// which is every state but revoked and expired. A session that waits for
+// approval, is suspended or quarantined isn't served right now but can become
+// usable again without being added anew.
+func isActiveState(state session.State) bool {
+	return state != session.StateRevoked && state != session.StateExpired
+}
+
+// checkActiveSessionLimit returns a ResourceExhausted error if the configured
+// maximum number of active sessions is already reached. A session counts as
+// active until it is revoked or expired.
+func (s *sessionRpcServer) checkActiveSessionLimit() error {
+	if s.cfg.MaxActiveSessions == 0 {
+		return nil
+	}
+
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		return fmt.Errorf("error listing sessions: %v", err)
+	}
+
+	var numActive uint32
+	for _, sess := range sessions {
+		if isActiveState(sess.State) {
+			numActive++
+		}
+	}
+
+	if numActive >= s.cfg.MaxActiveSessions {
+		return status.Errorf(codes.ResourceExhausted, "maximum number "+
+			"of %d active sessions reached",
+			s.cfg.MaxActiveSessions)
+	}
+
+	return nil
+}
+
+// validateExpiry makes sure the given session expiry lies within the minimum
+// and maximum session duration, counted from now, configured for the server
+// and not after the configured absolute maximum expiry.
+func (s *sessionRpcServer) validateExpiry(now, expiry time.Time) error {
+	minDuration := s.cfg.MinDuration
+	if minDuration != 0 && expiry.Before(now.Add(minDuration)) {
+		return status.Errorf(codes.InvalidArgument, "session must be "+
+			"valid for at least %v", minDuration)
+	}
+
+	maxDuration := s.cfg.MaxDuration
+	if maxDuration != 0 && expiry.After(now.Add(maxDuration)) {
+		return status.Errorf(codes.InvalidArgument, "session must not "+
+			"be valid for more than %v", maxDuration)
+	}
+
+	maxExpiry := s.cfg.maxAbsoluteExpiry
+	if !maxExpiry.IsZero() && expiry.After(maxExpiry) {
+		return status.Errorf(codes.InvalidArgument, "session must not "+
+			"expire after %v", maxExpiry.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// validateHardDeadline makes sure the given expiry doesn't lie after the given
+// hard deadline of a session. A zero deadline doesn't restrict the expiry.
+func validateHardDeadline(expiry, deadline time.Time) error {
+	if !deadline.IsZero() && expiry.After(deadline) {
+		return status.Errorf(codes.InvalidArgument, "expiry must not "+
+			"be after the hard deadline %v",
+			deadline.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// validateLabel makes sure the given session label doesn't exceed the maximum
+// label length and matches the configured label pattern, if there is one.
+func (s *sessionRpcServer) validateLabel(label string) error {
+	err := validateLength("label", label, s.cfg.MaxLabelLength)
+	if err != nil {
+		return err
+	}
+
+	if s.cfg.labelPattern != nil && !s.cfg.labelPattern.MatchString(label) {
+		return status.Errorf(codes.InvalidArgument, "label %q doesn't "+
+			"match the required pattern %s", label,
+			s.cfg.LabelPattern)
+	}
+
+	return nil
+}
+
+// validateLength makes sure the given text field doesn't exceed the given
+// maximum number of characters. The characters are counted as unicode code
+// points, so multi-byte characters count once. A maximum of 0 disables the
+// check.
+func validateLength(field, value string, maxLength uint32) error {
+	length := utf8.RuneCountInString(value)
+	if maxLength != 0 && length > int(maxLength) {
+		return status.Errorf(codes.InvalidArgument, "%s must not be "+
+			"longer than %d characters, got %d", field, maxLength,
+			length)
+	}
+
+	return nil
+}
+
+// resumeSession tries to start an existing session if it is not expired, not
+// revoked and a LiT session. Starting the session's mailbox connection is given
+// up after the given timeout. Any failure is recorded as the session's resume
+// status.
+func (s *sessionRpcServer) resumeSession(sess *session.Session,
+	startTimeout time.Duration) error {
+
+	pubKey := sess.LocalPublicKey
+	sessLog := sessionLogger(sess)
+
+	// A session that is already running doesn't need to be started again.
+	if s.isActive(pubKey) {
+		sessLog.Debugf("Not resuming already active session")
+		return nil
+	}
+
+	// We only start non-revoked LiT sessions. Everything else we just
+	// skip. Sessions that were marked as expired are still looked at so
+	// they can be revoked once their grace period is over. A session that
+	// is still marked as starting wasn't started completely before a
+	// shutdown. A session whose remote waited for approval is started so
+	// the remote can connect again.
+	if sess.State != session.StateInUse &&
+		sess.State != session.StateCreated &&
+		sess.State != session.StateStarting &&
+		sess.State != session.StatePendingApproval &&
+		sess.State != session.StateSuspended &&
+		sess.State != session.StateExpired {
+
+		sessLog.Debugf("Not resuming session with state %d", sess.State)
+
+		// Revoked and quarantined sessions are never resumed, that's
+		// not worth reporting.
+		if sess.State != session.StateRevoked &&
+			sess.State != session.StateQuarantined {
+
+			s.recordResumeStatus(sess, fmt.Sprintf("unknown "+
+				"session state %d", sess.State), sessLog)
+		}
+
+		return nil
+	}
+
+	// Don't resume an expired session. If it only expired recently, we
+	// mark it as expired instead of revoking it, so it can still be
+	// extended.
+	if sess.Expiry.Before(time.Now()) {
+		sessLog.Debugf("Not resuming session with expiry %s",
+			sess.Expiry)
+
+		if time.Since(sess.Expiry) <= s.cfg.ExpiryGracePeriod {
+			if sess.State == session.StateExpired {
+				return nil
+			}
+
+			err := s.db.UpdateSessionState(
+				pubKey, session.StateExpired,
+			)
+			if err != nil {
+				err = fmt.Errorf("error marking session as "+
+					"expired: %v", err)
+				s.recordResumeStatus(sess, err.Error(), sessLog)
+
+				return err
+			}
+
+			return nil
+		}
+
+		err := s.db.RevokeSession(pubKey, revokeReasonExpired)
+		if err != nil {
+			err = fmt.Errorf("error revoking session: %v", err)
+			s.recordResumeStatus(sess, err.Error(), sessLog)
+
+			return err
+		}
+
+		return nil
+	}
+
+	if !isSupportedSessionType(sess.Type) {
+		sessLog.Debugf("Not resuming session with unsupported type")
+		s.recordResumeStatus(sess, fmt.Sprintf("unsupported session "+
+			"type %d", sess.Type), sessLog)
+
+		return nil
+	}
+
+	if s.checkMacaroonBaker(sess.Type) != nil {
+		sessLog.Debugf("Not resuming session, %v", errNoMacaroonBaker)
+		s.recordResumeStatus(sess, errNoMacaroonBaker.Error(), sessLog)
+
+		return nil
+	}
+
+	if sess.PendingActivation(time.Now()) {
+		sessLog.Debugf("Deferring start of session until its "+
+			"activation at %v", sess.ActivationTime)
+		s.scheduleActivation(
+			sess, sess.ActivationTime, startTimeout, sessLog,
+		)
+
+		return nil
+	}
+
+	// A session with an access schedule is only served inside its access
+	// windows. Outside of them, it is suspended until the next one opens.
+	if sess.AccessSchedule != nil &&
+		!sess.AccessSchedule.Allows(time.Now()) {
+
+		sessLog.Debugf("Not resuming session outside of its access " +
+			"windows")
+		s.suspendSession(sess, sessLog)
+
+		return nil
+	}
+	if sess.State == session.StateSuspended {
+		if err := s.unsuspendSession(sess); err != nil {
+			s.recordResumeStatus(sess, err.Error(), sessLog)
+			return err
+		}
+	}
+
+	// In lazy mode, the macaroon is only baked once a client connects.
+	var authData []byte
+	if !s.bakesLazily(sess) {
+		var err error
+		authData, err = s.sessionAuthData(sess)
+		if err != nil {
+			sessLog.Debugf("Not resuming session. Could not bake "+
+				"the necessary macaroon: %v", err)
+			s.recordResumeStatus(sess, fmt.Sprintf("error baking "+
+				"macaroon: %v", err), sessLog)
+
+			return nil
+		}
+	}
+
+	sessionClosedSub, err := s.startSession(sess, authData, startTimeout)
+	if err != nil {
+		s.recordResumeStatus(sess, fmt.Sprintf("error starting "+
+			"session: %v", err), sessLog)
+
+		return err
+	}
+	s.markActive(pubKey, sessionClosedSub)
+	sessLog.Debugf("Started session with pairing secret %v",
+		session.Redact(sess.PairingSecret[:]))
+
+	// A successful resume clears the error of a previous attempt.
+	if sess.LastResumeError != "" {
+		s.recordResumeStatus(sess, "", sessLog)
+	}
+	s.recordAuditEvent(pubKey, session.AuditEventStarted)
+
+	s.wg.Add(1)
+	atomic.AddInt64(&s.numWaitGroupGoroutines, 1)
+	go func() {
+		atomic.AddInt64(&s.numSessionGoroutines, 1)
+		defer func() {
+			atomic.AddInt64(&s.numSessionGoroutines, -1)
+			atomic.AddInt64(&s.numWaitGroupGoroutines, -1)
+			s.wg.Done()
+		}()
+		defer s.markInactive(pubKey, sessionClosedSub)
+
+		ticker := time.NewTimer(expiryTimerDuration(
+			time.Now(), sessionDeadline(sess, time.Now()),
+			s.cfg.ExpiryJitter,
+		))
+		defer ticker.Stop()
+
+		// Only sessions with an inactivity expiry need to know about
+		// new connections, they push the deadline out each time.
+		var connected <-chan struct{}
+		if sess.InactivityExpiry != 0 {
+			connected = s.sessionServer.Connections(pubKey)
+		}
+
+		// Every connection event is passed on to the event handler.
+		events := s.sessionServer.Events(pubKey)
+
+		// Reading from a nil channel blocks forever, so we only ever
+		// renew sessions that are configured to do so.
+		var renew <-chan time.Time
+		if sess.AutoRenews() {
+			renewTicker := time.NewTicker(sess.RenewInterval)
+			defer renewTicker.Stop()
+
+			renew = renewTicker.C
+		}
+
+		// A session with an access schedule is suspended once its
+		// current access window closes.
+		var accessEnd <-chan time.Time
+		if sess.AccessSchedule != nil {
+			end := sess.AccessSchedule.NextChange(time.Now())
+			if !end.IsZero() {
+				accessTimer := time.NewTimer(time.Until(end))
+				defer accessTimer.Stop()
+
+				accessEnd = accessTimer.C
+			}
+		}
+
+		// Subscribers are warned the configured lead time before the
+		// session expires, so clients can renew it in time.
+		var (
+			warningTimer *time.Timer
+			warning      <-chan time.Time
+		)
+		warningDuration := func() time.Duration {
+			now := time.Now()
+			return expiryWarningDuration(
+				now, sessionDeadline(sess, now),
+				s.cfg.ExpiryWarning,
+			)
+		}
+		if s.cfg.ExpiryWarning > 0 {
+			warningTimer = time.NewTimer(warningDuration())
+			defer warningTimer.Stop()
+
+			warning = warningTimer.C
+		}
+
+		// Once the deadline moved, the warning is due again at its new
+		// lead time.
+		resetWarning := func() {
+			if warningTimer == nil {
+				return
+			}
+
+			warningTimer.Stop()
+			select {
+			case <-warningTimer.C:
+			default:
+			}
+			warningTimer.Reset(warningDuration())
+		}
+
+		for {
+			select {
+			case <-s.quit:
+				return
+
+			case <-sessionClosedSub:
+				s.recordAuditEvent(
+					pubKey, session.AuditEventStopped,
+				)
+
+				if sess.SingleUse {
+					s.revokeSingleUseSession(
+						pubKey, sessionClosedSub,
+						sessLog,
+					)
+				}
+
+				return
+
+			case <-renew:
+				if !time.Now().Before(sess.AutoRenewUntil) {
+					sessLog.Debugf("Stopping renewal of " +
+						"session macaroon")
+					renew = nil
+					continue
+				}
+
+				if err := s.renewSession(sess); err != nil {
+					sessLog.Errorf("Unable to renew "+
+						"session: %v", err)
+				}
+
+			case event := <-events:
+				s.handleConnectionEvent(sess, event)
+
+			case <-connected:
+				sessLog.Debugf("Client connected, resetting " +
+					"inactivity expiry")
+
+				if !ticker.Stop() {
+					<-ticker.C
+				}
+				ticker.Reset(expiryTimerDuration(
+					time.Now(),
+					sessionDeadline(sess, time.Now()),
+					s.cfg.ExpiryJitter,
+				))
+				resetWarning()
+
+			case <-accessEnd:
+				sessLog.Debugf("Access window of session " +
+					"closed")
+				s.suspendRunningSession(
+					sess, sessionClosedSub, sessLog,
+				)
+
+				return
+
+			case <-warning:
+				sessLog.Debugf("Notifying subscribers about " +
+					"expiring session")
+
+				err = s.db.NotifyExpiringSoon(pubKey)
+				if err != nil {
+					sessLog.Debugf("Error notifying about "+
+						"expiring session: %v", err)
+				}
+
+			case <-ticker.C:
+				// The session might have been renewed while it
+				// was running, it then runs until its new
+				// expiry.
+				if s.applyRenewedExpiry(sess, sessLog) {
+					ticker.Reset(expiryTimerDuration(
+						time.Now(),
+						sessionDeadline(
+							sess, time.Now(),
+						),
+						s.cfg.ExpiryJitter,
+					))
+					resetWarning()
+
+					continue
+				}
+
+				s.expireRunningSession(
+					pubKey, sessionClosedSub, sessLog,
+				)
+
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// applyRenewedExpiry reloads the expiry of the given running session, which
+// might have been renewed since the session was started. If the session reached
+// its absolute expiry but was renewed to a later one, its expiry is updated and
+// true is returned. Sessions that only reached their inactivity expiry aren't
+// kept running by a renewal.
+func (s *sessionRpcServer) applyRenewedExpiry(sess *session.Session,
+	sessLog btclog.Logger) bool {
+
+	now := time.Now()
+	if now.Before(sess.Expiry) {
+		return false
+	}
+
+	current, err := s.db.GetSession(sess.LocalPublicKey)
+	if err != nil {
+		sessLog.Debugf("Error fetching expired session: %v", err)
+		return false
+	}
+
+	if current.State == session.StateRevoked ||
+		!now.Before(current.Expiry) {
+
+		return false
+	}
+
+	sessLog.Debugf("Session was renewed until %v", current.Expiry)
+	sess.Expiry = current.Expiry
+
+	return true
+}
+
+// expireRunningSession stops and revokes a running session that reached its
+// expiry. Depending on the configured expiry policy, the clients that are still
+// connected are given the chance to finish first.
+func (s *sessionRpcServer) expireRunningSession(pubKey *btcec.PublicKey,
+	closedSub chan struct{}, sessLog btclog.Logger) {
+
+	switch s.cfg.ExpiryPolicy {
+	case expiryPolicyDrain:
+		sessLog.Infof("Session expired, draining its connected "+
+			"clients for up to %v", s.cfg.ExpiryDrainPeriod)
+
+		if err := s.sessionServer.Drain(pubKey); err != nil {
+			sessLog.Debugf("Error draining session: %v", err)
+		}
+
+		drainTimer := time.NewTimer(s.cfg.ExpiryDrainPeriod)
+		defer drainTimer.Stop()
+
+		select {
+		case <-s.sessionServer.Idle(pubKey):
+		case <-closedSub:
+		case <-drainTimer.C:
+			sessLog.Infof("Drain period of expired session is over")
+
+		case <-s.quit:
+			return
+		}
+
+	case expiryPolicyWarnOnly:
+		select {
+		case <-s.sessionServer.Idle(pubKey):
+		default:
+			sessLog.Warnf("Session expired, keeping it running " +
+				"until its connected clients are gone")
+		}
+
+		select {
+		case <-s.sessionServer.Idle(pubKey):
+		case <-closedSub:
+		case <-s.quit:
+			return
+		}
+	}
+
+	sessLog.Debugf("Stopping expired session")
+
+	err := s.sessionServer.StopSession(pubKey)
+	if err != nil {
+		sessLog.Debugf("Error stopping session: %v", err)
+	}
+	s.recordAuditEvent(pubKey, session.AuditEventStopped)
+
+	err = s.db.RevokeSession(pubKey, revokeReasonExpired)
+	if err != nil {
+		sessLog.Debugf("Error revoking session: %v", err)
+	}
+}
+
+// scheduleActivation resumes the given session at the given time, which is its
+// activation time or the time its next access window opens, unless the wait is
+// canceled by pausing all sessions or the server is stopped before. A session
+// that already waits for its activation isn't scheduled again.
+func (s *sessionRpcServer) scheduleActivation(sess *session.Session,
+	at time.Time, startTimeout time.Duration, sessLog btclog.Logger) {
+
+	id := string(sess.LocalPublicKey.SerializeCompressed())
+	cancel := make(chan struct{})
+
+	s.activeSessionsMtx.Lock()
+	if _, ok := s.pendingActivations[id]; ok {
+		s.activeSessionsMtx.Unlock()
+		return
+	}
+	s.pendingActivations[id] = cancel
+	s.activeSessionsMtx.Unlock()
+
+	s.wg.Add(1)
+	atomic.AddInt64(&s.numWaitGroupGoroutines, 1)
+	go func() {
+		defer func() {
+			atomic.AddInt64(&s.numWaitGroupGoroutines, -1)
+			s.wg.Done()
+		}()
+
+		timer := time.NewTimer(time.Until(at))
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-cancel:
+			return
+		case <-s.quit:
+			return
+		}
+
+		s.activeSessionsMtx.Lock()
+		if s.pendingActivations[id] != cancel {
+			s.activeSessionsMtx.Unlock()
+			return
+		}
+		delete(s.pendingActivations, id)
+		s.activeSessionsMtx.Unlock()
+
+		// The session might have been revoked in the meantime, so we
+		// resume its latest version.
+		current, err := s.db.GetSession(sess.LocalPublicKey)
+		if err != nil {
+			sessLog.Errorf("Unable to fetch session to "+
+				"activate: %v", err)
+			return
+		}
+
+		sessLog.Debugf("Activating session")
+		if err := s.resumeSession(current, startTimeout); err != nil {
+			sessLog.Errorf("Unable to activate session: %v", err)
+		}
+	}()
+}
+
+// cancelActivations cancels all pending session activations and returns the
+// number of canceled activations.
+func (s *sessionRpcServer) cancelActivations() uint32 {
+	s.activeSessionsMtx.Lock()
+	defer s.activeSessionsMtx.Unlock()
+
+	var numCanceled uint32
+	for id, cancel := range s.pendingActivations {
+		close(cancel)
+		delete(s.pendingActivations, id)
+		numCanceled++
+	}
+
+	return numCanceled
+}
+
+// recordResumeStatus persists the outcome of an attempt to resume the given
+// session, so operators can see why a session isn't running without digging
+// through the logs. Failing to do so doesn't affect the resume, so the error is
+// only logged.
+func (s *sessionRpcServer) recordResumeStatus(sess *session.Session,
+	resumeErr string, sessLog btclog.Logger) {
+
+	err := s.db.UpdateResumeStatus(sess.LocalPublicKey, resumeErr)
+	if err != nil {
+		sessLog.Errorf("Unable to record resume status: %v", err)
+	}
+}
+
+// resumeSessions resumes all given sessions in the order of their priority,
+// running at most the configured number of resumes at the same time. A session
+// that can't be resumed, for example because its mailbox server is
+// unreachable, doesn't keep the other sessions from being resumed, its failure
+// is only logged and recorded as its resume status. Once the server is
+// stopped, the sessions that are still queued aren't resumed anymore.
+func (s *sessionRpcServer) resumeSessions(sessions []*session.Session,
+	startTimeout time.Duration) {
+
+	// Sessions with the same priority keep their order, so they are still
+	// resumed in the order they are stored in.
+	sessions = append([]*session.Session(nil), sessions...)
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].Priority > sessions[j].Priority
+	})
+
+	numWorkers := int(s.cfg.StartupConcurrency)
+	if numWorkers == 0 || numWorkers > len(sessions) {
+		numWorkers = len(sessions)
+	}
+
+	var (
+		wg    sync.WaitGroup
+		queue = make(chan *session.Session)
+	)
+	for i := 0; i < numWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for sess := range queue {
+				// Sessions that were already queued when the
+				// server was stopped are skipped.
+				select {
+				case <-s.quit:
+					continue
+				default:
+				}
+
+				err := s.resumeSession(sess, startTimeout)
+				if err != nil {
+					sessionLogger(sess).Errorf("Unable to "+
+						"resume session: %v", err)
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for _, sess := range sessions {
+		select {
+		case queue <- sess:
+		case <-s.quit:
+			break dispatch
+		}
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// sessionAuthData returns the authentication data a client connecting through
+// the given session uses. For macaroon sessions, a new macaroon is baked. If
+// the session renews automatically, the macaroon only has a short expiry.
+func (s *sessionRpcServer) sessionAuthData(sess *session.Session) ([]byte,
+	error) {
+
+	if sess.AccessSchedule != nil &&
+		!sess.AccessSchedule.Allows(time.Now()) {
+
+		return nil, errOutsideAccessWindow
+	}
+
+	if sess.Type == session.TypeUIPassword {
+		s.basicAuthMtx.RLock()
+		defer s.basicAuthMtx.RUnlock()
+
+		return uiPasswordSessionAccess.authData(s.basicAuth), nil
+	}
+
+	if s.superMacBaker == nil {
+		return nil, errNoMacaroonBaker
+	}
+
+	ctx := context.Background()
+	recipe, err := s.bakeRecipe(ctx, sess, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	mac, err := s.superMacBaker(ctx, sess.MacaroonRootKey, recipe)
+	if err != nil {
+		return nil, err
+	}
+	sessionLogger(sess).Debugf("Baked macaroon %v", session.Redact(
+		[]byte(mac),
+	))
+
+	return []byte(fmt.Sprintf("%s: %s", HeaderMacaroon, mac)), nil
+}
+
+// bakeRecipe returns the recipe the macaroon of the given macaroon session is
+// baked with at the given time. On top of the session's recipe, it limits the
+// lifetime of the macaroon if the session has a macaroon expiry or renews its
+// macaroon and passes the recipe through the recipe interceptor.
+func (s *sessionRpcServer) bakeRecipe(ctx context.Context,
+	sess *session.Session, now time.Time) (*session.MacaroonRecipe,
+	error) {
+
+	recipe := s.sessionRecipe(sess)
+
+	// The macaroon of an auto renewing session stays valid for two renew
+	// intervals, so a client has enough time to pick up the renewed one.
+	var macExpiry time.Time
+	if sess.AutoRenews() {
+		macExpiry = now.Add(2 * sess.RenewInterval)
+		if macExpiry.After(sess.AutoRenewUntil) {
+			macExpiry = sess.AutoRenewUntil
+		}
+	}
+
+	// A configured macaroon expiry can make the macaroon expire even
+	// earlier.
+	if sess.MacaroonExpiry != 0 {
+		expiry := now.Add(sess.MacaroonExpiry)
+		if macExpiry.IsZero() || expiry.Before(macExpiry) {
+			macExpiry = expiry
+		}
+	}
+
+	if !macExpiry.IsZero() {
+		caveat := checkers.TimeBeforeCaveat(macExpiry)
+		recipe.Caveats = append(recipe.Caveats, macaroon.Caveat{
+			Id: []byte(caveat.Condition),
+		})
+	}
+
+	if s.recipeInterceptor == nil {
+		return recipe, nil
+	}
+
+	recipe, err := s.recipeInterceptor(ctx, sess, recipe)
+	if err != nil {
+		return nil, fmt.Errorf("error intercepting macaroon recipe: "+
+			"%v", err)
+	}
+
+	if recipe == nil {
+		return nil, fmt.Errorf("recipe interceptor returned no recipe")
+	}
+
+	return recipe, nil
+}
+
+// addSessionPermissions returns the permissions a new session of the given type
+// is created with for the given request. A custom session is restricted to the
+// union of its explicit permissions, its permission template and its subserver
+// methods. The permissions still need to be checked with
+// checkSessionPermissions.
+func (s *sessionRpcServer) addSessionPermissions(
+	req *litrpc.AddSessionRequest, typ session.Type) ([]bakery.Op, error) {
+
+	perms, err := customPermissions(req.MacaroonCustomPermissions, typ)
+	if err != nil {
+		return nil, err
+	}
+
+	templatePerms, err := s.templatePermissions(
+		req.PermissionTemplate, typ,
+	)
+	if err != nil {
+		return nil, err
+	}
+	perms = append(perms, templatePerms...)
+
+	methodPerms, err := s.subserverMethodPermissions(
+		req.LitSubserverMethods, typ,
+	)
+	if err != nil {
+		return nil, err
+	}
+	perms = dedupPermissions(append(perms, methodPerms...))
+
+	return perms, nil
+}
+
+// checkSessionPermissions makes sure that a new session of the given type may
+// be created with the given permissions. A custom session never falls back to
+// the admin permissions, so it must grant at least one permission and stay
+// within the configured permission limits.
+func (s *sessionRpcServer) checkSessionPermissions(typ session.Type,
+	perms []bakery.Op) error {
+
+	if typ != session.TypeMacaroonCustom {
+		return nil
+	}
+
+	if len(perms) == 0 {
+		return status.Error(codes.InvalidArgument, "custom sessions "+
+			"need at least one permission")
+	}
+
+	return s.checkCustomPermissions(perms)
+}
+
+// checkMacaroonBaker returns a FailedPrecondition error if sessions of the
+// given type use a macaroon but the server has no way to bake macaroons.
+func (s *sessionRpcServer) checkMacaroonBaker(typ session.Type) error {
+	if typ == session.TypeUIPassword || s.superMacBaker != nil {
+		return nil
+	}
+
+	return status.Error(
+		codes.FailedPrecondition, errNoMacaroonBaker.Error(),
+	)
+}
+
+// sessionRecipe returns the permissions and caveats the macaroon of the given
+// macaroon session is baked with, except for the expiry caveat that is only
+// added at the time the macaroon is baked.
+func (s *sessionRpcServer) sessionRecipe(
+	sess *session.Session) *session.MacaroonRecipe {
+
+	recipe := &session.MacaroonRecipe{}
+
+	// Custom sessions are limited to exactly the permissions they were
+	// created with, they never fall back to the admin permissions.
+	switch sess.Type {
+	case session.TypeMacaroonCustom:
+		if sess.MacaroonRecipe != nil {
+			recipe.Permissions = sess.MacaroonRecipe.Permissions
+		}
+
+	default:
+		readOnly := sess.Type == session.TypeMacaroonReadonly
+		recipe.Permissions = s.cfg.permissions(readOnly)
+	}
+
+	// Any caveats stored with the session, like those of an imported
+	// macaroon, are always added to the macaroon.
+	if sess.MacaroonRecipe != nil {
+		recipe.Caveats = append(
+			recipe.Caveats, sess.MacaroonRecipe.Caveats...,
+		)
+	}
+
+	return recipe
+}
+
+// startSession starts the mailbox connection of the given session. If no
+// authentication data is given, it is only created once the first client
+// connects. If none of the session's mailbox servers can be reached within the
+// given timeout, the session is stopped again and a DeadlineExceeded error is
+// returned. A timeout of 0 doesn't wait for a mailbox server to be reached.
+// While the session is being started, it is marked as starting.
+func (s *sessionRpcServer) startSession(sess *session.Session,
+	authData []byte, timeout time.Duration) (chan struct{}, error) {
+
+	// A session that is still marked as starting from an earlier attempt
+	// that was interrupted, for example by a crash, goes back to the state
+	// it had before that attempt once it is started.
+	pubKey := sess.LocalPublicKey
+	if sess.State == session.StateStarting {
+		sess.State = sess.StartingFrom
+	}
+
+	err := s.db.MarkSessionStarting(pubKey, sess.State)
+	if err != nil && err != session.ErrStateMismatch {
+		return nil, fmt.Errorf("error marking session as starting: %v",
+			err)
+	}
+	defer func() {
+		// If the state was changed in the meantime, for example because
+		// the session was revoked, we don't overwrite it.
+		err := s.db.SwapSessionState(
+			pubKey, session.StateStarting, sess.State,
+		)
+		if err != nil && err != session.ErrStateMismatch {
+			log.Errorf("Unable to reset state of session %x: %v",
+				pubKey.SerializeCompressed(), err)
+		}
+	}()
+
+	return s.startMailboxSession(sess, authData, timeout)
+}
+
+// bakesLazily returns true if the macaroon of the given session is only baked
+// once a client connects to it. UI password sessions don't use a macaroon, so
+// their authentication data is always created right away, unless the session
+// requires approval. The data of such a session is only created once its
+// remote was approved.
+func (s *sessionRpcServer) bakesLazily(sess *session.Session) bool {
+	if sess.RequireApproval {
+		return true
+	}
+
+	return s.cfg.LazyMacaroons && sess.Type != session.TypeUIPassword
+}
+
+// startMailboxSession starts the mailbox connection of the given session. If
+// no authentication data is given, it is only created once the first client
+// connects. If none of the session's mailbox servers can be reached within the
+// given timeout, the session server stops the session again and a
+// DeadlineExceeded error is returned. A timeout of 0 doesn't wait for a mailbox
+// server to be reached.
+func (s *sessionRpcServer) startMailboxSession(sess *session.Session,
+	authData []byte, timeout time.Duration) (chan struct{}, error) {
+
+	var (
+		sessionClosedSub chan struct{}
+		err              error
+	)
+	if authData != nil {
+		sessionClosedSub, err = s.sessionServer.StartSession(
+			sess, authData, timeout,
+		)
+	} else {
+		sessLog := sessionLogger(sess)
+		lazyAuthData := func(quit <-chan struct{}) ([]byte, error) {
+			err := s.awaitApproval(
+				sess.LocalPublicKey, quit, sessLog,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			sessLog.Debugf("Baking macaroon for first connection")
+
+			// The session is reloaded, so changes made since it
+			// was started, like added caveats, are included.
+			current, err := s.db.GetSession(sess.LocalPublicKey)
+			if err != nil {
+				return nil, err
+			}
+
+			authData, err := s.sessionAuthData(current)
+			if err != nil {
+				sessLog.Errorf("Unable to bake macaroon: %v",
+					err)
+			}
+
+			return authData, err
+		}
+
+		sessionClosedSub, err = s.sessionServer.StartLazySession(
+			sess, lazyAuthData, timeout,
+		)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, status.Errorf(codes.DeadlineExceeded, "timeout "+
+			"after %v while starting session: %v", timeout, err)
+	}
+
+	return sessionClosedSub, err
+}
+
+// renewSession re-bakes the macaroon of a running session and hands it out to
+// clients from their next handshake on. The mailbox connection stays up, so
+// connected clients aren't interrupted. If the new macaroon can't be baked, the
+// session keeps handing out the old one.
+func (s *sessionRpcServer) renewSession(sess *session.Session) error {
+	// The session's type or caveats might have been changed since it was
+	// started, so the macaroon is baked for the stored version of the
+	// session.
+	stored, err := s.db.GetSession(sess.LocalPublicKey)
+	if err != nil {
+		return fmt.Errorf("error fetching session: %v", err)
+	}
+
+	// The macaroon of a session that wasn't approved yet is only baked
+	// once it is approved, so there's nothing to renew.
+	if stored.RequireApproval {
+		return nil
+	}
+
+	authData, err := s.sessionAuthData(stored)
+	if err != nil {
+		return fmt.Errorf("error baking macaroon: %v", err)
+	}
+
+	err = s.sessionServer.UpdateAuthData(sess.LocalPublicKey, authData)
+	if err != nil {
+		return fmt.Errorf("error updating session macaroon: %v", err)
+	}
+	s.recordAuditEvent(sess.LocalPublicKey, session.AuditEventRenewed)
+
+	return nil
+}
+
+// expiryTimerDuration returns the time to wait from now until an expired
+// session should be revoked. A random jitter of up to maxJitter is added to the
+// time until the expiry, so sessions with the same expiry aren't all revoked in
+// one burst. The returned duration never ends before the actual expiry.
+func expiryTimerDuration(now, expiry time.Time,
+	maxJitter time.Duration) time.Duration {
+
+	duration := expiry.Sub(now)
+	if maxJitter <= 0 {
+		return duration
+	}
+
+	return duration + time.Duration(rand.Int63n(int64(maxJitter)+1))
+}
+
+// expiryWarningDuration returns the time to wait from now until subscribers
+// are warned that a session with the given deadline expires soon. If the
+// deadline is closer than the lead time, the warning is due right away.
+func expiryWarningDuration(now, deadline time.Time,
+	leadTime time.Duration) time.Duration {
+
+	duration := deadline.Sub(now) - leadTime
+	if duration < 0 {
+		return 0
+	}
+
+	return duration
+}
+
+// sessionDeadline returns the time a running session expires at, counted from
+// the given time. For sessions with an inactivity expiry this is the end of
+// the inactivity period, capped at the session's absolute expiry.
+func sessionDeadline(sess *session.Session, now time.Time) time.Time {
+	if sess.InactivityExpiry == 0 {
+		return sess.Expiry
+	}
+
+	deadline := now.Add(sess.InactivityExpiry)
+	if deadline.After(sess.Expiry) {
+		return sess.Expiry
+	}
+
+	return deadline
+}
+
+// sessionLogger returns a logger that attaches the identifying fields of the
+// given session to every log line, so the lines can be filtered by session.
+func sessionLogger(sess *session.Session) btclog.Logger {
+	prefix := fmt.Sprintf("session_pubkey=%x session_type=%d "+
+		"session_label=%q", sess.LocalPublicKey.SerializeCompressed(),
+		sess.Type, sess.Label)
+
+	// The prefix is used as part of a format string, so we need to escape
+	// any formatting directives a user might have put into the label.
+	return build.NewPrefixLog(strings.ReplaceAll(prefix, "%", "%%"), log)
+}
+
+// isActive returns true if the mailbox connection of the session with the
+// given local public key is currently running.
+func (s *sessionRpcServer) isActive(pubKey *btcec.PublicKey) bool {
+	s.activeSessionsMtx.Lock()
+	defer s.activeSessionsMtx.Unlock()
+
+	_, ok := s.activeSessions[string(pubKey.SerializeCompressed())]
+	return ok
+}
+
+// revokeSingleUseSession revokes a single-use session after the mailbox
+// connection signalled over the given channel ended. Sessions that were paused,
+// already revoked or stopped because of a shutdown are left untouched.
+func (s *sessionRpcServer) revokeSingleUseSession(pubKey *btcec.PublicKey,
+	closedSub chan struct{}, sessLog btclog.Logger) {
+
+	select {
+	case <-s.quit:
+		return
+	default:
+	}
+
+	// Paused sessions are removed from the active set before being
+	// stopped, so a session that isn't tracked anymore wasn't disconnected.
+	s.activeSessionsMtx.Lock()
+	tracked := s.activeSessions[string(pubKey.SerializeCompressed())]
+	s.activeSessionsMtx.Unlock()
+	if tracked != closedSub {
+		return
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	if err != nil {
+		sessLog.Errorf("Unable to fetch single-use session: %v", err)
+		return
+	}
+	if sess.State == session.StateRevoked {
+		return
+	}
+
+	sessLog.Debugf("Revoking single-use session after disconnect")
+	err = s.db.RevokeSession(pubKey, revokeReasonSingleUse)
+	if err != nil {
+		sessLog.Errorf("Unable to revoke single-use session: %v", err)
+	}
+}
+
+// recordAuditEvent appends an event of the given type for the session with the
+// given local public key to the audit log. Failing to do so doesn't fail the
+// action itself, so the error is only logged.
+func (s *sessionRpcServer) recordAuditEvent(pubKey *btcec.PublicKey,
+	typ session.AuditEventType) {
+
+	if err := s.db.AddAuditEvent(pubKey, typ); err != nil {
+		log.Errorf("Unable to record audit event %d for session %x: %v",
+			typ, pubKey.SerializeCompressed(), err)
+	}
+}
+
+// ListSessionEvents returns the recorded lifecycle actions of all sessions or
+// of a single session, optionally restricted to a time range.
+func (s *sessionRpcServer) ListSessionEvents(_ context.Context,
+	req *litrpc.ListSessionEventsRequest) (
+	*litrpc.ListSessionEventsResponse, error) {
+
+	filter, err := auditEventFilter(
+		req.LocalPublicKey, req.StartTimestampSeconds,
+		req.EndTimestampSeconds,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.db.ListAuditEvents(filter)
+	if err != nil {
+		return nil, fmt.Errorf("error listing session events: %v", err)
+	}
+
+	resp := &litrpc.ListSessionEventsResponse{
+		Events: make([]*litrpc.SessionEvent, len(events)),
+	}
+	for idx, event := range events {
+		rpcType, err := marshalRPCEventType(event.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		pubKey := event.LocalPublicKey.SerializeCompressed()
+		resp.Events[idx] = &litrpc.SessionEvent{
+			Type:             rpcType,
+			LocalPublicKey:   pubKey,
+			TimestampSeconds: uint64(event.Timestamp.Unix()),
+		}
+	}
+
+	return resp, nil
+}
+
+// auditEventFilter returns the audit log filter for the given optional local
+// public key and time range. Unset values don't restrict the events.
+func auditEventFilter(localPublicKey []byte, startTimestamp,
+	endTimestamp uint64) (*session.AuditEventFilter, error) {
+
+	filter := &session.AuditEventFilter{}
+	if len(localPublicKey) != 0 {
+		pubKey, err := btcec.ParsePubKey(localPublicKey, btcec.S256())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"error parsing public key: %v", err)
+		}
+		filter.LocalPublicKey = pubKey
+	}
+	if startTimestamp != 0 {
+		filter.StartTime = time.Unix(int64(startTimestamp), 0)
+	}
+	if endTimestamp != 0 {
+		filter.EndTime = time.Unix(int64(endTimestamp), 0)
+	}
+
+	return filter, nil
+}
+
+// markActive records that the session with the given local public key was
+// started and will signal its shutdown over the given channel.
+func (s *sessionRpcServer) markActive(pubKey *btcec.PublicKey,
+	closedSub chan struct{}) {
+
+	s.activeSessionsMtx.Lock()
+	defer s.activeSessionsMtx.Unlock()
+
+	s.activeSessions[string(pubKey.SerializeCompressed())] = closedSub
+}
+
+// markInactive removes the session with the given local public key from the
+// set of active sessions. If the session was restarted in the meantime and is
+// now tracked with a different shutdown channel, it is left untouched.
+func (s *sessionRpcServer) markInactive(pubKey *btcec.PublicKey,
+	closedSub chan struct{}) {
+
+	s.activeSessionsMtx.Lock()
+	defer s.activeSessionsMtx.Unlock()
+
+	id := string(pubKey.SerializeCompressed())
+	if s.activeSessions[id] == closedSub {
+		delete(s.activeSessions, id)
+	}
+}
+
+// PauseAllSessions stops the mailbox connections of all running sessions
+// without revoking them. Paused sessions are put back into the created state so
+// they can be resumed with ResumeAllSessions.
+func (s *sessionRpcServer) PauseAllSessions(_ context.Context,
+	_ *litrpc.PauseAllSessionsRequest) (*litrpc.PauseAllSessionsResponse,
+	error) {
+
+	// Sessions that wait for their activation must not be started while
+	// all sessions are paused. ResumeAllSessions schedules them again.
+	s.cancelActivations()
+
+	s.activeSessionsMtx.Lock()
+	active := make(map[string]chan struct{}, len(s.activeSessions))
+	for id, closedSub := range s.activeSessions {
+		active[id] = closedSub
+	}
+	s.activeSessionsMtx.Unlock()
+
+	var numPaused uint32
+	for id, closedSub := range active {
+		pubKey, err := btcec.ParsePubKey([]byte(id), btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("error parsing public key: %v",
+				err)
+		}
+
+		if err := s.pauseSession(pubKey, closedSub); err != nil {
+			return nil, err
+		}
+
+		numPaused++
+	}
+
+	return &litrpc.PauseAllSessionsResponse{
+		NumPaused: numPaused,
+	}, nil
+}
+
+// pauseSession stops the mailbox connection of the running session with the
+// given local public key and the given shutdown channel without revoking it.
+// The session is put back into the created state so it can be resumed again.
+func (s *sessionRpcServer) pauseSession(pubKey *btcec.PublicKey,
+	closedSub chan struct{}) error {
+
+	// The session is marked inactive before it is stopped, so single-use
+	// sessions know they are only paused.
+	s.markInactive(pubKey, closedSub)
+	if err := s.sessionServer.StopSession(pubKey); err != nil {
+		log.Debugf("Error stopping session: %v", err)
+	}
+
+	err := s.db.UpdateSessionState(pubKey, session.StateCreated)
+	if err != nil {
+		return fmt.Errorf("error updating session state: %v", err)
+	}
+
+	return nil
+}
+
+// QuarantineSession cuts off the access of a session without revoking it. The
+// session's mailbox connection is stopped and it isn't resumed anymore, but it
+// is kept for inspection and can be restored with UnquarantineSession.
+func (s *sessionRpcServer) QuarantineSession(_ context.Context,
+	req *litrpc.QuarantineSessionRequest) (
+	*litrpc.QuarantineSessionResponse, error) {
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error "+
+			"parsing public key: %v", err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	switch sess.State {
+	case session.StateRevoked:
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is revoked")
+
+	case session.StateQuarantined:
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is already quarantined")
+	}
+
+	// The state is changed before the session is stopped, so it can't be
+	// resumed in the meantime.
+	err = s.db.UpdateSessionState(pubKey, session.StateQuarantined)
+	if err != nil {
+		return nil, fmt.Errorf("error updating session state: %v", err)
+	}
+
+	s.activeSessionsMtx.Lock()
+	closedSub, wasActive := s.activeSessions[string(
+		pubKey.SerializeCompressed(),
+	)]
+	s.activeSessionsMtx.Unlock()
+
+	// Just like for a paused session, the session is marked inactive
+	// before it is stopped, so single-use sessions aren't revoked.
+	if wasActive {
+		s.markInactive(pubKey, closedSub)
+		if err := s.sessionServer.StopSession(pubKey); err != nil {
+			log.Debugf("Error stopping session: %v", err)
+		}
+	}
+
+	sessionLogger(sess).Infof("Quarantined session")
+
+	return &litrpc.QuarantineSessionResponse{}, nil
+}
+
+// UnquarantineSession restores a quarantined session. It is put back into the
+// created state and resumed like any other session.
+func (s *sessionRpcServer) UnquarantineSession(_ context.Context,
+	req *litrpc.UnquarantineSessionRequest) (
+	*litrpc.UnquarantineSessionResponse, error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error "+
+			"parsing public key: %v", err)
+	}
+
+	err = s.db.SwapSessionState(
+		pubKey, session.StateQuarantined, session.StateCreated,
+	)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, session.ErrStateMismatch):
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is not quarantined")
+
+	case err != nil:
+		return nil, fmt.Errorf("error updating session state: %v", err)
+	}
+	s.recordAuditEvent(pubKey, session.AuditEventUnquarantined)
+
+	sess, err := s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+	sessionLogger(sess).Infof("Restored quarantined session")
+
+	if err := s.resumeSession(sess, s.cfg.StartTimeout); err != nil {
+		return nil, fmt.Errorf("error resuming session: %v", err)
+	}
+
+	return &litrpc.UnquarantineSessionResponse{}, nil
+}
+
+// ResetSession tears down the mailbox connection of a session that wasn't fully
+// paired yet and restarts it in the created state, so the pairing can be
+// retried with the same pairing secret.
+func (s *sessionRpcServer) ResetSession(_ context.Context,
+	req *litrpc.ResetSessionRequest) (*litrpc.ResetSessionResponse, error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error "+
+			"parsing public key: %v", err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	switch sess.State {
+	case session.StateCreated, session.StateStarting,
+		session.StatePendingApproval:
+
+	case session.StateInUse:
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is already paired")
+
+	default:
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is no longer usable")
+	}
+
+	s.activeSessionsMtx.Lock()
+	closedSub, wasActive := s.activeSessions[string(
+		pubKey.SerializeCompressed(),
+	)]
+	s.activeSessionsMtx.Unlock()
+
+	// Just like for a paused session, the session is marked inactive
+	// before it is stopped, so single-use sessions aren't revoked.
+	if wasActive {
+		s.markInactive(pubKey, closedSub)
+		if err := s.sessionServer.StopSession(pubKey); err != nil {
+			log.Debugf("Error stopping session: %v", err)
+		}
+	}
+
+	err = s.db.ResetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrStateMismatch):
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"can no longer be reset")
+
+	case err != nil:
+		return nil, fmt.Errorf("error resetting session: %v", err)
+	}
+
+	sess, err = s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+	sessionLogger(sess).Infof("Reset session for a new pairing attempt")
+
+	if err := s.resumeSession(sess, s.cfg.StartTimeout); err != nil {
+		return nil, fmt.Errorf("error resuming session: %v", err)
+	}
+
+	sess, err = s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	rpcSession, err := marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+
+	return &litrpc.ResetSessionResponse{
+		Session: rpcSession,
+	}, nil
+}
+
+// RestartSession stops the mailbox connection of a running session and starts
+// it again, for example to recover from a connection that got stuck. The
+// session keeps its keys, pairing secret and state. If it can't be started
+// again right away, a second attempt is made before an error is returned.
+func (s *sessionRpcServer) RestartSession(_ context.Context,
+	req *litrpc.RestartSessionRequest) (*litrpc.RestartSessionResponse,
+	error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error "+
+			"parsing public key: %v", err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	s.activeSessionsMtx.Lock()
+	closedSub, isActive := s.activeSessions[string(
+		pubKey.SerializeCompressed(),
+	)]
+	s.activeSessionsMtx.Unlock()
+
+	if !isActive {
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is not running")
+	}
+
+	// Just like for a paused session, the session is marked inactive
+	// before it is stopped, so single-use sessions aren't revoked. Its
+	// state is left untouched, so it is never lost, even if it can't be
+	// started again.
+	sessLog := sessionLogger(sess)
+	s.markInactive(pubKey, closedSub)
+	if err := s.sessionServer.StopSession(pubKey); err != nil {
+		sessLog.Debugf("Error stopping session: %v", err)
+	}
+
+	restart := func() error {
+		err := s.resumeSession(sess, s.cfg.StartTimeout)
+		if err != nil {
+			return err
+		}
+		if !s.isActive(pubKey) {
+			return fmt.Errorf("session wasn't started")
+		}
+
+		return nil
+	}
+	if err := restart(); err != nil {
+		sessLog.Warnf("Unable to restart session, retrying: %v", err)
+
+		sess, err = s.db.GetSession(pubKey)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching session: %v",
+				err)
+		}
+
+		if err := restart(); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "unable "+
+				"to restart session: %v", err)
+		}
+	}
+	sessLog.Infof("Restarted session")
+
+	sess, err = s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	rpcSession, err := marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+
+	return &litrpc.RestartSessionResponse{
+		Session: rpcSession,
+	}, nil
+}
+
+// ResumeAllSessions starts all non-revoked and non-expired sessions that aren't
+// currently running.
+func (s *sessionRpcServer) ResumeAllSessions(_ context.Context,
+	_ *litrpc.ResumeAllSessionsRequest) (*litrpc.ResumeAllSessionsResponse,
+	error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sessions: %v", err)
+	}
+
+	var numResumed uint32
+	for _, sess := range sessions {
+		if s.isActive(sess.LocalPublicKey) {
+			continue
+		}
+
+		err := s.resumeSession(sess, s.cfg.StartTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("error resuming session: %v",
+				err)
+		}
+
+		if s.isActive(sess.LocalPublicKey) {
+			numResumed++
+		}
+	}
+
+	return &litrpc.ResumeAllSessionsResponse{
+		NumResumed: numResumed,
+	}, nil
+}
+
+// ListSessions returns all sessions known to the session store that match the
+// filters of the request. The sessions are a consistent point-in-time view of
+// the store, even if sessions are added or revoked concurrently. The sessions
+// are returned in the latest version of the Session message the client
+// supports.
+func (s *sessionRpcServer) ListSessions(ctx context.Context,
+	req *litrpc.ListSessionsRequest) (*litrpc.ListSessionsResponse, error) {
+
+	sessions, err := s.filterSessions(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	apiVersion := negotiateSessionAPIVersion(req.ApiVersion)
+
+	// Marshaling includes deriving the mnemonic of each session, which we
+	// can skip entirely if only the number is requested.
+	if req.CountOnly {
+		return &litrpc.ListSessionsResponse{
+			Sessions:   []*litrpc.Session{},
+			Total:      uint32(len(sessions)),
+			ApiVersion: apiVersion,
+		}, nil
+	}
+
+	response := &litrpc.ListSessionsResponse{
+		Sessions:   make([]*litrpc.Session, 0, len(sessions)),
+		Total:      uint32(len(sessions)),
+		ApiVersion: apiVersion,
+	}
+	for _, sess := range sessions {
+		rpcSession, err := marshalRPCSession(sess)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling session: %v",
+				err)
+		}
+		rpcSession.IsRunning = s.isActive(sess.LocalPublicKey)
+		rpcSession.ActiveMailboxServerAddr =
+			s.sessionServer.ActiveServerAddr(sess.LocalPublicKey)
+
+		if req.IncludeRecipe && sess.Type != session.TypeUIPassword {
+			rpcSession.MacaroonRecipe = marshalRPCRecipe(
+				sess.MacaroonRecipe,
+			)
+		}
+		projectRPCSession(rpcSession, apiVersion)
+
+		response.Sessions = append(response.Sessions, rpcSession)
+	}
+
+	return response, nil
+}
+
+// ListFailedSessions returns all sessions whose last resume attempt failed,
+// for example because their macaroon couldn't be baked on startup, and all
+// stored session records that can't be used as a session at all.
+func (s *sessionRpcServer) ListFailedSessions(_ context.Context,
+	_ *litrpc.ListFailedSessionsRequest) (
+	*litrpc.ListFailedSessionsResponse, error) {
+
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sessions: %v", err)
+	}
+
+	resp := &litrpc.ListFailedSessionsResponse{}
+	for _, sess := range sessions {
+		if sess.LastResumeError == "" {
+			continue
+		}
+
+		rpcSession, err := marshalRPCSession(sess)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling session: %v",
+				err)
+		}
+		rpcSession.IsRunning = s.isActive(sess.LocalPublicKey)
+
+		resp.Sessions = append(resp.Sessions, rpcSession)
+	}
+
+	invalid, err := s.db.InvalidRecords()
+	if err != nil {
+		return nil, fmt.Errorf("error checking session records: %v",
+			err)
+	}
+
+	for _, record := range invalid {
+		resp.InvalidRecords = append(
+			resp.InvalidRecords, &litrpc.InvalidSessionRecord{
+				Key:    record.Key,
+				Reason: record.Reason,
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// checkSessionRecords logs all stored session records that can't be used as a
+// session. They aren't resumed, but can be inspected with ListFailedSessions.
+func (s *sessionRpcServer) checkSessionRecords() error {
+	invalid, err := s.db.InvalidRecords()
+	if err != nil {
+		return fmt.Errorf("error checking session records: %v", err)
+	}
+
+	for _, record := range invalid {
+		log.Errorf("Not resuming invalid session record %x: %v",
+			record.Key, record.Reason)
+	}
+
+	return nil
+}
+
+// filterSessions returns all sessions known to the session store that match the
+// filters of the given list request.
+func (s *sessionRpcServer) filterSessions(ctx context.Context,
+	req *litrpc.ListSessionsRequest) ([]*session.Session, error) {
+
+	owner := req.OwnerId
+	if req.MineOnly && owner == "" {
+		var err error
+		owner, err = callerIdentity(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if owner == "" {
+			return nil, status.Error(codes.InvalidArgument,
+				"unable to identify caller without a macaroon")
+		}
+	}
+
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sessions: %v", err)
+	}
+
+	matches := make([]*session.Session, 0, len(sessions))
+	for _, sess := range sessions {
+		if !matchesListFilter(req, sess) {
+			continue
+		}
+
+		if req.MineOnly && sess.Owner != owner {
+			continue
+		}
+
+		// A session is in use once a client completed the handshake
+		// with it. That state outlives the connection though, so
+		// sessions are only considered running if their mailbox
+		// connection is live too.
+		if req.RunningOnly && (!s.isActive(sess.LocalPublicKey) ||
+			sess.State != session.StateInUse) {
+
+			continue
+		}
+
+		matches = append(matches, sess)
+	}
+
+	return matches, nil
+}
+
+// SubscribeSessionStateChanges streams every session that is created, changes
+// its state or expires soon to the client. If requested, the current state of
+// all sessions is sent first, terminated by a marker update.
+func (s *sessionRpcServer) SubscribeSessionStateChanges(
+	req *litrpc.SubscribeSessionStateChangesRequest,
+	stream litrpc.Sessions_SubscribeSessionStateChangesServer) error {
+
+	// We subscribe before taking the snapshot, so no change that happens
+	// in between is lost. Such a change can then show up both in the
+	// snapshot and as a live update.
+	sub, err := s.db.SubscribeStateChanges()
+	if err != nil {
+		return fmt.Errorf("error subscribing to session state "+
+			"changes: %v", err)
+	}
+	defer sub.Cancel()
+
+	done := make(chan struct{})
+	defer close(done)
+	updates, overflow := s.bufferStateChanges(sub, done)
+
+	if req.IncludeSnapshot {
+		if err := s.sendStateSnapshot(stream); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case change := <-updates:
+			rpcUpdate, err := marshalRPCStateChange(change)
+			if err != nil {
+				return fmt.Errorf("error marshaling state "+
+					"change: %v", err)
+			}
+
+			if err := stream.Send(rpcUpdate); err != nil {
+				return err
+			}
+
+		case <-overflow:
+			return status.Errorf(codes.ResourceExhausted, "more "+
+				"than %d session state changes pending, "+
+				"subscriber too slow", s.cfg.SubscriberBuffer)
+
+		case <-sub.Quit():
+			return errors.New("session state subscription stopped")
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-s.quit:
+			return errors.New("session server shutting down")
+		}
+	}
+}
+
+// bufferStateChanges forwards the state changes of the given subscription to
+// the returned channel, which buffers up to the configured number of changes.
+// The subscription itself never blocks the publisher of the changes, but would
+// grow without bound if the subscriber doesn't keep up. Instead of dropping
+// changes, which would leave the subscriber with an inconsistent view of the
+// sessions, the returned overflow channel is closed once the buffer is full,
+// after which no more changes are forwarded. A buffer size of 0 disables the
+// limit. The forwarding stops once the done channel is closed.
+func (s *sessionRpcServer) bufferStateChanges(sub *subscribe.Client,
+	done <-chan struct{}) (<-chan *session.StateChange, <-chan struct{}) {
+
+	overflow := make(chan struct{})
+	updates := make(chan *session.StateChange, s.cfg.SubscriberBuffer)
+
+	forward := func(change *session.StateChange) bool {
+		if s.cfg.SubscriberBuffer == 0 {
+			select {
+			case updates <- change:
+				return true
+
+			case <-done:
+				return false
+			}
+		}
+
+		select {
+		case updates <- change:
+			return true
+
+		default:
+			close(overflow)
+			return false
+		}
+	}
+
+	go func() {
+		for {
+			select {
+			case update := <-sub.Updates():
+				change, ok := update.(*session.StateChange)
+				if !ok {
+					continue
+				}
+
+				if !forward(change) {
+					return
+				}
+
+			case <-sub.Quit():
+				return
+
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return updates, overflow
+}
+
+// sendStateSnapshot sends the current state of every session to the given
+// stream, followed by an update that marks the end of the snapshot.
+func (s *sessionRpcServer) sendStateSnapshot(
+	stream litrpc.Sessions_SubscribeSessionStateChangesServer) error {
+
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		return fmt.Errorf("error fetching sessions: %v", err)
+	}
+
+	for _, sess := range sessions {
+		rpcSession, err := marshalRPCSession(sess)
+		if err != nil {
+			return fmt.Errorf("error marshaling session: %v", err)
+		}
+		rpcSession.IsRunning = s.isActive(sess.LocalPublicKey)
+
+		err = stream.Send(&litrpc.SessionStateUpdate{
+			Session:       rpcSession,
+			PreviousState: rpcSession.SessionState,
+			Timestamp:     uint64(time.Now().Unix()),
+			Snapshot:      true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return stream.Send(&litrpc.SessionStateUpdate{
+		SnapshotDone: true,
+	})
+}
+
+// ListSessionsSummary returns a reduced view of all sessions that doesn't
+// contain any secret material, intended for monitoring consumers.
+func (s *sessionRpcServer) ListSessionsSummary(_ context.Context,
+	_ *litrpc.ListSessionsSummaryRequest) (
+	*litrpc.ListSessionsSummaryResponse, error) {
+
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sessions: %v", err)
+	}
+
+	response := &litrpc.ListSessionsSummaryResponse{
+		Sessions: make([]*litrpc.SessionSummary, 0, len(sessions)),
+	}
+	for _, sess := range sessions {
+		summary, err := marshalRPCSessionSummary(sess)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling session: %v",
+				err)
+		}
+
+		response.Sessions = append(response.Sessions, summary)
+	}
+
+	return response, nil
+}
+
+// callerIdentity returns an identifier of the macaroon the caller of an RPC
+// authenticated with. An empty identifier is returned if the call doesn't
+// carry a macaroon.
+func callerIdentity(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+
+	macHeader := md.Get(HeaderMacaroon)
+	if len(macHeader) != 1 {
+		return "", nil
+	}
+
+	mac, err := session.ParseMacaroon(macHeader[0])
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "error "+
+			"parsing macaroon: %v", err)
+	}
+
+	id := sha256.Sum256(mac.Id())
+	return hex.EncodeToString(id[:]), nil
+}
+
+// matchesListFilter returns true if the given session matches all filters set
+// in the list request. Unset filters match every session.
+func matchesListFilter(req *litrpc.ListSessionsRequest,
+	sess *session.Session) bool {
+
+	expiry := uint64(sess.Expiry.Unix())
+	if req.ExpiryAfter != 0 && expiry < req.ExpiryAfter {
+		return false
+	}
+	if req.ExpiryBefore != 0 && expiry > req.ExpiryBefore {
+		return false
+	}
+
+	// Both creation time bounds are inclusive. Sessions without a recorded
+	// creation time can't be placed in the window at all.
+	if req.CreatedAfter != 0 || req.CreatedBefore != 0 {
+		if sess.CreatedAt.IsZero() {
+			return false
+		}
+
+		createdAt := uint64(sess.CreatedAt.Unix())
+		if req.CreatedAfter != 0 && createdAt < req.CreatedAfter {
+			return false
+		}
+		if req.CreatedBefore != 0 && createdAt > req.CreatedBefore {
+			return false
+		}
+	}
+
+	if req.GroupId != "" && sess.GroupID != req.GroupId {
+		return false
+	}
+
+	for key, value := range req.Metadata {
+		if current, ok := sess.Metadata[key]; !ok || current != value {
+			return false
+		}
+	}
+
+	query := strings.ToLower(req.LabelQuery)
+	if !strings.Contains(strings.ToLower(sess.Label), query) {
+		return false
+	}
+
+	return true
+}
+
+// RevokeSession revokes a single session and also stops it if it is currently
+// active.
+func (s *sessionRpcServer) RevokeSession(ctx context.Context,
+	req *litrpc.RevokeSessionRequest) (*litrpc.RevokeSessionResponse, error) {
+
+	pubKey, err := s.resolveRevokeKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.confirmRevocation(pubKey, req.ConfirmationToken, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Soft {
+		if s.cfg.RestoreWindow == 0 {
+			return nil, status.Error(codes.FailedPrecondition,
+				"soft revocations are disabled")
+		}
+
+		restorableUntil, err := s.softRevokeSession(
+			ctx, pubKey, req.Reason,
+			time.Now().Add(s.cfg.RestoreWindow),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		resp := &litrpc.RevokeSessionResponse{}
+		if !restorableUntil.IsZero() {
+			resp.RestorableUntil = uint64(restorableUntil.Unix())
+		}
+
+		return resp, nil
+	}
+
+	if err := s.revokeSession(ctx, pubKey, req.Reason); err != nil {
+		return nil, err
+	}
+
+	return &litrpc.RevokeSessionResponse{}, nil
+}
+
+// revokeSession revokes the session with the given local public key for the
+// given reason and stops its mailbox connection if it is running.
+func (s *sessionRpcServer) revokeSession(ctx context.Context,
+	pubKey *btcec.PublicKey, reason string) error {
+
+	if err := s.db.RevokeSession(pubKey, reason); err != nil {
+		return fmt.Errorf("error revoking session: %v", err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	if err != nil {
+		return fmt.Errorf("error fetching session: %v", err)
+	}
+	sessLog := sessionLogger(sess)
+	sessLog.Infof("Revoked session")
+
+	s.stopRevokedSession(ctx, pubKey, sessLog)
+
+	return nil
+}
+
+// softRevokeSession revokes the session with the given local public key for
+// the given reason but keeps it restorable until the given time. Its mailbox
+// connection is stopped if it is running. The time until which the session is
+// actually restorable is returned, which is zero if it was revoked permanently
+// before.
+func (s *sessionRpcServer) softRevokeSession(ctx context.Context,
+	pubKey *btcec.PublicKey, reason string,
+	restorableUntil time.Time) (time.Time, error) {
+
+	err := s.db.SoftRevokeSession(pubKey, reason, restorableUntil)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error revoking session: %v",
+			err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error fetching session: %v",
+			err)
+	}
+	sessLog := sessionLogger(sess)
+	sessLog.Infof("Softly revoked session, restorable until %v",
+		sess.RestorableUntil)
+
+	s.stopRevokedSession(ctx, pubKey, sessLog)
+
+	return sess.RestorableUntil, nil
+}
+
+// RestoreSession restores a softly revoked session within its restore window
+// and resumes it.
+func (s *sessionRpcServer) RestoreSession(_ context.Context,
+	req *litrpc.RestoreSessionRequest) (*litrpc.RestoreSessionResponse,
+	error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error "+
+			"parsing public key: %v", err)
+	}
+
+	err = s.restoreSession(pubKey)
+	switch {
+	case status.Code(err) == codes.ResourceExhausted:
+		return nil, err
+
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case errors.Is(err, session.ErrStateMismatch):
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is not revoked")
+
+	case errors.Is(err, session.ErrNotRestorable),
+		errors.Is(err, session.ErrSessionExpired):
+
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error restoring session: %v", err)
+	}
+	s.recordAuditEvent(pubKey, session.AuditEventRestored)
+
+	sess, err := s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+	sessionLogger(sess).Infof("Restored softly revoked session")
+
+	if err := s.resumeSession(sess, s.cfg.StartTimeout); err != nil {
+		return nil, fmt.Errorf("error resuming session: %v", err)
+	}
+
+	return &litrpc.RestoreSessionResponse{}, nil
+}
+
+// restoreSession moves a softly revoked session back to its previous state,
+// unless that would exceed the limit of active sessions. The limit is checked
+// while holding the store mutex, so a concurrently added session can't push
+// the number of active sessions over the limit.
+func (s *sessionRpcServer) restoreSession(pubKey *btcec.PublicKey) error {
+	s.storeMtx.Lock()
+	defer s.storeMtx.Unlock()
+
+	if err := s.checkActiveSessionLimit(); err != nil {
+		return err
+	}
+
+	return s.db.RestoreSession(pubKey)
+}
+
+// RevokeExpiredSessions revokes all sessions whose expiry lies in the past but
+// that aren't revoked yet, for example sessions that were only marked as
+// expired on startup. Running sessions are stopped as well.
+func (s *sessionRpcServer) RevokeExpiredSessions(ctx context.Context,
+	_ *litrpc.RevokeExpiredSessionsRequest) (
+	*litrpc.RevokeExpiredSessionsResponse, error) {
+
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sessions: %v", err)
+	}
+
+	now := time.Now()
+	var expired []*session.Session
+	for _, sess := range sessions {
+		if sess.State == session.StateRevoked ||
+			!sess.Expiry.Before(now) {
+
+			continue
+		}
+
+		expired = append(expired, sess)
+	}
+
+	if len(expired) == 0 {
+		return &litrpc.RevokeExpiredSessionsResponse{}, nil
+	}
+
+	// Many sessions often expire together, so they are all revoked in a
+	// single transaction instead of one write per session. The store
+	// checks their expiry again, since a session could have been extended
+	// or revoked since it was listed.
+	pubKeys := make([]*btcec.PublicKey, len(expired))
+	for i, sess := range expired {
+		pubKeys[i] = sess.LocalPublicKey
+	}
+	revoked, err := s.db.RevokeExpiredSessions(
+		pubKeys, revokeReasonExpired, now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error revoking sessions: %v", err)
+	}
+	log.Debugf("Revoked %d expired sessions in a single transaction",
+		len(revoked))
+
+	for _, sess := range revoked {
+		sessLog := sessionLogger(sess)
+		sessLog.Infof("Revoked session")
+
+		s.stopRevokedSession(ctx, sess.LocalPublicKey, sessLog)
+	}
+
+	return &litrpc.RevokeExpiredSessionsResponse{
+		NumRevoked: uint32(len(revoked)),
+	}, nil
+}
+
+// RevokeSessionGroup revokes all sessions of the given group that aren't
+// revoked yet. Running sessions are stopped as well.
+func (s *sessionRpcServer) RevokeSessionGroup(ctx context.Context,
+	req *litrpc.RevokeSessionGroupRequest) (
+	*litrpc.RevokeSessionGroupResponse, error) {
+
+	if req.GroupId == "" {
+		return nil, status.Error(codes.InvalidArgument, "a group ID "+
+			"is required")
+	}
+
+	sessions, err := s.db.ListGroupSessions(req.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sessions: %v", err)
+	}
+
+	var numRevoked uint32
+	for _, sess := range sessions {
+		if sess.State == session.StateRevoked {
+			continue
+		}
+
+		err := s.revokeSession(ctx, sess.LocalPublicKey, req.Reason)
+		if err != nil {
+			return nil, err
+		}
+
+		numRevoked++
+	}
+
+	return &litrpc.RevokeSessionGroupResponse{
+		NumRevoked: numRevoked,
+	}, nil
+}
+
+// ImportMacaroonAsSession creates and starts a custom session whose macaroon
+// has the same permissions and caveats as the given lnd macaroon. The session's
+// macaroon is baked under a new root key, so revoking it doesn't affect the
+// imported macaroon or vice versa.
+func (s *sessionRpcServer) ImportMacaroonAsSession(ctx context.Context,
+	req *litrpc.ImportMacaroonAsSessionRequest) (
+	*litrpc.ImportMacaroonAsSessionResponse, error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	mac, err := session.ParseMacaroon(req.Macaroon)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error "+
+			"parsing macaroon: %v", err)
+	}
+
+	recipe, err := session.RecipeFromMacaroon(mac)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to "+
+			"import macaroon: %v", err)
+	}
+
+	recipe.Permissions = dedupPermissions(recipe.Permissions)
+
+	expiry := time.Unix(int64(req.ExpiryTimestampSeconds), 0)
+	sess, err := session.NewSession(
+		req.Label, session.TypeMacaroonCustom, expiry,
+		req.MailboxServerAddr, req.DevServer, recipe.Permissions,
+		recipe.Caveats,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating new session: %v", err)
+	}
+
+	releaseLabel, err := s.applyAutoLabel(sess)
+	if err != nil {
+		return nil, err
+	}
+	defer releaseLabel()
+
+	sess.Owner, err = callerIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The imported session has to pass the same checks as one that is
+	// added.
+	addReq, err := addSessionRequest(sess)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validateNewSession(
+		ctx, addReq, session.TypeMacaroonCustom, recipe.Permissions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.storeAndStartSession(sess, s.cfg.StartTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSession, err := marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+
+	return &litrpc.ImportMacaroonAsSessionResponse{
+		Session: rpcSession,
+	}, nil
+}
+
+// stopRevokedSession stops the mailbox connection of a revoked session. As the
+// connection would otherwise linger, stopping a running session is retried a
+// few times with a jittered backoff, as long as the context isn't canceled.
+// The session is already revoked, so failures are only logged.
+func (s *sessionRpcServer) stopRevokedSession(ctx context.Context,
+	pubKey *btcec.PublicKey, sessLog btclog.Logger) {
+
+	backoff := stopSessionBackoff
+	for attempt := 0; ; attempt++ {
+		err := s.sessionServer.StopSession(pubKey)
+		if err == nil {
+			return
+		}
+
+		// If the session expired already it might not be running
+		// anymore, in which case there is nothing to retry.
+		if !s.isActive(pubKey) {
+			sessLog.Debugf("Error stopping session: %v", err)
+			return
+		}
+
+		if attempt == stopSessionRetries {
+			sessLog.Warnf("Unable to stop session after %d "+
+				"attempts: %v", attempt+1, err)
+			return
+		}
+
+		sessLog.Debugf("Error stopping session, retrying: %v", err)
+
+		jitter := time.Duration(rand.Int63n(int64(backoff)/2 + 1))
+		select {
+		case <-time.After(backoff + jitter):
+		case <-ctx.Done():
+			sessLog.Warnf("Unable to stop session before the "+
+				"request ended: %v", err)
+			return
+		}
+
+		backoff *= 2
+	}
+}
+
+// ReplaceSession creates and starts a new session and only revokes the given
+// old session once the new one was started successfully. If the new session
+// can't be created, the old session is left untouched.
+func (s *sessionRpcServer) ReplaceSession(ctx context.Context,
+	req *litrpc.ReplaceSessionRequest) (*litrpc.ReplaceSessionResponse,
+	error) {
+
+	if req.NewSession == nil {
+		return nil, status.Error(codes.InvalidArgument, "new_session "+
+			"must be set")
+	}
+
+	oldKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %v", err)
+	}
+
+	oldSess, err := s.db.GetSession(oldKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+	if oldSess.State == session.StateRevoked {
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"to replace is already revoked")
+	}
+
+	if req.TransferLabel {
+		return s.replaceSessionAtomically(ctx, oldSess, req.NewSession)
+	}
+
+	resp, err := s.AddSession(ctx, req.NewSession)
+	if err != nil {
+		return nil, fmt.Errorf("error adding new session: %v", err)
+	}
+
+	err = s.revokeSession(ctx, oldKey, revokeReasonReplaced)
+	if err != nil {
+		// We don't want to end up with two valid sessions, so we undo
+		// the creation of the new one.
+		newKey, undoErr := btcec.ParsePubKey(
+			resp.Session.LocalPublicKey, btcec.S256(),
+		)
+		if undoErr == nil {
+			undoErr = s.revokeSession(
+				ctx, newKey, revokeReasonReplaceFailed,
+			)
+		}
+		if undoErr != nil {
+			log.Errorf("Unable to revoke replacement session: %v",
+				undoErr)
+		}
+
+		return nil, fmt.Errorf("error revoking old session: %v", err)
+	}
+
+	return &litrpc.ReplaceSessionResponse{
+		Session:           resp.Session,
+		OldSessionRevoked: true,
+	}, nil
+}
+
+// replaceSessionAtomically creates the new session under the label of the old
+// session and revokes the old session in the same transaction, so the label is
+// handed over without a gap. The old session keeps running until the new one
+// was started. If the new session fails to start, the old session is moved back
+// to its previous state and the new one is revoked.
+func (s *sessionRpcServer) replaceSessionAtomically(ctx context.Context,
+	oldSess *session.Session, newReq *litrpc.AddSessionRequest) (
+	*litrpc.ReplaceSessionResponse, error) {
+
+	if newReq.Label != "" && newReq.Label != oldSess.Label {
+		return nil, status.Error(codes.InvalidArgument, "the label "+
+			"of the new session must be empty or match the label "+
+			"of the old session when transferring the label")
+	}
+
+	newReq = proto.Clone(newReq).(*litrpc.AddSessionRequest)
+	newReq.Label = oldSess.Label
+
+	resp, err := s.addSession(ctx, newReq, oldSess.LocalPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error adding new session: %v", err)
+	}
+
+	return &litrpc.ReplaceSessionResponse{
+		Session:           resp.Session,
+		OldSessionRevoked: true,
+	}, nil
+}
+
+// parseCompressedPubKey parses the public key of the given request field, which
+// must be in the 33 byte compressed format. Malformed keys are rejected with an
+// InvalidArgument error that names the field. All parsing goes through btcec
+// here, so a change of its API only affects this function.
+func parseCompressedPubKey(field string, key []byte) (*btcec.PublicKey,
+	error) {
+
+	if len(key) != btcec.PubKeyBytesLenCompressed {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s: "+
+			"expected a %d byte compressed public key, got %d "+
+			"bytes", field, btcec.PubKeyBytesLenCompressed,
+			len(key))
+	}
+
+	pubKey, err := btcec.ParsePubKey(key, btcec.S256())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s: "+
+			"%v", field, err)
+	}
+
+	return pubKey, nil
+}
+
+// resolveRevokeKey returns the local public key of the session a revoke
+// request refers to. The session can either be identified by its local public
+// key directly or by its short ID.
+func (s *sessionRpcServer) resolveRevokeKey(
+	req *litrpc.RevokeSessionRequest) (*btcec.PublicKey, error) {
+
+	var numKeys int
+	for _, isSet := range []bool{
+		len(req.LocalPublicKey) > 0, req.SessionId != "",
+	} {
+		if isSet {
+			numKeys++
+		}
+	}
+
+	switch {
+	case numKeys > 1:
+		return nil, status.Error(codes.InvalidArgument, "only one of "+
+			"local_public_key and session_id can be set")
+
+	case req.SessionId != "":
+		return s.resolveSessionID(req.SessionId)
+
+	case len(req.LocalPublicKey) > 0:
+		return parseCompressedPubKey(
+			"local_public_key", req.LocalPublicKey,
+		)
+
+	default:
+		return nil, status.Error(codes.InvalidArgument, "one of "+
+			"local_public_key and session_id must be set")
+	}
+}
+
+// resolveSessionID returns the local public key of the session with the given
+// short ID. If more than one session has the ID, the session can only be
+// identified by its local public key.
+func (s *sessionRpcServer) resolveSessionID(id string) (*btcec.PublicKey,
+	error) {
+
+	sess, err := s.db.GetSessionByShortID(id)
+	switch {
+	case errors.Is(err, session.ErrMultipleSessions):
+		return nil, status.Error(codes.FailedPrecondition, "multiple "+
+			"sessions have the session ID, use local_public_key "+
+			"to select the session")
+
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, "no session with "+
+			"the session ID found")
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	return sess.LocalPublicKey, nil
+}
+
+// UpdateSessionDescription updates the free-text description of a session.
+func (s *sessionRpcServer) UpdateSessionDescription(_ context.Context,
+	req *litrpc.UpdateSessionDescriptionRequest) (
+	*litrpc.UpdateSessionDescriptionResponse, error) {
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %v", err)
+	}
+
+	err = validateLength(
+		"description", req.Description, s.cfg.MaxDescriptionLength,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.UpdateSessionDescription(pubKey, req.Description)
+	if err != nil {
+		return nil, fmt.Errorf("error updating session description: %v",
+			err)
+	}
+
+	return &litrpc.UpdateSessionDescriptionResponse{}, nil
+}
+
+// MigrateSessionMailbox moves a session to a new mailbox server without
+// changing its local key or pairing secret. If the session is running, its
+// mailbox connection is torn down and restarted on the new server.
+//
+// NOTE: The remote only knows the mailbox server it paired with, so it must be
+// told about the new address out of band, for example with a new connect URI,
+// before it can reach the session again. It doesn't need to pair again.
+func (s *sessionRpcServer) MigrateSessionMailbox(_ context.Context,
+	req *litrpc.MigrateSessionMailboxRequest) (
+	*litrpc.MigrateSessionMailboxResponse, error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error "+
+			"parsing public key: %v", err)
+	}
+
+	if req.MailboxServerAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "a mailbox "+
+			"server address is required")
+	}
+
+	if err := s.validateDevServer(req.DevServer); err != nil {
+		return nil, err
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	if sess.State == session.StateRevoked ||
+		sess.State == session.StateExpired {
+
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is revoked or expired")
+	}
+
+	s.activeSessionsMtx.Lock()
+	closedSub, wasActive := s.activeSessions[string(
+		pubKey.SerializeCompressed(),
+	)]
+	s.activeSessionsMtx.Unlock()
+
+	if wasActive {
+		if err := s.pauseSession(pubKey, closedSub); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.db.UpdateSessionMailbox(
+		pubKey, req.MailboxServerAddr, req.DevServer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error updating session mailbox: %v",
+			err)
+	}
+
+	sess, err = s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+	sessionLogger(sess).Infof("Migrated session to mailbox server %s",
+		sess.ServerAddr)
+
+	if wasActive {
+		err := s.resumeSession(sess, s.cfg.StartTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("error restarting session: %v",
+				err)
+		}
+	}
+
+	rpcSession, err := marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+
+	return &litrpc.MigrateSessionMailboxResponse{
+		Session: rpcSession,
+	}, nil
+}
+
+// CompactDB compacts the session database to reclaim the space freed by
+// previous updates.
+func (s *sessionRpcServer) CompactDB(_ context.Context,
+	_ *litrpc.CompactDBRequest) (*litrpc.CompactDBResponse, error) {
+
+	sizeBefore, sizeAfter, err := s.db.Compact()
+	if err == session.ErrCompactionInProgress {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error compacting session DB: %v", err)
+	}
+
+	log.Infof("Compacted session DB from %d to %d bytes", sizeBefore,
+		sizeAfter)
+
+	return &litrpc.CompactDBResponse{
+		SizeBefore: uint64(sizeBefore),
+		SizeAfter:  uint64(sizeAfter),
+	}, nil
+}
+
+// RevealPairingSecret returns the pairing secret of a session. This is the only
+// way to retrieve the secret of a session that was created with a suppressed
+// pairing secret.
+func (s *sessionRpcServer) RevealPairingSecret(_ context.Context,
+	req *litrpc.RevealPairingSecretRequest) (
+	*litrpc.RevealPairingSecretResponse, error) {
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %v", err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	mnemonic, _ := pairingSecretMnemonic(sess)
+
+	return &litrpc.RevealPairingSecretResponse{
+		PairingSecret:         sess.PairingSecret[:],
+		PairingSecretMnemonic: mnemonic,
+	}, nil
+}
+
+// GetSessionMnemonic returns the pairing mnemonic of a session that wasn't
+// paired yet, so a UI can display it again without fetching the whole session.
+// The mnemonic of a session with a suppressed pairing secret can only be
+// retrieved through RevealPairingSecret.
+func (s *sessionRpcServer) GetSessionMnemonic(_ context.Context,
+	req *litrpc.GetSessionMnemonicRequest) (
+	*litrpc.GetSessionMnemonicResponse, error) {
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error "+
+			"parsing public key: %v", err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	switch {
+	case sess.State == session.StateInUse:
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is already paired")
+
+	case sess.SuppressPairingSecret:
+		return nil, status.Error(codes.FailedPrecondition, "pairing "+
+			"secret of session is suppressed")
+	}
+
+	mnemonic, err := deriveMnemonic(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error deriving mnemonic: %v", err)
+	}
+
+	resp := &litrpc.GetSessionMnemonicResponse{
+		PairingSecretMnemonic: mnemonic,
+	}
+	if req.IncludePairingSecret {
+		resp.PairingSecret = sess.PairingSecret[:]
+	}
+
+	return resp, nil
+}
+
+// GetSession returns the session with the given local public key or short ID in
+// the latest version of the Session message the client supports. If requested,
+// the details of the live mailbox connection are included for running
+// sessions.
+func (s *sessionRpcServer) GetSession(_ context.Context,
+	req *litrpc.GetSessionRequest) (*litrpc.GetSessionResponse, error) {
+
+	var (
+		pubKey *btcec.PublicKey
+		err    error
+	)
+	switch {
+	case len(req.LocalPublicKey) > 0 && req.SessionId != "":
+		return nil, status.Error(codes.InvalidArgument, "only one of "+
+			"local_public_key and session_id can be set")
+
+	case req.SessionId != "":
+		pubKey, err = s.resolveSessionID(req.SessionId)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		pubKey, err = btcec.ParsePubKey(
+			req.LocalPublicKey, btcec.S256(),
+		)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"error parsing public key: %v", err)
+		}
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	rpcSession, err := marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+	rpcSession.IsRunning = s.isActive(pubKey)
+	rpcSession.ActiveMailboxServerAddr =
+		s.sessionServer.ActiveServerAddr(pubKey)
+
+	isRunning := rpcSession.IsRunning
+
+	apiVersion := negotiateSessionAPIVersion(req.ApiVersion)
+	projectRPCSession(rpcSession, apiVersion)
+
+	resp := &litrpc.GetSessionResponse{
+		Session:    rpcSession,
+		ApiVersion: apiVersion,
+	}
+	if !req.IncludeConnectionInfo || !isRunning {
+		return resp, nil
+	}
+
+	info := s.sessionServer.ConnectionInfo(pubKey)
+	if info != nil {
+		resp.ConnectionInfo = &litrpc.SessionConnectionInfo{
+			RemoteAddr: info.RemoteAddr,
+			Healthy:    info.Healthy,
+		}
+		if !info.ConnectedSince.IsZero() {
+			resp.ConnectionInfo.ConnectedSince = uint64(
+				info.ConnectedSince.Unix(),
+			)
+		}
+	}
+
+	return resp, nil
+}
+
+// RenewSessions extends the expiry of all non-revoked sessions that match the
+// given filter. Each session is renewed on its own, so a session that can't be
+// renewed doesn't prevent the others from being renewed.
+func (s *sessionRpcServer) RenewSessions(ctx context.Context,
+	req *litrpc.RenewSessionsRequest) (*litrpc.RenewSessionsResponse,
+	error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	if (req.NewExpiryTimestampSeconds == 0) == (req.ExtensionSeconds == 0) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one "+
+			"of new_expiry_timestamp_seconds and "+
+			"extension_seconds must be set")
+	}
+
+	filter := req.Filter
+	if filter == nil {
+		filter = &litrpc.ListSessionsRequest{}
+	}
+
+	sessions, err := s.filterSessions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &litrpc.RenewSessionsResponse{}
+	for _, sess := range sessions {
+		if sess.State == session.StateRevoked {
+			continue
+		}
+
+		expiry := time.Unix(int64(req.NewExpiryTimestampSeconds), 0)
+		if req.ExtensionSeconds != 0 {
+			extension := time.Duration(req.ExtensionSeconds) *
+				time.Second
+			expiry = sess.Expiry.Add(extension)
+		}
+
+		pubKey := sess.LocalPublicKey.SerializeCompressed()
+		result := &litrpc.RenewSessionResult{
+			LocalPublicKey: pubKey,
+			Label:          sess.Label,
+		}
+		resp.Results = append(resp.Results, result)
+
+		if err := s.renewSessionExpiry(sess, expiry); err != nil {
+			expiry = sess.Expiry
+			result.Error = err.Error()
+		}
+
+		result.ExpiryTimestampSeconds = uint64(expiry.Unix())
+	}
+
+	return resp, nil
+}
+
+// renewSessionExpiry moves the expiry of the given session to the given later
+// time, which must lie within the configured session duration bounds. A
+// session that was already marked as expired is resumed again.
+func (s *sessionRpcServer) renewSessionExpiry(sess *session.Session,
+	expiry time.Time) error {
+
+	now := time.Now()
+	if !expiry.After(now) {
+		return status.Error(codes.InvalidArgument, "expiry must be in "+
+			"the future")
+	}
+
+	if !expiry.After(sess.Expiry) {
+		return status.Error(codes.InvalidArgument, "new expiry must "+
+			"be after the current expiry")
+	}
+
+	if err := s.validateExpiry(now, expiry); err != nil {
+		return err
+	}
+
+	if err := validateHardDeadline(expiry, sess.HardDeadline); err != nil {
+		return err
+	}
+
+	pubKey := sess.LocalPublicKey
+	if err := s.db.UpdateSessionExpiry(pubKey, expiry); err != nil {
+		return fmt.Errorf("error updating session expiry: %v", err)
+	}
+
+	if sess.State != session.StateExpired {
+		return nil
+	}
+
+	err := s.db.SwapSessionState(
+		pubKey, session.StateExpired, session.StateCreated,
+	)
+	if err != nil {
+		return fmt.Errorf("error restoring expired session: %v", err)
+	}
+
+	renewed, err := s.db.GetSession(pubKey)
+	if err != nil {
+		return fmt.Errorf("error fetching session: %v", err)
+	}
+
+	return s.resumeSession(renewed, s.cfg.StartTimeout)
+}
+
+// GetSessionConnectURI returns a URI that encodes everything a client needs to
+// pair with the session. The pairing secret is only included on request and
+// never for sessions that suppress it.
+func (s *sessionRpcServer) GetSessionConnectURI(_ context.Context,
+	req *litrpc.GetSessionConnectURIRequest) (
+	*litrpc.GetSessionConnectURIResponse, error) {
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error "+
+			"parsing public key: %v", err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	if req.IncludePairingSecret && sess.SuppressPairingSecret {
+		return nil, status.Error(codes.FailedPrecondition, "pairing "+
+			"secret of session is suppressed")
+	}
+
+	uri := sess.ConnectURI(req.IncludePairingSecret)
+	return &litrpc.GetSessionConnectURIResponse{
+		Uri: uri.String(),
+	}, nil
+}
+
+// GetSessionPairingQR returns the payload of the QR code a client scans to pair
+// with the session. It is the session's connect URI including the pairing
+// secret, so all clients render the same QR code.
+func (s *sessionRpcServer) GetSessionPairingQR(_ context.Context,
+	req *litrpc.GetSessionPairingQRRequest) (
+	*litrpc.GetSessionPairingQRResponse, error) {
+
+	pubKey, err := parseCompressedPubKey(
+		"local_public_key", req.LocalPublicKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	switch {
+	case sess.State == session.StateRevoked:
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is revoked")
+
+	case sess.State == session.StateInUse && !req.IncludePaired:
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is already paired")
+
+	case sess.SuppressPairingSecret:
+		return nil, status.Error(codes.FailedPrecondition, "pairing "+
+			"secret of session is suppressed")
+	}
+
+	return &litrpc.GetSessionPairingQRResponse{
+		Payload: sess.ConnectURI(true).String(),
+	}, nil
+}
+
+// GetServerStatus returns internal counters of the session server that help to
+// detect leaked session goroutines and sessions whose pairing mnemonic can't be
+// derived. The number of live session goroutines should match the number of
+// running sessions.
+func (s *sessionRpcServer) GetServerStatus(_ context.Context,
+	_ *litrpc.GetServerStatusRequest) (*litrpc.GetServerStatusResponse,
+	error) {
+
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sessions: %v", err)
+	}
+
+	var numDBActive uint32
+	for _, sess := range sessions {
+		if isActiveState(sess.State) {
+			numDBActive++
+		}
+	}
+
+	s.activeSessionsMtx.Lock()
+	numRunning := uint32(len(s.activeSessions))
+	s.activeSessionsMtx.Unlock()
+
+	numGoroutines := atomic.LoadInt64(&s.numSessionGoroutines)
+	numWaitGroup := atomic.LoadInt64(&s.numWaitGroupGoroutines)
+
+	return &litrpc.GetServerStatusResponse{
+		SessionGoroutines:   uint32(numGoroutines),
+		WaitGroupGoroutines: uint32(numWaitGroup),
+		RunningSessions:     numRunning,
+		DbActiveSessions:    numDBActive,
+		MnemonicFailures:    atomic.LoadUint64(&numMnemonicFailures),
+	}, nil
+}
+
+// GetMailboxServerStats returns how the sessions are distributed across the
+// mailbox servers they use, together with the number of sessions per server
+// that are currently running and connected to a client.
+func (s *sessionRpcServer) GetMailboxServerStats(_ context.Context,
+	_ *litrpc.GetMailboxServerStatsRequest) (
+	*litrpc.GetMailboxServerStatsResponse, error) {
+
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sessions: %v", err)
+	}
+
+	stats := make(map[string]*litrpc.MailboxServerStats)
+	for _, sess := range sessions {
+		serverStats, ok := stats[sess.ServerAddr]
+		if !ok {
+			serverStats = &litrpc.MailboxServerStats{
+				ServerAddr: sess.ServerAddr,
+			}
+			stats[sess.ServerAddr] = serverStats
+		}
+
+		serverStats.TotalSessions++
+
+		if !s.isActive(sess.LocalPublicKey) {
+			continue
+		}
+		serverStats.RunningSessions++
+
+		if s.hasClients(sess.LocalPublicKey) {
+			serverStats.ConnectedSessions++
+		}
+	}
+
+	resp := &litrpc.GetMailboxServerStatsResponse{}
+	for _, serverStats := range stats {
+		resp.Servers = append(resp.Servers, serverStats)
+	}
+	sort.Slice(resp.Servers, func(i, j int) bool {
+		return resp.Servers[i].ServerAddr < resp.Servers[j].ServerAddr
+	})
+
+	return resp, nil
+}
+
+// hasClients returns true if at least one client is connected to the running
+// session with the given local public key.
+func (s *sessionRpcServer) hasClients(pubKey *btcec.PublicKey) bool {
+	idle := s.sessionServer.Idle(pubKey)
+	if idle == nil {
+		return false
+	}
+
+	select {
+	case <-idle:
+		return false
+
+	default:
+		return true
+	}
+}
+
+// RefreshSessionMacaroon bakes a new macaroon for a running macaroon session,
+// for example after the root key of the old one was rotated. The new macaroon
+// is handed out to clients from the next handshake on, the mailbox connection
+// of the session is kept running.
+func (s *sessionRpcServer) RefreshSessionMacaroon(_ context.Context,
+	req *litrpc.RefreshSessionMacaroonRequest) (
+	*litrpc.RefreshSessionMacaroonResponse, error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error "+
+			"parsing public key: %v", err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	if sess.Type == session.TypeUIPassword {
+		return nil, status.Error(codes.InvalidArgument, "session "+
+			"doesn't use a macaroon")
+	}
+
+	if !s.isActive(pubKey) {
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is not running")
+	}
+
+	if sess.RequireApproval {
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"wasn't approved yet")
+	}
+
+	authData, err := s.sessionAuthData(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error baking macaroon: %v", err)
+	}
+
+	err = s.sessionServer.UpdateAuthData(pubKey, authData)
+	if err != nil {
+		return nil, fmt.Errorf("error updating session macaroon: %v",
+			err)
+	}
+
+	return &litrpc.RefreshSessionMacaroonResponse{}, nil
+}
+
+// AddSessionCaveat restricts the macaroon of an existing session by adding a
+// caveat to its recipe. If the session is running, a new macaroon is baked
+// right away and handed out from the next handshake on. Caveats can only be
+// added, so a session can't gain permissions this way.
+func (s *sessionRpcServer) AddSessionCaveat(_ context.Context,
+	req *litrpc.AddSessionCaveatRequest) (*litrpc.AddSessionCaveatResponse,
+	error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := parseCompressedPubKey(
+		"local_public_key", req.LocalPublicKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := checkers.ParseCaveat(req.Caveat); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"caveat: %v", err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	if sess.Type == session.TypeUIPassword {
+		return nil, status.Error(codes.InvalidArgument, "session "+
+			"doesn't use a macaroon")
+	}
+
+	if sess.State == session.StateRevoked {
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is revoked")
+	}
+
+	if err := s.checkMacaroonBaker(sess.Type); err != nil {
+		return nil, err
+	}
+
+	err = s.db.AddSessionCaveat(pubKey, macaroon.Caveat{
+		Id: []byte(req.Caveat),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error adding caveat: %v", err)
+	}
+
+	sess, err = s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+	sessionLogger(sess).Infof("Added caveat %q", req.Caveat)
+
+	// A session that wasn't approved yet only bakes its macaroon once it
+	// is, it then picks up the new caveat by itself.
+	if s.isActive(pubKey) && !sess.RequireApproval {
+		authData, err := s.sessionAuthData(sess)
+		if err != nil {
+			return nil, fmt.Errorf("error baking macaroon: %v", err)
+		}
+
+		err = s.sessionServer.UpdateAuthData(pubKey, authData)
+		if err != nil {
+			return nil, fmt.Errorf("error updating session "+
+				"macaroon: %v", err)
+		}
+	}
+
+	rpcSession, err := marshalRPCSession(sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+	rpcSession.IsRunning = s.isActive(pubKey)
+	rpcSession.MacaroonRecipe = marshalRPCRecipe(sess.MacaroonRecipe)
+
+	return &litrpc.AddSessionCaveatResponse{
+		Session: rpcSession,
+	}, nil
+}
+
+// isChangeableSessionType returns true if sessions of the given type can change
+// their type. Only admin and readonly sessions can be changed into each
+// other, since UI password sessions don't use a macaroon and account and custom
+// sessions are bound to the restriction they were created with.
+func isChangeableSessionType(typ session.Type) bool {
+	return typ == session.TypeMacaroonAdmin ||
+		typ == session.TypeMacaroonReadonly
+}
+
+// ChangeSessionType changes the type of an existing session, for example to
+// promote a readonly session to an admin session, without pairing it again. If
+// the session is running, a macaroon with the permissions of the new type is
+// baked right away and handed out from the next handshake on.
+func (s *sessionRpcServer) ChangeSessionType(_ context.Context,
+	req *litrpc.ChangeSessionTypeRequest) (
+	*litrpc.ChangeSessionTypeResponse, error) {
+
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
+
+	pubKey, err := parseCompressedPubKey(
+		"local_public_key", req.LocalPublicKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	typ, err := unmarshalRPCType(req.SessionType)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"session type: %v", err)
+	}
+
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	if sess.Type == session.TypeUIPassword ||
+		typ == session.TypeUIPassword {
+
+		return nil, status.Error(codes.InvalidArgument, "UI password "+
+			"sessions don't use a macaroon, so sessions can't be "+
+			"changed from or to this type")
+	}
+
+	if !isChangeableSessionType(sess.Type) ||
+		!isChangeableSessionType(typ) {
 
-	expiry := time.Unix(int64(req.ExpiryTimestampSeconds), 0)
-	if time.Now().After(expiry) {
-		return nil, fmt.Errorf("expiry must be in the future")
+		return nil, status.Error(codes.InvalidArgument, "only admin "+
+			"and readonly sessions can be changed into each other")
 	}
 
-	typ, err := unmarshalRPCType(req.SessionType)
-	if err != nil {
+	if sess.State == session.StateRevoked {
+		return nil, status.Error(codes.FailedPrecondition, "session "+
+			"is revoked")
+	}
+
+	if err := s.checkMacaroonBaker(typ); err != nil {
 		return nil, err
 	}
 
-	if typ != session.TypeUIPassword && typ != session.TypeMacaroonAdmin &&
-		typ != session.TypeMacaroonReadonly {
+	if typ != sess.Type {
+		// The new macaroon is baked before the change is persisted,
+		// so a running session never ends up without one. A session
+		// that wasn't approved yet only bakes its macaroon once it is,
+		// it then picks up the new type by itself.
+		var authData []byte
+		if s.isActive(pubKey) && !sess.RequireApproval {
+			changed := *sess
+			changed.Type = typ
 
-		return nil, fmt.Errorf("invalid session type, only UI " +
-			"password, admin and readonly macaroon types " +
-			"supported in LiT")
-	}
+			authData, err = s.sessionAuthData(&changed)
+			if err != nil {
+				return nil, fmt.Errorf("error baking "+
+					"macaroon: %v", err)
+			}
+		}
 
-	sess, err := session.NewSession(
-		req.Label, typ, expiry, req.MailboxServerAddr, req.DevServer,
-		nil, nil,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("error creating new session: %v", err)
-	}
+		err = s.db.ChangeSessionType(pubKey, typ)
+		switch {
+		case errors.Is(err, session.ErrStateMismatch):
+			return nil, status.Error(codes.FailedPrecondition,
+				"session is revoked")
 
-	if err := s.db.StoreSession(sess); err != nil {
-		return nil, fmt.Errorf("error storing session: %v", err)
+		case err != nil:
+			return nil, fmt.Errorf("error changing session "+
+				"type: %v", err)
+		}
+
+		if authData != nil {
+			err = s.sessionServer.UpdateAuthData(pubKey, authData)
+			if err != nil {
+				return nil, fmt.Errorf("error updating "+
+					"session macaroon: %v", err)
+			}
+		}
+
+		sessionLogger(sess).Infof("Changed session type to %v",
+			req.SessionType)
 	}
 
-	if err := s.resumeSession(sess); err != nil {
-		return nil, fmt.Errorf("error starting session: %v", err)
+	sess, err = s.db.GetSession(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
 	}
 
 	rpcSession, err := marshalRPCSession(sess)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling session: %v", err)
 	}
+	rpcSession.IsRunning = s.isActive(pubKey)
 
-	return &litrpc.AddSessionResponse{
+	return &litrpc.ChangeSessionTypeResponse{
 		Session: rpcSession,
 	}, nil
 }
 
-// resumeSession tries to start an existing session if it is not expired, not
-// revoked and a LiT session.
-func (s *sessionRpcServer) resumeSession(sess *session.Session) error {
-	pubKey := sess.LocalPublicKey
-	pubKeyBytes := pubKey.SerializeCompressed()
+// RotateUIPassword replaces the UI password and updates the authentication
+// data of all running UI password sessions, so they keep working with the new
+// password. The new password isn't persisted, the configured one is used again
+// after a restart.
+func (s *sessionRpcServer) RotateUIPassword(_ context.Context,
+	req *litrpc.RotateUIPasswordRequest) (*litrpc.RotateUIPasswordResponse,
+	error) {
 
-	// We only start non-revoked, non-expired LiT sessions. Everything else
-	// we just skip.
-	if sess.State != session.StateInUse &&
-		sess.State != session.StateCreated {
+	if err := s.checkReady(); err != nil {
+		return nil, err
+	}
 
-		log.Debugf("Not resuming session %x with state %d", pubKeyBytes,
-			sess.State)
-		return nil
+	if len(req.NewPassword) < uiPasswordMinLength {
+		return nil, status.Errorf(codes.InvalidArgument, "the UI "+
+			"password must be at least %d characters long",
+			uiPasswordMinLength)
 	}
 
-	// Don't resume an expired session.
-	if sess.Expiry.Before(time.Now()) {
-		log.Debugf("Not resuming session %x with expiry %s",
-			pubKeyBytes, sess.Expiry)
+	basicAuth := uiBasicAuth(req.NewPassword)
 
-		if err := s.db.RevokeSession(pubKey); err != nil {
-			return fmt.Errorf("error revoking session: %v", err)
-		}
+	s.basicAuthMtx.Lock()
+	s.basicAuth = basicAuth
+	s.basicAuthMtx.Unlock()
 
-		return nil
+	if s.setProxyBasicAuth != nil {
+		s.setProxyBasicAuth(basicAuth)
 	}
 
-	var authData []byte
-	switch sess.Type {
-	case session.TypeUIPassword:
-		authData = []byte("Authorization: Basic " + s.basicAuth)
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sessions: %v", err)
+	}
 
-	case session.TypeMacaroonAdmin, session.TypeMacaroonReadonly:
-		ctx := context.Background()
-		readOnly := sess.Type == session.TypeMacaroonReadonly
-		mac, err := s.superMacBaker(
-			ctx, sess.MacaroonRootKey, &session.MacaroonRecipe{
-				Permissions: GetAllPermissions(readOnly),
-			},
-		)
+	var numUpdated uint32
+	// Sessions that weren't approved yet create their data with the new
+	// password once they are approved.
+	for _, sess := range sessions {
+		if sess.Type != session.TypeUIPassword ||
+			sess.RequireApproval ||
+			!s.isActive(sess.LocalPublicKey) {
+
+			continue
+		}
+
+		authData, err := s.sessionAuthData(sess)
 		if err != nil {
-			log.Debugf("Not resuming session %x. Could not bake"+
-				"the necessary macaroon: %w", pubKeyBytes, err)
-			return nil
+			return nil, err
 		}
 
-		authData = []byte(fmt.Sprintf("%s: %s", HeaderMacaroon, mac))
+		err = s.sessionServer.UpdateAuthData(
+			sess.LocalPublicKey, authData,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error updating session auth "+
+				"data: %v", err)
+		}
 
-	default:
-		log.Debugf("Not resuming session %x with type %d", pubKeyBytes,
-			sess.Type)
-		return nil
+		numUpdated++
 	}
 
-	sessionClosedSub, err := s.sessionServer.StartSession(sess, authData)
-	if err != nil {
-		return err
-	}
+	return &litrpc.RotateUIPasswordResponse{
+		NumUpdated: numUpdated,
+	}, nil
+}
 
-	s.wg.Add(1)
-	go func() {
-		defer s.wg.Done()
+// ListSessionTypes returns all session types that can be created in LiT
+// together with a summary of the permissions each of them confers.
+func (s *sessionRpcServer) ListSessionTypes(_ context.Context,
+	_ *litrpc.ListSessionTypesRequest) (*litrpc.ListSessionTypesResponse,
+	error) {
 
-		ticker := time.NewTimer(time.Until(sess.Expiry))
-		defer ticker.Stop()
+	resp := &litrpc.ListSessionTypesResponse{
+		SessionTypes: make(
+			[]*litrpc.SessionTypeInfo, 0, len(supportedSessionTypes),
+		),
+	}
+	for _, info := range supportedSessionTypes {
+		rpcType, err := marshalRPCType(info.typ)
+		if err != nil {
+			return nil, err
+		}
 
-		select {
-		case <-s.quit:
-		case <-sessionClosedSub:
-		case <-ticker.C:
-			log.Debugf("Stopping expired session %x with "+
-				"type %d", pubKeyBytes, sess.Type)
+		var perms []bakery.Op
+		switch info.typ {
+		case session.TypeMacaroonAdmin:
+			perms = s.cfg.permissions(false)
 
-			err = s.sessionServer.StopSession(pubKey)
-			if err != nil {
-				log.Debugf("Error stopping session: "+
-					"%v", err)
+		case session.TypeMacaroonReadonly:
+			perms = s.cfg.permissions(true)
+		}
+
+		// The permissions are collected from a map, so we sort them to
+		// get a stable response.
+		sort.Slice(perms, func(i, j int) bool {
+			if perms[i].Entity != perms[j].Entity {
+				return perms[i].Entity < perms[j].Entity
 			}
+			return perms[i].Action < perms[j].Action
+		})
 
-			err = s.db.RevokeSession(pubKey)
-			if err != nil {
-				log.Debugf("error revoking session: "+
-					"%v", err)
+		rpcPerms := make([]*litrpc.MacaroonPermission, len(perms))
+		for idx, op := range perms {
+			rpcPerms[idx] = &litrpc.MacaroonPermission{
+				Entity: op.Entity,
+				Action: op.Action,
 			}
 		}
-	}()
 
-	return nil
+		resp.SessionTypes = append(
+			resp.SessionTypes, &litrpc.SessionTypeInfo{
+				Type:        rpcType,
+				Name:        info.name,
+				Description: info.description,
+				Permissions: rpcPerms,
+			},
+		)
+	}
+
+	return resp, nil
 }
 
-// ListSessions returns all sessions known to the session store.
-func (s *sessionRpcServer) ListSessions(_ context.Context,
-	_ *litrpc.ListSessionsRequest) (*litrpc.ListSessionsResponse, error) {
+// GetSessionPermissions returns the permissions and caveats the macaroon of a
+// session effectively grants. UI password sessions don't use a macaroon, their
+// effective access is returned as an explicit descriptor instead.
+func (s *sessionRpcServer) GetSessionPermissions(_ context.Context,
+	req *litrpc.GetSessionPermissionsRequest) (
+	*litrpc.GetSessionPermissionsResponse, error) {
 
-	sessions, err := s.db.ListSessions()
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
 	if err != nil {
-		return nil, fmt.Errorf("error fetching sessions: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "error "+
+			"parsing public key: %v", err)
 	}
 
-	response := &litrpc.ListSessionsResponse{
-		Sessions: make([]*litrpc.Session, len(sessions)),
+	sess, err := s.db.GetSession(pubKey)
+	switch {
+	case errors.Is(err, session.ErrSessionNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, fmt.Errorf("error fetching session: %v", err)
 	}
-	for idx, sess := range sessions {
-		response.Sessions[idx], err = marshalRPCSession(sess)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling session: %v",
-				err)
+
+	if sess.Type == session.TypeUIPassword {
+		return &litrpc.GetSessionPermissionsResponse{
+			FullUiAccess:     true,
+			UiPasswordAccess: uiPasswordSessionAccess.marshalRPC(),
+		}, nil
+	}
+
+	recipe := marshalRPCRecipe(s.sessionRecipe(sess))
+
+	return &litrpc.GetSessionPermissionsResponse{
+		Permissions: recipe.Permissions,
+		Caveats:     recipe.Caveats,
+	}, nil
+}
+
+// ListPermissionTemplates returns the permission templates that are configured
+// on the server, sorted by name.
+func (s *sessionRpcServer) ListPermissionTemplates(_ context.Context,
+	_ *litrpc.ListPermissionTemplatesRequest) (
+	*litrpc.ListPermissionTemplatesResponse, error) {
+
+	names := make([]string, 0, len(s.cfg.permissionTemplates))
+	for name := range s.cfg.permissionTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resp := &litrpc.ListPermissionTemplatesResponse{
+		Templates: make([]*litrpc.PermissionTemplate, len(names)),
+	}
+	for idx, name := range names {
+		perms := s.cfg.permissionTemplates[name]
+		rpcPerms := make([]*litrpc.MacaroonPermission, len(perms))
+		for permIdx, op := range perms {
+			rpcPerms[permIdx] = &litrpc.MacaroonPermission{
+				Entity: op.Entity,
+				Action: op.Action,
+			}
+		}
+
+		resp.Templates[idx] = &litrpc.PermissionTemplate{
+			Name:        name,
+			Permissions: rpcPerms,
 		}
 	}
 
-	return response, nil
+	return resp, nil
 }
 
-// RevokeSession revokes a single session and also stops it if it is currently
-// active.
-func (s *sessionRpcServer) RevokeSession(_ context.Context,
-	req *litrpc.RevokeSessionRequest) (*litrpc.RevokeSessionResponse, error) {
+// CheckMailboxServer dials the given mailbox server the same way a session
+// does to find out whether it can be reached from this node. No session is
+// created.
+func (s *sessionRpcServer) CheckMailboxServer(ctx context.Context,
+	req *litrpc.CheckMailboxServerRequest) (
+	*litrpc.CheckMailboxServerResponse, error) {
 
-	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey, btcec.S256())
+	if req.MailboxServerAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "a mailbox "+
+			"server address is required")
+	}
+
+	if req.InsecureSkipVerify && !req.DevServer {
+		return nil, status.Error(codes.InvalidArgument, "skipping "+
+			"the TLS verification is only allowed for dev servers")
+	}
+
+	latency, err := session.CheckMailboxServer(
+		ctx, req.MailboxServerAddr, req.DevServer,
+		req.InsecureSkipVerify,
+		time.Duration(req.TimeoutSeconds)*time.Second,
+	)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing public key: %v", err)
+		return &litrpc.CheckMailboxServerResponse{
+			Error: err.Error(),
+		}, nil
 	}
 
-	if err := s.db.RevokeSession(pubKey); err != nil {
-		return nil, fmt.Errorf("error revoking session: %v", err)
+	return &litrpc.CheckMailboxServerResponse{
+		Reachable:           true,
+		LatencyMilliseconds: uint64(latency.Milliseconds()),
+	}, nil
+}
+
+// ValidatePermissions checks the given macaroon permissions against all
+// permissions known to the node, without creating a session. Each permission
+// is only reported once, even if it was given multiple times.
+func (s *sessionRpcServer) ValidatePermissions(_ context.Context,
+	req *litrpc.ValidatePermissionsRequest) (
+	*litrpc.ValidatePermissionsResponse, error) {
+
+	known := make(map[bakery.Op]bool)
+	for _, op := range GetAllPermissions(false) {
+		known[op] = true
 	}
 
-	// If the session expired already it might not be running anymore. So we
-	// only log possible errors here.
-	if err := s.sessionServer.StopSession(pubKey); err != nil {
-		log.Debugf("Error stopping session: %v", err)
+	seen := make(map[bakery.Op]bool, len(req.Permissions))
+	resp := &litrpc.ValidatePermissionsResponse{}
+	for _, perm := range req.Permissions {
+		op := bakery.Op{
+			Entity: perm.Entity,
+			Action: perm.Action,
+		}
+		if seen[op] {
+			continue
+		}
+		seen[op] = true
+
+		if known[op] {
+			resp.ValidPermissions = append(
+				resp.ValidPermissions, perm,
+			)
+			continue
+		}
+
+		resp.UnknownPermissions = append(resp.UnknownPermissions, perm)
 	}
 
-	return &litrpc.RevokeSessionResponse{}, nil
+	return resp, nil
 }
 
 // marshalRPCSession converts a session into its RPC counterpart.
@@ -230,6 +4876,19 @@ func marshalRPCSession(sess *session.Session) (*litrpc.Session, error) {
 		return nil, err
 	}
 
+	// A created session that waits for its activation can't be connected
+	// to yet, which we want to make visible.
+	now := time.Now()
+	if sess.State == session.StateCreated && sess.PendingActivation(now) {
+
+		rpcState = litrpc.SessionState_STATE_SCHEDULED
+	}
+
+	var activation uint64
+	if !sess.ActivationTime.IsZero() {
+		activation = uint64(sess.ActivationTime.Unix())
+	}
+
 	rpcType, err := marshalRPCType(sess.Type)
 	if err != nil {
 		return nil, err
@@ -240,9 +4899,54 @@ func marshalRPCSession(sess *session.Session) (*litrpc.Session, error) {
 		remotePubKey = sess.RemotePublicKey.SerializeCompressed()
 	}
 
-	mnemonic, err := mailbox.PasswordEntropyToMnemonic(sess.PairingSecret)
-	if err != nil {
-		return nil, err
+	var macRootKeyID uint64
+	if sess.Type != session.TypeUIPassword {
+		macRootKeyID = sess.MacaroonRootKey
+	}
+
+	var autoRenewUntil uint64
+	if sess.AutoRenews() {
+		autoRenewUntil = uint64(sess.AutoRenewUntil.Unix())
+	}
+
+	var revokedAt uint64
+	if !sess.RevokedAt.IsZero() {
+		revokedAt = uint64(sess.RevokedAt.Unix())
+	}
+
+	var restorableUntil uint64
+	if !sess.RestorableUntil.IsZero() {
+		restorableUntil = uint64(sess.RestorableUntil.Unix())
+	}
+
+	var hardDeadline uint64
+	if !sess.HardDeadline.IsZero() {
+		hardDeadline = uint64(sess.HardDeadline.Unix())
+	}
+
+	var lastResumeAttempt uint64
+	if !sess.LastResumeAttempt.IsZero() {
+		lastResumeAttempt = uint64(sess.LastResumeAttempt.Unix())
+	}
+
+	// A suppressed pairing secret is only ever handed out through
+	// RevealPairingSecret.
+	var (
+		pairingSecret   []byte
+		mnemonic        string
+		mnemonicWarning string
+	)
+	if !sess.SuppressPairingSecret {
+		pairingSecret = sess.PairingSecret[:]
+		mnemonic, mnemonicWarning = pairingSecretMnemonic(sess)
+	}
+
+	var metadata map[string]string
+	if len(sess.Metadata) > 0 {
+		metadata = make(map[string]string, len(sess.Metadata))
+		for key, value := range sess.Metadata {
+			metadata[key] = value
+		}
 	}
 
 	return &litrpc.Session{
@@ -252,13 +4956,289 @@ func marshalRPCSession(sess *session.Session) (*litrpc.Session, error) {
 		ExpiryTimestampSeconds: uint64(sess.Expiry.Unix()),
 		MailboxServerAddr:      sess.ServerAddr,
 		DevServer:              sess.DevServer,
-		PairingSecret:          sess.PairingSecret[:],
-		PairingSecretMnemonic:  strings.Join(mnemonic[:], " "),
+		PairingSecret:          pairingSecret,
+		PairingSecretMnemonic:  mnemonic,
 		LocalPublicKey:         sess.LocalPublicKey.SerializeCompressed(),
 		RemotePublicKey:        remotePubKey,
+		InsecureSkipVerify:     sess.InsecureSkipVerify,
+		Description:            sess.Description,
+		MacaroonRootKeyId:      macRootKeyID,
+		MailboxServerAddrs:     sess.MailboxServerAddrs(),
+		SuppressPairingSecret:  sess.SuppressPairingSecret,
+		AutoRenewUntil:         autoRenewUntil,
+		RenewIntervalSeconds:   uint64(sess.RenewInterval.Seconds()),
+		OwnerId:                sess.Owner,
+		SingleUse:              sess.SingleUse,
+		InactivityExpirySeconds: uint64(
+			sess.InactivityExpiry / time.Second,
+		),
+		RevokedAt:         revokedAt,
+		RevokeReason:      sess.RevokeReason,
+		LastResumeError:   sess.LastResumeError,
+		LastResumeAttempt: lastResumeAttempt,
+
+		ActivationTimestampSeconds: activation,
+		SecondsUntilExpiry: int64(
+			sess.Expiry.Sub(now) / time.Second,
+		),
+		GroupId: sess.GroupID,
+		KeepaliveIntervalSeconds: uint32(
+			sess.KeepaliveInterval / time.Second,
+		),
+		HandshakeTimeoutSeconds: uint32(
+			sess.HandshakeTimeout / time.Second,
+		),
+		RestorableUntil: restorableUntil,
+		MacaroonExpirySeconds: uint64(
+			sess.MacaroonExpiry / time.Second,
+		),
+		RemoteDisplayName:    sess.RemoteDisplayName,
+		MaxConcurrentStreams: sess.MaxConcurrentStreams,
+		RequireApproval:      sess.RequireApproval,
+		Priority:             sess.Priority,
+		MnemonicVersion:      uint32(sess.MnemonicVersion),
+		AccessSchedule: marshalRPCAccessSchedule(
+			sess.AccessSchedule,
+		),
+		HardDeadline:    hardDeadline,
+		SessionId:       session.ShortID(sess.LocalPublicKey),
+		MnemonicWarning: mnemonicWarning,
+		Metadata:        metadata,
+	}, nil
+}
+
+// addSessionRequest describes the given new session, for example a clone, as
+// the add session request that would have created it. It lets the session
+// policy judge sessions that aren't created through AddSession.
+func addSessionRequest(sess *session.Session) (*litrpc.AddSessionRequest,
+	error) {
+
+	rpcType, err := marshalRPCType(sess.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	var customPerms []*litrpc.MacaroonPermission
+	if sess.Type == session.TypeMacaroonCustom &&
+		sess.MacaroonRecipe != nil {
+
+		for _, op := range sess.MacaroonRecipe.Permissions {
+			customPerms = append(
+				customPerms, &litrpc.MacaroonPermission{
+					Entity: op.Entity,
+					Action: op.Action,
+				},
+			)
+		}
+	}
+
+	var activation uint64
+	if !sess.ActivationTime.IsZero() {
+		activation = uint64(sess.ActivationTime.Unix())
+	}
+
+	var autoRenewUntil uint64
+	if sess.AutoRenews() {
+		autoRenewUntil = uint64(sess.AutoRenewUntil.Unix())
+	}
+
+	var hardDeadline uint64
+	if !sess.HardDeadline.IsZero() {
+		hardDeadline = uint64(sess.HardDeadline.Unix())
+	}
+
+	return &litrpc.AddSessionRequest{
+		Label:                     sess.Label,
+		SessionType:               rpcType,
+		ExpiryTimestampSeconds:    uint64(sess.Expiry.Unix()),
+		MailboxServerAddr:         sess.ServerAddr,
+		DevServer:                 sess.DevServer,
+		MacaroonCustomPermissions: customPerms,
+		InsecureSkipVerify:        sess.InsecureSkipVerify,
+		Description:               sess.Description,
+		MailboxServerAddrs:        sess.FallbackServerAddrs,
+		SuppressPairingSecret:     sess.SuppressPairingSecret,
+		AutoRenewUntil:            autoRenewUntil,
+		RenewIntervalSeconds:      uint64(sess.RenewInterval.Seconds()),
+		OwnerId:                   sess.Owner,
+		SingleUse:                 sess.SingleUse,
+		InactivityExpirySeconds: uint64(
+			sess.InactivityExpiry / time.Second,
+		),
+		ActivationTimestampSeconds: activation,
+		GroupId:                    sess.GroupID,
+		KeepaliveIntervalSeconds: uint32(
+			sess.KeepaliveInterval / time.Second,
+		),
+		HandshakeTimeoutSeconds: uint32(
+			sess.HandshakeTimeout / time.Second,
+		),
+		MacaroonExpirySeconds: uint64(
+			sess.MacaroonExpiry / time.Second,
+		),
+		RemoteDisplayName:    sess.RemoteDisplayName,
+		MaxConcurrentStreams: sess.MaxConcurrentStreams,
+		RequireApproval:      sess.RequireApproval,
+		Priority:             sess.Priority,
+		AccessSchedule: marshalRPCAccessSchedule(
+			sess.AccessSchedule,
+		),
+		HardDeadline: hardDeadline,
+	}, nil
+}
+
+var (
+	// numMnemonicFailures is the number of times the pairing mnemonic of a
+	// session couldn't be derived. It must only be accessed atomically.
+	numMnemonicFailures uint64
+
+	// passwordEntropyToMnemonic derives the mnemonic of a pairing secret.
+	// It is a variable so tests can make the derivation fail.
+	passwordEntropyToMnemonic = mailbox.PasswordEntropyToMnemonic
+)
+
+// pairingSecretMnemonic returns the mnemonic representation of the session's
+// pairing secret. A missing or malformed secret shouldn't prevent the session
+// (and all others in a list) from being marshaled, so in that case we only log
+// the problem, count it and return an empty mnemonic together with a warning
+// for the client.
+func pairingSecretMnemonic(sess *session.Session) (string, string) {
+	pubKeyBytes := sess.LocalPublicKey.SerializeCompressed()
+
+	if sess.PairingSecret == [mailbox.NumPasswordBytes]byte{} {
+		log.Warnf("Session %x has no valid pairing secret", pubKeyBytes)
+		atomic.AddUint64(&numMnemonicFailures, 1)
+
+		return "", "session has no valid pairing secret"
+	}
+
+	mnemonic, err := deriveMnemonic(sess)
+	if err != nil {
+		log.Warnf("Unable to derive pairing mnemonic from secret %v "+
+			"for session %x: %v", session.Redact(
+			sess.PairingSecret[:],
+		), pubKeyBytes, err)
+		atomic.AddUint64(&numMnemonicFailures, 1)
+
+		return "", fmt.Sprintf("unable to derive pairing mnemonic: %v",
+			err)
+	}
+
+	return mnemonic, ""
+}
+
+// deriveMnemonic derives the mnemonic of the session's pairing secret with the
+// scheme recorded in the session, so the same secret always results in the
+// same words that were handed out when the session was created.
+func deriveMnemonic(sess *session.Session) (string, error) {
+	switch sess.MnemonicVersion {
+	case session.MnemonicVersionMailbox:
+		mnemonic, err := passwordEntropyToMnemonic(
+			sess.PairingSecret,
+		)
+		if err != nil {
+			return "", err
+		}
+
+		return strings.Join(mnemonic[:], " "), nil
+
+	default:
+		return "", fmt.Errorf("unknown mnemonic version %d",
+			sess.MnemonicVersion)
+	}
+}
+
+// marshalRPCSessionSummary converts a session into its reduced RPC summary that
+// leaves out all secret material.
+func marshalRPCSessionSummary(sess *session.Session) (*litrpc.SessionSummary,
+	error) {
+
+	rpcState, err := marshalRPCState(sess.State)
+	if err != nil {
+		return nil, err
+	}
+
+	// Just like for the full session, a session that waits for its
+	// activation is reported as scheduled.
+	if sess.State == session.StateCreated &&
+		sess.PendingActivation(time.Now()) {
+
+		rpcState = litrpc.SessionState_STATE_SCHEDULED
+	}
+
+	rpcType, err := marshalRPCType(sess.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	var createdAt uint64
+	if !sess.CreatedAt.IsZero() {
+		createdAt = uint64(sess.CreatedAt.Unix())
+	}
+
+	localPubKey := sess.LocalPublicKey.SerializeCompressed()
+
+	return &litrpc.SessionSummary{
+		Label:                  sess.Label,
+		LocalPublicKey:         localPubKey,
+		SessionState:           rpcState,
+		SessionType:            rpcType,
+		ExpiryTimestampSeconds: uint64(sess.Expiry.Unix()),
+		CreatedAt:              createdAt,
 	}, nil
 }
 
+// marshalRPCStateChange converts a session state change to its RPC
+// counterpart.
+func marshalRPCStateChange(change *session.StateChange) (
+	*litrpc.SessionStateUpdate, error) {
+
+	rpcSession, err := marshalRPCSession(change.Session)
+	if err != nil {
+		return nil, err
+	}
+
+	prevState, err := marshalRPCState(change.PrevState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &litrpc.SessionStateUpdate{
+		Session:       rpcSession,
+		Created:       change.Created,
+		PreviousState: prevState,
+		ExpiringSoon:  change.ExpiringSoon,
+		Timestamp:     uint64(change.Timestamp.Unix()),
+	}, nil
+}
+
+// marshalRPCRecipe converts a macaroon recipe into its RPC counterpart. Nil is
+// returned if there is no recipe.
+func marshalRPCRecipe(recipe *session.MacaroonRecipe) *litrpc.MacaroonRecipe {
+	if recipe == nil {
+		return nil
+	}
+
+	numPerms := len(recipe.Permissions)
+	rpcRecipe := &litrpc.MacaroonRecipe{
+		Permissions: make([]*litrpc.MacaroonPermission, 0, numPerms),
+		Caveats:     make([]string, 0, len(recipe.Caveats)),
+	}
+	for _, perm := range recipe.Permissions {
+		rpcRecipe.Permissions = append(
+			rpcRecipe.Permissions, &litrpc.MacaroonPermission{
+				Entity: perm.Entity,
+				Action: perm.Action,
+			},
+		)
+	}
+	for _, caveat := range recipe.Caveats {
+		rpcRecipe.Caveats = append(rpcRecipe.Caveats, string(caveat.Id))
+	}
+
+	return rpcRecipe
+}
+
 // marshalRPCState converts a session state to its RPC counterpart.
 func marshalRPCState(state session.State) (litrpc.SessionState, error) {
 	switch state {
@@ -274,6 +5254,18 @@ func marshalRPCState(state session.State) (litrpc.SessionState, error) {
 	case session.StateExpired:
 		return litrpc.SessionState_STATE_EXPIRED, nil
 
+	case session.StateStarting:
+		return litrpc.SessionState_STATE_STARTING, nil
+
+	case session.StateQuarantined:
+		return litrpc.SessionState_STATE_QUARANTINED, nil
+
+	case session.StatePendingApproval:
+		return litrpc.SessionState_STATE_PENDING_APPROVAL, nil
+
+	case session.StateSuspended:
+		return litrpc.SessionState_STATE_SUSPENDED, nil
+
 	default:
 		return 0, fmt.Errorf("unknown state <%d>", state)
 	}
@@ -299,6 +5291,60 @@ func marshalRPCType(typ session.Type) (litrpc.SessionType, error) {
 	}
 }
 
+// marshalRPCEventType converts an audit event type to its RPC counterpart.
+func marshalRPCEventType(
+	typ session.AuditEventType) (litrpc.SessionEventType, error) {
+
+	switch typ {
+	case session.AuditEventCreated:
+		return litrpc.SessionEventType_EVENT_CREATED, nil
+
+	case session.AuditEventStarted:
+		return litrpc.SessionEventType_EVENT_STARTED, nil
+
+	case session.AuditEventStopped:
+		return litrpc.SessionEventType_EVENT_STOPPED, nil
+
+	case session.AuditEventRevoked:
+		return litrpc.SessionEventType_EVENT_REVOKED, nil
+
+	case session.AuditEventExpired:
+		return litrpc.SessionEventType_EVENT_EXPIRED, nil
+
+	case session.AuditEventRenewed:
+		return litrpc.SessionEventType_EVENT_RENEWED, nil
+
+	case session.AuditEventQuarantined:
+		return litrpc.SessionEventType_EVENT_QUARANTINED, nil
+
+	case session.AuditEventUnquarantined:
+		return litrpc.SessionEventType_EVENT_UNQUARANTINED, nil
+
+	case session.AuditEventRestored:
+		return litrpc.SessionEventType_EVENT_RESTORED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown event type <%d>", typ)
+	}
+}
+
+// ParseSessionTypeString converts the alias of a session type that can be
+// created in LiT, like "admin" or "readonly", to its RPC type. The alias is
+// matched case-insensitively.
+func ParseSessionTypeString(s string) (litrpc.SessionType, error) {
+	aliases := make([]string, 0, len(supportedSessionTypes))
+	for _, info := range supportedSessionTypes {
+		if strings.EqualFold(strings.TrimSpace(s), info.alias) {
+			return marshalRPCType(info.typ)
+		}
+
+		aliases = append(aliases, info.alias)
+	}
+
+	return 0, fmt.Errorf("unknown session type %q, supported types are "+
+		"%s", s, strings.Join(aliases, "|"))
+}
+
 // unmarshalRPCType converts an RPC session type to its session counterpart.
 func unmarshalRPCType(typ litrpc.SessionType) (session.Type, error) {
 	switch typ {
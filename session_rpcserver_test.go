@@ -0,0 +1,6249 @@
+package terminal
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/lightning-node-connect/mailbox"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightninglabs/loop/loopd"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+// mockSessionServer is a mailboxSessionServer that doesn't connect to any
+// mailbox but only keeps track of the sessions it was asked to start.
+type mockSessionServer struct {
+	mu          sync.Mutex
+	active      map[string]chan struct{}
+	authData    map[string][]byte
+	serverAddrs map[string]string
+	connected   map[string]chan struct{}
+
+	// events holds the channel that receives the simulated connection
+	// events of each active session.
+	events map[string]chan session.ConnectionEvent
+
+	// connInfo holds the connection details reported for active sessions.
+	connInfo map[string]*session.ConnectionInfo
+
+	// clients holds a channel for each session with a connected client
+	// that is closed once the client disconnects. Sessions without a
+	// connected client are idle.
+	clients map[string]chan struct{}
+
+	// draining holds the sessions that don't accept new clients anymore.
+	draining map[string]bool
+
+	// lazyAuthData holds the functions of lazily started sessions that
+	// create their authentication data on the first connection.
+	lazyAuthData map[string]session.AuthDataFunc
+
+	// startErr, if set, is returned by StartSession.
+	startErr error
+
+	// startErrs are returned by the next calls of StartSession, one per
+	// call, before the session is actually started.
+	startErrs []error
+
+	// stopErrs are returned by the next calls of StopSession, one per
+	// call, before the session is actually stopped.
+	stopErrs []error
+}
+
+// newMockSessionServer creates a new mock session server without any active
+// sessions.
+func newMockSessionServer() *mockSessionServer {
+	return &mockSessionServer{
+		active:      make(map[string]chan struct{}),
+		authData:    make(map[string][]byte),
+		serverAddrs: make(map[string]string),
+		connected:   make(map[string]chan struct{}),
+		events:      make(map[string]chan session.ConnectionEvent),
+		connInfo:    make(map[string]*session.ConnectionInfo),
+		clients:     make(map[string]chan struct{}),
+		draining:    make(map[string]bool),
+
+		lazyAuthData: make(map[string]session.AuthDataFunc),
+	}
+}
+
+// StartSession marks the given session as active. The mock reaches its mailbox
+// server right away, so the timeout is ignored.
+func (m *mockSessionServer) StartSession(sess *session.Session,
+	authData []byte, _ time.Duration) (chan struct{}, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.startErr != nil {
+		return nil, m.startErr
+	}
+	if len(m.startErrs) > 0 {
+		err := m.startErrs[0]
+		m.startErrs = m.startErrs[1:]
+		return nil, err
+	}
+
+	id := string(sess.LocalPublicKey.SerializeCompressed())
+	if _, ok := m.active[id]; ok {
+		return nil, fmt.Errorf("session %x is already active", id)
+	}
+
+	quit := make(chan struct{})
+	m.active[id] = quit
+	m.authData[id] = authData
+	m.serverAddrs[id] = sess.ServerAddr
+	m.connected[id] = make(chan struct{}, 1)
+	m.events[id] = make(chan session.ConnectionEvent, 10)
+
+	return quit, nil
+}
+
+// StartLazySession marks the given session as active without creating its
+// authentication data until a client connects.
+func (m *mockSessionServer) StartLazySession(sess *session.Session,
+	authData session.AuthDataFunc, timeout time.Duration) (chan struct{},
+	error) {
+
+	quit, err := m.StartSession(sess, nil, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := string(sess.LocalPublicKey.SerializeCompressed())
+	m.lazyAuthData[id] = authData
+
+	return quit, nil
+}
+
+// StopSession marks the session with the given key as no longer active.
+func (m *mockSessionServer) StopSession(localPublicKey *btcec.PublicKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.stopErrs) > 0 {
+		err := m.stopErrs[0]
+		m.stopErrs = m.stopErrs[1:]
+		return err
+	}
+
+	id := string(localPublicKey.SerializeCompressed())
+	quit, ok := m.active[id]
+	if !ok {
+		return fmt.Errorf("session %x is not active", id)
+	}
+
+	close(quit)
+	delete(m.active, id)
+	delete(m.serverAddrs, id)
+
+	return nil
+}
+
+// ActiveServerAddr returns the first mailbox server of the session with the
+// given key if it is active.
+func (m *mockSessionServer) ActiveServerAddr(
+	localPublicKey *btcec.PublicKey) string {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.serverAddrs[string(localPublicKey.SerializeCompressed())]
+}
+
+// UpdateAuthData replaces the authentication data of the active session with
+// the given key.
+func (m *mockSessionServer) UpdateAuthData(localPublicKey *btcec.PublicKey,
+	authData []byte) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := string(localPublicKey.SerializeCompressed())
+	if _, ok := m.active[id]; !ok {
+		return fmt.Errorf("session %x is not active", id)
+	}
+	m.authData[id] = authData
+
+	return nil
+}
+
+// Connections returns the channel that is signaled by connect for the active
+// session with the given key.
+func (m *mockSessionServer) Connections(
+	localPublicKey *btcec.PublicKey) <-chan struct{} {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.connected[string(localPublicKey.SerializeCompressed())]
+}
+
+// Events returns the channel that receives the simulated connection events of
+// the active session with the given key.
+func (m *mockSessionServer) Events(
+	localPublicKey *btcec.PublicKey) <-chan session.ConnectionEvent {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.events[string(localPublicKey.SerializeCompressed())]
+}
+
+// sendEvent simulates the given connection event of the session with the given
+// key. The event is dropped if the session doesn't read its events.
+func (m *mockSessionServer) sendEvent(localPublicKey *btcec.PublicKey,
+	event session.ConnectionEvent) {
+
+	m.mu.Lock()
+	events := m.events[string(localPublicKey.SerializeCompressed())]
+	m.mu.Unlock()
+
+	select {
+	case events <- event:
+	default:
+	}
+}
+
+// ConnectionInfo returns the configured connection details of the session with
+// the given key if it is active.
+func (m *mockSessionServer) ConnectionInfo(
+	localPublicKey *btcec.PublicKey) *session.ConnectionInfo {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := string(localPublicKey.SerializeCompressed())
+	if _, ok := m.active[id]; !ok {
+		return nil
+	}
+
+	return m.connInfo[id]
+}
+
+// Drain marks the active session with the given key as draining.
+func (m *mockSessionServer) Drain(localPublicKey *btcec.PublicKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := string(localPublicKey.SerializeCompressed())
+	if _, ok := m.active[id]; !ok {
+		return fmt.Errorf("session %x is not active", id)
+	}
+	m.draining[id] = true
+
+	return nil
+}
+
+// Idle returns a channel that is closed once the client of the session with
+// the given key disconnected. A closed channel is returned if no client is
+// connected.
+func (m *mockSessionServer) Idle(
+	localPublicKey *btcec.PublicKey) <-chan struct{} {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	id := string(localPublicKey.SerializeCompressed())
+	if clientGone, ok := m.clients[id]; ok {
+		return clientGone
+	}
+
+	idle := make(chan struct{})
+	close(idle)
+
+	return idle
+}
+
+// connectClient simulates a client that connects to the session with the given
+// key and stays connected until disconnectClient is called.
+func (m *mockSessionServer) connectClient(localPublicKey *btcec.PublicKey) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.clients[string(localPublicKey.SerializeCompressed())] = make(
+		chan struct{},
+	)
+}
+
+// disconnectClient simulates the client of the session with the given key
+// disconnecting.
+func (m *mockSessionServer) disconnectClient(localPublicKey *btcec.PublicKey) {
+	m.mu.Lock()
+	id := string(localPublicKey.SerializeCompressed())
+	close(m.clients[id])
+	delete(m.clients, id)
+	m.mu.Unlock()
+
+	m.sendEvent(localPublicKey, session.EventRemoteDisconnected)
+}
+
+// isDraining returns true if the session with the given key was drained.
+func (m *mockSessionServer) isDraining(localPublicKey *btcec.PublicKey) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.draining[string(localPublicKey.SerializeCompressed())]
+}
+
+// connect simulates a client connecting to the session with the given key. The
+// authentication data of a lazily started session is created on its first
+// connection.
+func (m *mockSessionServer) connect(localPublicKey *btcec.PublicKey) {
+	id := string(localPublicKey.SerializeCompressed())
+
+	m.mu.Lock()
+	connected := m.connected[id]
+	quit := m.active[id]
+	lazyAuthData := m.lazyAuthData[id]
+	delete(m.lazyAuthData, id)
+	m.mu.Unlock()
+
+	if lazyAuthData != nil {
+		authData, err := lazyAuthData(quit)
+		if err == nil {
+			m.mu.Lock()
+			m.authData[id] = authData
+			m.mu.Unlock()
+		}
+	}
+
+	select {
+	case connected <- struct{}{}:
+	default:
+	}
+	m.sendEvent(localPublicKey, session.EventRemoteConnected)
+}
+
+// isActive returns true if the session with the given key is active.
+func (m *mockSessionServer) isActive(localPublicKey *btcec.PublicKey) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.active[string(localPublicKey.SerializeCompressed())]
+	return ok
+}
+
+// newTestSessionRpcServer creates a session RPC server that is backed by a
+// fresh session DB in a temporary directory and a mock session server.
+func newTestSessionRpcServer(t *testing.T) *sessionRpcServer {
+	db, err := session.NewDB(t.TempDir(), session.DBFilename)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	s := &sessionRpcServer{
+		cfg:                &SessionConfig{},
+		basicAuth:          "dGVzdDp0ZXN0",
+		rpcEndpoint:        "localhost:8443",
+		db:                 db,
+		sessionServer:      newMockSessionServer(),
+		activeSessions:     make(map[string]chan struct{}),
+		pendingActivations: make(map[string]chan struct{}),
+		pendingApprovals:   make(map[string]chan bool),
+		expiryWarnings:     make(map[string]time.Time),
+		quit:               make(chan struct{}),
+		policy:             permissiveSessionPolicy{},
+		superMacBaker: func(context.Context, uint64,
+			*session.MacaroonRecipe) (string, error) {
+
+			return "mac", nil
+		},
+		subserverPermissions: litSubserverPermissions(),
+		revokeConfirmations:  make(map[string]revokeConfirmation),
+		pendingLabels:        make(map[string]struct{}),
+		eventHandler:         noopSessionEventHandler{},
+	}
+	s.markReady()
+	t.Cleanup(s.stop)
+
+	return s
+}
+
+// infoReadPermission returns the explicit permissions of a custom session that
+// may only read the node info.
+func infoReadPermission() []*litrpc.MacaroonPermission {
+	return []*litrpc.MacaroonPermission{{
+		Entity: "info",
+		Action: "read",
+	}}
+}
+
+// newTestSession creates a new session with the given label and type that
+// expires in one day.
+func newTestSession(t *testing.T, label string,
+	typ session.Type) *session.Session {
+
+	sess, err := session.NewSession(
+		label, typ, time.Now().Add(24*time.Hour),
+		"mailbox.terminal.lightning.today:443", false, nil, nil,
+	)
+	require.NoError(t, err)
+
+	return sess
+}
+
+// TestListSessionsInvalidPairingSecret makes sure that a single session with
+// an invalid pairing secret doesn't prevent all other sessions from being
+// listed.
+func TestListSessionsInvalidPairingSecret(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	valid := newTestSession(t, "valid", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(valid))
+
+	malformed := newTestSession(t, "malformed", session.TypeMacaroonAdmin)
+	malformed.PairingSecret = [mailbox.NumPasswordBytes]byte{}
+	require.NoError(t, s.db.StoreSession(malformed))
+
+	resp, err := s.ListSessions(
+		context.Background(), &litrpc.ListSessionsRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 2)
+
+	for _, sess := range resp.Sessions {
+		switch sess.Label {
+		case "valid":
+			require.NotEmpty(t, sess.PairingSecretMnemonic)
+
+		case "malformed":
+			require.Empty(t, sess.PairingSecretMnemonic)
+			require.NotEmpty(t, sess.MnemonicWarning)
+
+		default:
+			t.Fatalf("unexpected session %v", sess.Label)
+		}
+	}
+}
+
+// TestListSessionsMnemonicFailure makes sure that a session whose mnemonic
+// can't be derived is still listed with a warning instead of failing the whole
+// list, and that the failure is counted.
+func TestListSessionsMnemonicFailure(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	valid := newTestSession(t, "valid", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(valid))
+
+	failing := newTestSession(t, "failing", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(failing))
+
+	// Only the derivation of the failing session's mnemonic fails.
+	errDerivation := errors.New("derivation failed")
+	defer func(orig func([mailbox.NumPasswordBytes]byte) (
+		[mailbox.NumPasswordWords]string, error)) {
+
+		passwordEntropyToMnemonic = orig
+	}(passwordEntropyToMnemonic)
+	passwordEntropyToMnemonic = func(
+		secret [mailbox.NumPasswordBytes]byte) (
+		[mailbox.NumPasswordWords]string, error) {
+
+		if secret == failing.PairingSecret {
+			return [mailbox.NumPasswordWords]string{}, errDerivation
+		}
+
+		return mailbox.PasswordEntropyToMnemonic(secret)
+	}
+
+	statusBefore, err := s.GetServerStatus(
+		ctx, &litrpc.GetServerStatusRequest{},
+	)
+	require.NoError(t, err)
+
+	resp, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 2)
+
+	for _, sess := range resp.Sessions {
+		switch sess.Label {
+		case "valid":
+			require.NotEmpty(t, sess.PairingSecretMnemonic)
+			require.Empty(t, sess.MnemonicWarning)
+
+		case "failing":
+			require.Empty(t, sess.PairingSecretMnemonic)
+			require.Contains(
+				t, sess.MnemonicWarning, errDerivation.Error(),
+			)
+
+		default:
+			t.Fatalf("unexpected session %v", sess.Label)
+		}
+	}
+
+	statusAfter, err := s.GetServerStatus(
+		ctx, &litrpc.GetServerStatusRequest{},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, statusBefore.MnemonicFailures+1,
+		statusAfter.MnemonicFailures,
+	)
+
+	// Clients of an older version of the Session message don't get the
+	// warning.
+	resp, err = s.ListSessions(ctx, &litrpc.ListSessionsRequest{
+		ApiVersion: sessionAPIVersion4,
+	})
+	require.NoError(t, err)
+	for _, sess := range resp.Sessions {
+		require.Empty(t, sess.MnemonicWarning)
+	}
+}
+
+// TestListSessionsExpiryFilter makes sure that the expiry bounds of a list
+// request are applied correctly, both individually and combined.
+func TestListSessionsExpiryFilter(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	now := time.Now()
+	expiries := map[string]time.Time{
+		"day":   now.Add(24 * time.Hour),
+		"week":  now.Add(7 * 24 * time.Hour),
+		"month": now.Add(30 * 24 * time.Hour),
+	}
+	for label, expiry := range expiries {
+		sess := newTestSession(t, label, session.TypeMacaroonAdmin)
+		sess.Expiry = expiry
+		require.NoError(t, s.db.StoreSession(sess))
+	}
+
+	unix := func(label string) uint64 {
+		return uint64(expiries[label].Unix())
+	}
+
+	tests := []struct {
+		name     string
+		req      *litrpc.ListSessionsRequest
+		expected []string
+	}{{
+		name:     "no filter",
+		req:      &litrpc.ListSessionsRequest{},
+		expected: []string{"day", "week", "month"},
+	}, {
+		name: "expiry after",
+		req: &litrpc.ListSessionsRequest{
+			ExpiryAfter: unix("week"),
+		},
+		expected: []string{"week", "month"},
+	}, {
+		name: "expiry before",
+		req: &litrpc.ListSessionsRequest{
+			ExpiryBefore: unix("week"),
+		},
+		expected: []string{"day", "week"},
+	}, {
+		name: "expiry after and before",
+		req: &litrpc.ListSessionsRequest{
+			ExpiryAfter:  unix("day") + 1,
+			ExpiryBefore: unix("month") - 1,
+		},
+		expected: []string{"week"},
+	}}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := s.ListSessions(
+				context.Background(), test.req,
+			)
+			require.NoError(t, err)
+
+			labels := make([]string, 0, len(resp.Sessions))
+			for _, sess := range resp.Sessions {
+				labels = append(labels, sess.Label)
+			}
+			require.ElementsMatch(t, test.expected, labels)
+		})
+	}
+}
+
+// TestListSessionsCreatedFilter makes sure that sessions can be filtered by
+// their creation time, that both bounds are inclusive and that sessions without
+// a recorded creation time only match without these filters.
+func TestListSessionsCreatedFilter(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	now := time.Unix(time.Now().Unix(), 0)
+	created := map[string]time.Time{
+		"old":    now.Add(-7 * 24 * time.Hour),
+		"recent": now.Add(-24 * time.Hour),
+		"new":    now.Add(-time.Hour),
+	}
+	for label, createdAt := range created {
+		sess := newTestSession(t, label, session.TypeMacaroonAdmin)
+		sess.CreatedAt = createdAt
+		require.NoError(t, s.db.StoreSession(sess))
+	}
+
+	legacy := newTestSession(t, "legacy", session.TypeMacaroonAdmin)
+	legacy.CreatedAt = time.Time{}
+	require.NoError(t, s.db.StoreSession(legacy))
+
+	unix := func(label string) uint64 {
+		return uint64(created[label].Unix())
+	}
+
+	tests := []struct {
+		name     string
+		req      *litrpc.ListSessionsRequest
+		expected []string
+	}{{
+		name:     "no filter",
+		req:      &litrpc.ListSessionsRequest{},
+		expected: []string{"old", "recent", "new", "legacy"},
+	}, {
+		name: "created after",
+		req: &litrpc.ListSessionsRequest{
+			CreatedAfter: unix("recent"),
+		},
+		expected: []string{"recent", "new"},
+	}, {
+		name: "created before",
+		req: &litrpc.ListSessionsRequest{
+			CreatedBefore: unix("recent"),
+		},
+		expected: []string{"old", "recent"},
+	}, {
+		name: "created after and before",
+		req: &litrpc.ListSessionsRequest{
+			CreatedAfter:  unix("old") + 1,
+			CreatedBefore: unix("new") - 1,
+		},
+		expected: []string{"recent"},
+	}, {
+		name: "exactly on both bounds",
+		req: &litrpc.ListSessionsRequest{
+			CreatedAfter:  unix("recent"),
+			CreatedBefore: unix("recent"),
+		},
+		expected: []string{"recent"},
+	}, {
+		name: "combined with label filter",
+		req: &litrpc.ListSessionsRequest{
+			CreatedAfter: unix("old"),
+			LabelQuery:   "e",
+		},
+		expected: []string{"recent", "new"},
+	}}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := s.ListSessions(
+				context.Background(), test.req,
+			)
+			require.NoError(t, err)
+
+			labels := make([]string, 0, len(resp.Sessions))
+			for _, sess := range resp.Sessions {
+				labels = append(labels, sess.Label)
+			}
+			require.ElementsMatch(t, test.expected, labels)
+		})
+	}
+}
+
+// TestAddSessionInsecureSkipVerify makes sure that skipping the TLS
+// verification of the mailbox server is only allowed for dev servers.
+func TestAddSessionInsecureSkipVerify(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	_, err := s.AddSession(
+		context.Background(), &litrpc.AddSessionRequest{
+			Label:       "insecure",
+			SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr:  "localhost:1234",
+			InsecureSkipVerify: true,
+		},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestUpdateSessionDescription makes sure that a session's description can be
+// updated over RPC and is returned when listing sessions.
+func TestUpdateSessionDescription(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	sess := newTestSession(t, "description", session.TypeMacaroonAdmin)
+	sess.Description = "initial"
+	require.NoError(t, s.db.StoreSession(sess))
+
+	ctx := context.Background()
+	_, err := s.UpdateSessionDescription(
+		ctx, &litrpc.UpdateSessionDescriptionRequest{
+			LocalPublicKey: sess.LocalPublicKey.SerializeCompressed(),
+			Description:    "updated",
+		},
+	)
+	require.NoError(t, err)
+
+	resp, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 1)
+	require.Equal(t, "updated", resp.Sessions[0].Description)
+	require.Equal(t, "description", resp.Sessions[0].Label)
+}
+
+// TestValidateExpiry makes sure that the configured minimum and maximum
+// session durations are enforced inclusively.
+func TestValidateExpiry(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.MinDuration = time.Hour
+	s.cfg.MaxDuration = 180 * 24 * time.Hour
+
+	now := time.Now()
+	tests := []struct {
+		name    string
+		expiry  time.Time
+		allowed bool
+	}{{
+		name:    "below min",
+		expiry:  now.Add(s.cfg.MinDuration - time.Second),
+		allowed: false,
+	}, {
+		name:    "exactly min",
+		expiry:  now.Add(s.cfg.MinDuration),
+		allowed: true,
+	}, {
+		name:    "exactly max",
+		expiry:  now.Add(s.cfg.MaxDuration),
+		allowed: true,
+	}, {
+		name:    "above max",
+		expiry:  now.Add(s.cfg.MaxDuration + time.Second),
+		allowed: false,
+	}}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := s.validateExpiry(now, test.expiry)
+			if test.allowed {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+
+	// Zero values disable the bounds.
+	s.cfg.MinDuration = 0
+	s.cfg.MaxDuration = 0
+	require.NoError(t, s.validateExpiry(now, now.Add(time.Second)))
+	require.NoError(t, s.validateExpiry(now, now.Add(10*365*24*time.Hour)))
+}
+
+// TestCloneSession makes sure that a cloned session has the same type and
+// permissions as the original but a distinct key and pairing secret and that
+// it belongs to the caller that cloned it.
+func TestCloneSession(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	perms := []bakery.Op{{Entity: "info", Action: "read"}}
+	orig, err := session.NewSession(
+		"orig", session.TypeMacaroonCustom, time.Now().Add(time.Hour),
+		"mailbox.terminal.lightning.today:443", false, perms, nil,
+	)
+	require.NoError(t, err)
+	orig.Description = "cloned from here"
+	orig.GroupID = "group"
+	orig.Metadata = map[string]string{"team": "ops"}
+	orig.Owner = "original owner"
+	require.NoError(t, s.db.StoreSession(orig))
+
+	ctx := callerContext(t, "bob")
+	bob, err := callerIdentity(ctx)
+	require.NoError(t, err)
+
+	resp, err := s.CloneSession(ctx, &litrpc.CloneSessionRequest{
+		LocalPublicKey: orig.LocalPublicKey.SerializeCompressed(),
+	})
+	require.NoError(t, err)
+
+	clonedKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+	clone, err := s.db.GetSession(clonedKey)
+	require.NoError(t, err)
+
+	require.Equal(t, "orig"+defaultCloneLabelSuffix, clone.Label)
+	require.Equal(t, orig.Type, clone.Type)
+	require.Equal(t, orig.Description, clone.Description)
+	require.Equal(t, orig.Expiry.Unix(), clone.Expiry.Unix())
+	require.Equal(t, orig.MacaroonRecipe, clone.MacaroonRecipe)
+	require.Equal(t, orig.GroupID, clone.GroupID)
+	require.Equal(t, orig.Metadata, clone.Metadata)
+	require.Equal(t, bob, clone.Owner)
+	require.NotEqual(t, orig.LocalPublicKey, clone.LocalPublicKey)
+	require.NotEqual(t, orig.PairingSecret, clone.PairingSecret)
+
+	// An explicit label and expiry should be used instead of the original
+	// ones.
+	expiry := time.Now().Add(48 * time.Hour)
+	resp, err = s.CloneSession(ctx, &litrpc.CloneSessionRequest{
+		LocalPublicKey:         orig.LocalPublicKey.SerializeCompressed(),
+		Label:                  "explicit",
+		ExpiryTimestampSeconds: uint64(expiry.Unix()),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "explicit", resp.Session.Label)
+	require.Equal(
+		t, uint64(expiry.Unix()), resp.Session.ExpiryTimestampSeconds,
+	)
+}
+
+// TestMarshalRPCSessionMacaroonRootKeyID makes sure that the macaroon root key
+// ID is only reported for macaroon sessions.
+func TestMarshalRPCSessionMacaroonRootKeyID(t *testing.T) {
+	uiSession := newTestSession(t, "ui", session.TypeUIPassword)
+	rpcSession, err := marshalRPCSession(uiSession)
+	require.NoError(t, err)
+	require.Zero(t, rpcSession.MacaroonRootKeyId)
+
+	for _, typ := range []session.Type{
+		session.TypeMacaroonReadonly, session.TypeMacaroonAdmin,
+		session.TypeMacaroonCustom,
+	} {
+		macSession := newTestSession(t, "mac", typ)
+		rpcSession, err := marshalRPCSession(macSession)
+		require.NoError(t, err)
+		require.NotZero(t, rpcSession.MacaroonRootKeyId)
+		require.Equal(
+			t, macSession.MacaroonRootKey,
+			rpcSession.MacaroonRootKeyId,
+		)
+	}
+}
+
+// addTestUISession adds and starts a new UI password session over RPC.
+func addTestUISession(t *testing.T, s *sessionRpcServer,
+	label string) *litrpc.Session {
+
+	resp, err := s.AddSession(
+		context.Background(), &litrpc.AddSessionRequest{
+			Label:       label,
+			SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		},
+	)
+	require.NoError(t, err)
+
+	return resp.Session
+}
+
+// activeKeys returns the serialized local public keys of all sessions the
+// server considers active.
+func activeKeys(s *sessionRpcServer) []string {
+	s.activeSessionsMtx.Lock()
+	defer s.activeSessionsMtx.Unlock()
+
+	keys := make([]string, 0, len(s.activeSessions))
+	for id := range s.activeSessions {
+		keys = append(keys, id)
+	}
+
+	return keys
+}
+
+// TestPauseResumeAllSessions makes sure that pausing and then resuming all
+// sessions yields the same set of running sessions and that both operations
+// can be called repeatedly.
+func TestPauseResumeAllSessions(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	addTestUISession(t, s, "first")
+	addTestUISession(t, s, "second")
+
+	running := activeKeys(s)
+	require.Len(t, running, 2)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		_, err := s.PauseAllSessions(
+			ctx, &litrpc.PauseAllSessionsRequest{},
+		)
+		require.NoError(t, err)
+		require.Empty(t, activeKeys(s))
+
+		sessions, err := s.db.ListSessions()
+		require.NoError(t, err)
+		for _, sess := range sessions {
+			require.False(t, mockServer.isActive(sess.LocalPublicKey))
+			require.Equal(t, session.StateCreated, sess.State)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := s.ResumeAllSessions(
+			ctx, &litrpc.ResumeAllSessionsRequest{},
+		)
+		require.NoError(t, err)
+		require.ElementsMatch(t, running, activeKeys(s))
+	}
+}
+
+// TestListSessionTypes makes sure that only the supported session types are
+// reported and that the macaroon based ones list their permissions.
+func TestListSessionTypes(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	resp, err := s.ListSessionTypes(
+		context.Background(), &litrpc.ListSessionTypesRequest{},
+	)
+	require.NoError(t, err)
+
+	types := make(map[litrpc.SessionType]*litrpc.SessionTypeInfo)
+	for _, info := range resp.SessionTypes {
+		require.NotEmpty(t, info.Name)
+		types[info.Type] = info
+	}
+	require.Len(t, types, 4)
+
+	require.Contains(t, types, litrpc.SessionType_TYPE_UI_PASSWORD)
+
+	// The permissions of a custom session are only known once it is
+	// added, so none are listed for the type.
+	custom := types[litrpc.SessionType_TYPE_MACAROON_CUSTOM]
+	require.NotNil(t, custom)
+	require.Empty(t, custom.Permissions)
+
+	admin := types[litrpc.SessionType_TYPE_MACAROON_ADMIN]
+	require.NotNil(t, admin)
+	require.NotEmpty(t, admin.Permissions)
+
+	readonly := types[litrpc.SessionType_TYPE_MACAROON_READONLY]
+	require.NotNil(t, readonly)
+	require.NotEmpty(t, readonly.Permissions)
+	require.Less(t, len(readonly.Permissions), len(admin.Permissions))
+	for _, perm := range readonly.Permissions {
+		require.Equal(t, "read", perm.Action)
+	}
+}
+
+// TestRevokeSessionMalformedKey makes sure that RevokeSession rejects keys that
+// aren't valid compressed public keys with an InvalidArgument error.
+func TestRevokeSessionMalformedKey(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	// A key of the wrong length is rejected before it is parsed.
+	_, err := s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: []byte{0x02, 0x01, 0x02},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "expected a 33 byte compressed "+
+		"public key, got 3 bytes")
+
+	// There is no point with the x coordinate 5 on the curve.
+	notOnCurve := make([]byte, btcec.PubKeyBytesLenCompressed)
+	notOnCurve[0] = 0x02
+	notOnCurve[len(notOnCurve)-1] = 0x05
+	_, err = s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: notOnCurve,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "invalid local_public_key")
+
+	sess := newTestSession(t, "valid", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(sess))
+
+	_, err = s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: sess.LocalPublicKey.SerializeCompressed(),
+	})
+	require.NoError(t, err)
+
+	stored, err := s.db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateRevoked, stored.State)
+}
+
+// TestSessionLogFields makes sure that the log lines of session related
+// operations contain the identifying fields of the session.
+func TestSessionLogFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := btclog.NewBackend(&buf).Logger(Subsystem)
+	logger.SetLevel(btclog.LevelDebug)
+
+	oldLog := log
+	UseLogger(logger)
+	t.Cleanup(func() {
+		UseLogger(oldLog)
+	})
+
+	s := newTestSessionRpcServer(t)
+	rpcSess := addTestUISession(t, s, "100% logged")
+
+	_, err := s.RevokeSession(
+		context.Background(), &litrpc.RevokeSessionRequest{
+			LocalPublicKey: rpcSess.LocalPublicKey,
+		},
+	)
+	require.NoError(t, err)
+
+	logged := buf.String()
+	require.Contains(t, logged, fmt.Sprintf(
+		"session_pubkey=%x session_type=%d session_label=%q "+
+			"Revoked session", rpcSess.LocalPublicKey,
+		session.TypeUIPassword, "100% logged",
+	))
+}
+
+// TestSessionLogRedaction makes sure that neither the pairing secret nor the
+// macaroon of a session ever show up in the log as is, but that their stable
+// fingerprints do.
+func TestSessionLogRedaction(t *testing.T) {
+	var buf bytes.Buffer
+	logger := btclog.NewBackend(&buf).Logger(Subsystem)
+	logger.SetLevel(btclog.LevelTrace)
+
+	oldLog := log
+	UseLogger(logger)
+	t.Cleanup(func() {
+		UseLogger(oldLog)
+	})
+
+	const mac = "0201036c6e640258030a10b8d0f6d3e1c2a4b5"
+
+	s := newTestSessionRpcServer(t)
+	s.superMacBaker = func(context.Context, uint64,
+		*session.MacaroonRecipe) (string, error) {
+
+		return mac, nil
+	}
+
+	resp, err := s.AddSession(
+		context.Background(), &litrpc.AddSessionRequest{
+			Label:       "redacted",
+			SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		},
+	)
+	require.NoError(t, err)
+
+	secret := resp.Session.PairingSecret
+	_, err = s.ListSessions(
+		context.Background(), &litrpc.ListSessionsRequest{},
+	)
+	require.NoError(t, err)
+
+	logged := buf.String()
+	require.NotContains(t, logged, mac)
+	require.NotContains(t, logged, string(secret))
+	require.NotContains(t, logged, hex.EncodeToString(secret))
+	require.NotContains(t, logged, resp.Session.PairingSecretMnemonic)
+
+	require.Contains(t, logged, session.Fingerprint([]byte(mac)))
+	require.Contains(t, logged, session.Fingerprint(secret))
+}
+
+// TestResumeSessionExpiryGracePeriod makes sure that sessions that expired
+// within the grace period are only marked as expired on resume while sessions
+// that expired before the grace period are revoked.
+func TestResumeSessionExpiryGracePeriod(t *testing.T) {
+	const gracePeriod = time.Hour
+
+	s := newTestSessionRpcServer(t)
+	s.cfg.ExpiryGracePeriod = gracePeriod
+
+	now := time.Now()
+	withinGrace := newTestSession(t, "within", session.TypeUIPassword)
+	withinGrace.Expiry = now.Add(-gracePeriod + time.Minute)
+	require.NoError(t, s.db.StoreSession(withinGrace))
+
+	beyondGrace := newTestSession(t, "beyond", session.TypeUIPassword)
+	beyondGrace.Expiry = now.Add(-gracePeriod - time.Minute)
+	require.NoError(t, s.db.StoreSession(beyondGrace))
+
+	assertState := func(sess *session.Session, state session.State) {
+		t.Helper()
+
+		dbSess, err := s.db.GetSession(sess.LocalPublicKey)
+		require.NoError(t, err)
+		require.Equal(t, state, dbSess.State)
+	}
+
+	require.NoError(t, s.resumeSession(withinGrace, 0))
+	require.NoError(t, s.resumeSession(beyondGrace, 0))
+	require.Empty(t, activeKeys(s))
+
+	assertState(withinGrace, session.StateExpired)
+	assertState(beyondGrace, session.StateRevoked)
+
+	// Once the grace period is over, an expired session is revoked on the
+	// next resume.
+	s.cfg.ExpiryGracePeriod = 0
+	expired, err := s.db.GetSession(withinGrace.LocalPublicKey)
+	require.NoError(t, err)
+	require.NoError(t, s.resumeSession(expired, 0))
+	assertState(withinGrace, session.StateRevoked)
+}
+
+// TestAddSessionMailboxServerAddrs makes sure that all mailbox server addresses
+// of a new session are persisted in order and reported by ListSessions.
+func TestAddSessionMailboxServerAddrs(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:       "failover",
+		SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr: "primary:443",
+		MailboxServerAddrs: []string{
+			"secondary:443", "primary:443", "tertiary:443",
+		},
+	})
+	require.NoError(t, err)
+
+	expected := []string{"primary:443", "secondary:443", "tertiary:443"}
+	require.Equal(t, expected, resp.Session.MailboxServerAddrs)
+
+	pubKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+
+	sess, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, "primary:443", sess.ServerAddr)
+	require.Equal(t, expected[1:], sess.FallbackServerAddrs)
+
+	list, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Sessions, 1)
+	require.Equal(t, expected, list.Sessions[0].MailboxServerAddrs)
+	require.Equal(
+		t, "primary:443", list.Sessions[0].ActiveMailboxServerAddr,
+	)
+}
+
+// TestSuppressPairingSecret makes sure that a suppressed pairing secret is
+// neither returned by AddSession nor ListSessions but can still be revealed
+// explicitly.
+func TestSuppressPairingSecret(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:       "suppressed",
+		SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr:     "localhost:1234",
+		SuppressPairingSecret: true,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Session.SuppressPairingSecret)
+	require.Empty(t, resp.Session.PairingSecret)
+	require.Empty(t, resp.Session.PairingSecretMnemonic)
+
+	list, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Sessions, 1)
+	require.Empty(t, list.Sessions[0].PairingSecret)
+	require.Empty(t, list.Sessions[0].PairingSecretMnemonic)
+
+	// The secret is still stored, so the session can be started.
+	pubKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+	sess, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.NotEqual(t, [mailbox.NumPasswordBytes]byte{}, sess.PairingSecret)
+	require.True(t, s.sessionServer.(*mockSessionServer).isActive(pubKey))
+
+	reveal, err := s.RevealPairingSecret(
+		ctx, &litrpc.RevealPairingSecretRequest{
+			LocalPublicKey: resp.Session.LocalPublicKey,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sess.PairingSecret[:], reveal.PairingSecret)
+
+	words, err := mailbox.PasswordEntropyToMnemonic(sess.PairingSecret)
+	require.NoError(t, err)
+	require.Equal(
+		t, strings.Join(words[:], " "), reveal.PairingSecretMnemonic,
+	)
+}
+
+// TestExpiryTimerDuration makes sure that the expiry timer of a session never
+// fires before the session expires and that the jitter stays within bounds.
+func TestExpiryTimerDuration(t *testing.T) {
+	now := time.Now()
+	expiry := now.Add(time.Hour)
+
+	require.Equal(t, time.Hour, expiryTimerDuration(now, expiry, 0))
+
+	const maxJitter = 5 * time.Second
+	var jittered bool
+	for i := 0; i < 1000; i++ {
+		duration := expiryTimerDuration(now, expiry, maxJitter)
+		require.GreaterOrEqual(t, duration, time.Hour)
+		require.LessOrEqual(t, duration, time.Hour+maxJitter)
+
+		if duration != time.Hour {
+			jittered = true
+		}
+	}
+	require.True(t, jittered)
+}
+
+// TestReplaceSession makes sure that a session is only revoked once its
+// replacement was started successfully.
+func TestReplaceSession(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mockServer := s.sessionServer.(*mockSessionServer)
+	ctx := context.Background()
+
+	old := addTestUISession(t, s, "old")
+	oldKey, err := btcec.ParsePubKey(old.LocalPublicKey, btcec.S256())
+	require.NoError(t, err)
+
+	// A replacement that can't be created leaves the old session intact.
+	_, err = s.ReplaceSession(ctx, &litrpc.ReplaceSessionRequest{
+		LocalPublicKey: old.LocalPublicKey,
+		NewSession: &litrpc.AddSessionRequest{
+			Label:                  "new",
+			SessionType:            litrpc.SessionType_TYPE_UI_PASSWORD,
+			ExpiryTimestampSeconds: uint64(time.Now().Unix() - 1),
+		},
+	})
+	require.Error(t, err)
+
+	oldSess, err := s.db.GetSession(oldKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateCreated, oldSess.State)
+	require.True(t, mockServer.isActive(oldKey))
+
+	sessions, err := s.db.ListSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+
+	// A successful replacement revokes the old session.
+	resp, err := s.ReplaceSession(ctx, &litrpc.ReplaceSessionRequest{
+		LocalPublicKey: old.LocalPublicKey,
+		NewSession: &litrpc.AddSessionRequest{
+			Label:       "new",
+			SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.OldSessionRevoked)
+	require.Equal(t, "new", resp.Session.Label)
+
+	oldSess, err = s.db.GetSession(oldKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateRevoked, oldSess.State)
+	require.False(t, mockServer.isActive(oldKey))
+
+	newKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+	require.True(t, mockServer.isActive(newKey))
+
+	// A revoked session can't be replaced.
+	_, err = s.ReplaceSession(ctx, &litrpc.ReplaceSessionRequest{
+		LocalPublicKey: old.LocalPublicKey,
+		NewSession:     &litrpc.AddSessionRequest{},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// TestReplaceSessionTransferLabel makes sure that a replacement that transfers
+// the label takes over the old label and revokes the old session.
+func TestReplaceSessionTransferLabel(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mockServer := s.sessionServer.(*mockSessionServer)
+	ctx := context.Background()
+
+	old := addTestUISession(t, s, "prod-loop-bot")
+	oldKey, err := btcec.ParsePubKey(old.LocalPublicKey, btcec.S256())
+	require.NoError(t, err)
+
+	newSession := &litrpc.AddSessionRequest{
+		SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr: "localhost:1234",
+	}
+
+	// A different label is rejected and leaves the old session intact.
+	newSession.Label = "other"
+	_, err = s.ReplaceSession(ctx, &litrpc.ReplaceSessionRequest{
+		LocalPublicKey: old.LocalPublicKey,
+		NewSession:     newSession,
+		TransferLabel:  true,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.True(t, mockServer.isActive(oldKey))
+
+	newSession.Label = ""
+	resp, err := s.ReplaceSession(ctx, &litrpc.ReplaceSessionRequest{
+		LocalPublicKey: old.LocalPublicKey,
+		NewSession:     newSession,
+		TransferLabel:  true,
+	})
+	require.NoError(t, err)
+	require.True(t, resp.OldSessionRevoked)
+	require.Equal(t, "prod-loop-bot", resp.Session.Label)
+
+	oldSess, err := s.db.GetSession(oldKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateRevoked, oldSess.State)
+	require.Equal(t, revokeReasonReplaced, oldSess.RevokeReason)
+	require.False(t, mockServer.isActive(oldKey))
+
+	newKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+	require.True(t, mockServer.isActive(newKey))
+
+	// The caller's request isn't modified.
+	require.Empty(t, newSession.Label)
+}
+
+// TestReplaceSessionTransferLabelStartFailure makes sure that the old session
+// of a replacement that transfers the label stays valid and running if the new
+// session can't be started.
+func TestReplaceSessionTransferLabelStartFailure(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mockServer := s.sessionServer.(*mockSessionServer)
+	ctx := context.Background()
+
+	old := addTestUISession(t, s, "prod-loop-bot")
+	oldKey, err := btcec.ParsePubKey(old.LocalPublicKey, btcec.S256())
+	require.NoError(t, err)
+
+	mockServer.startErr = errors.New("mailbox unreachable")
+	_, err = s.ReplaceSession(ctx, &litrpc.ReplaceSessionRequest{
+		LocalPublicKey: old.LocalPublicKey,
+		NewSession: &litrpc.AddSessionRequest{
+			SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		},
+		TransferLabel: true,
+	})
+	require.Error(t, err)
+
+	// The old session keeps its state, its label and its running mailbox
+	// connection.
+	oldSess, err := s.db.GetSession(oldKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateCreated, oldSess.State)
+	require.Equal(t, "prod-loop-bot", oldSess.Label)
+	require.Empty(t, oldSess.RevokeReason)
+	require.True(t, mockServer.isActive(oldKey))
+
+	// The new session was revoked again.
+	sessions, err := s.db.ListSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	for _, sess := range sessions {
+		if sess.LocalPublicKey.IsEqual(oldKey) {
+			continue
+		}
+
+		require.Equal(t, session.StateRevoked, sess.State)
+		require.Equal(t, revokeReasonStartFailed, sess.RevokeReason)
+		require.False(t, mockServer.isActive(sess.LocalPublicKey))
+	}
+}
+
+// TestSessionPermissionOverrides makes sure that the configured permission
+// overrides are applied to the macaroon recipe of readonly and admin sessions.
+func TestSessionPermissionOverrides(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.ReadOnlyAddPerms = []string{"lnd:write"}
+	s.cfg.ReadOnlyRemovePerms = []string{"offchain:read"}
+	s.cfg.AdminRemovePerms = []string{"macaroon:generate"}
+	require.NoError(t, s.cfg.validate())
+
+	var recipes []*session.MacaroonRecipe
+	s.superMacBaker = func(_ context.Context, _ uint64,
+		recipe *session.MacaroonRecipe) (string, error) {
+
+		recipes = append(recipes, recipe)
+		return "mac", nil
+	}
+
+	for _, typ := range []litrpc.SessionType{
+		litrpc.SessionType_TYPE_MACAROON_READONLY,
+		litrpc.SessionType_TYPE_MACAROON_ADMIN,
+	} {
+		_, err := s.AddSession(
+			context.Background(), &litrpc.AddSessionRequest{
+				Label:       typ.String(),
+				SessionType: typ,
+				ExpiryTimestampSeconds: uint64(
+					time.Now().Add(time.Hour).Unix(),
+				),
+				MailboxServerAddr: "localhost:1234",
+			},
+		)
+		require.NoError(t, err)
+	}
+	require.Len(t, recipes, 2)
+
+	readOnly, admin := recipes[0].Permissions, recipes[1].Permissions
+	require.Contains(t, readOnly, bakery.Op{Entity: "lnd", Action: "write"})
+	require.NotContains(
+		t, readOnly, bakery.Op{Entity: "offchain", Action: "read"},
+	)
+	require.Contains(t, readOnly, bakery.Op{Entity: "onchain", Action: "read"})
+
+	require.NotContains(
+		t, admin, bakery.Op{Entity: "macaroon", Action: "generate"},
+	)
+	require.Contains(t, admin, bakery.Op{Entity: "offchain", Action: "read"})
+
+	// The overrides are also reported by ListSessionTypes.
+	resp, err := s.ListSessionTypes(
+		context.Background(), &litrpc.ListSessionTypesRequest{},
+	)
+	require.NoError(t, err)
+	for _, info := range resp.SessionTypes {
+		if info.Type != litrpc.SessionType_TYPE_MACAROON_READONLY {
+			continue
+		}
+
+		require.Contains(t, info.Permissions, &litrpc.MacaroonPermission{
+			Entity: "lnd",
+			Action: "write",
+		})
+	}
+
+	// Invalid overrides are rejected.
+	s.cfg.AdminAddPerms = []string{"invalid"}
+	require.Error(t, s.cfg.validate())
+}
+
+// TestListSessionsIsRunning makes sure that ListSessions reports whether a
+// session is actually running independent of its persisted state.
+func TestListSessionsIsRunning(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	running := addTestUISession(t, s, "running")
+
+	failed := newTestSession(t, "failed", session.TypeUIPassword)
+	failed.State = session.StateInUse
+	require.NoError(t, s.db.StoreSession(failed))
+
+	mockServer.mu.Lock()
+	mockServer.startErr = fmt.Errorf("mailbox unreachable")
+	mockServer.mu.Unlock()
+	require.Error(t, s.resumeSession(failed, 0))
+
+	resp, err := s.ListSessions(
+		context.Background(), &litrpc.ListSessionsRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 2)
+
+	for _, sess := range resp.Sessions {
+		switch sess.Label {
+		case running.Label:
+			require.True(t, sess.IsRunning)
+
+		case failed.Label:
+			require.Equal(
+				t, litrpc.SessionState_STATE_IN_USE,
+				sess.SessionState,
+			)
+			require.False(t, sess.IsRunning)
+
+		default:
+			t.Fatalf("unexpected session %v", sess.Label)
+		}
+	}
+}
+
+// TestAutoRenewSession makes sure that the macaroon of an auto renewing session
+// is re-baked periodically with a short expiry and that the renewal parameters
+// are persisted.
+func TestAutoRenewSession(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	var (
+		mu      sync.Mutex
+		recipes []*session.MacaroonRecipe
+	)
+	s.superMacBaker = func(_ context.Context, _ uint64,
+		recipe *session.MacaroonRecipe) (string, error) {
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		recipes = append(recipes, recipe)
+		return fmt.Sprintf("mac%d", len(recipes)), nil
+	}
+
+	sess := newTestSession(t, "renew", session.TypeMacaroonReadonly)
+	sess.AutoRenewUntil = time.Now().Add(time.Hour)
+	sess.RenewInterval = 20 * time.Millisecond
+	require.NoError(t, s.storeAndStartSession(sess, 0))
+
+	id := string(sess.LocalPublicKey.SerializeCompressed())
+	mockServer.mu.Lock()
+	quit := mockServer.active[id]
+	mockServer.mu.Unlock()
+
+	// The initial bake plus at least two renewals.
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+
+		return len(recipes) >= 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	for _, recipe := range recipes {
+		require.Len(t, recipe.Caveats, 1)
+		require.Contains(
+			t, string(recipe.Caveats[0].Id), "time-before",
+		)
+	}
+	mu.Unlock()
+
+	// The renewed macaroon is handed out by the same mailbox connection,
+	// the session was never restarted.
+	mockServer.mu.Lock()
+	authData := string(mockServer.authData[id])
+	active := mockServer.active[id]
+	mockServer.mu.Unlock()
+	require.NotEqual(t, HeaderMacaroon+": mac1", authData)
+	require.Equal(t, quit, active)
+
+	dbSess, err := s.db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.True(t, dbSess.AutoRenews())
+	require.Equal(
+		t, sess.AutoRenewUntil.Unix(), dbSess.AutoRenewUntil.Unix(),
+	)
+	require.Equal(t, sess.RenewInterval, dbSess.RenewInterval)
+
+	// UI password sessions can't be renewed.
+	_, err = s.AddSession(context.Background(), &litrpc.AddSessionRequest{
+		Label:       "ui",
+		SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		AutoRenewUntil: uint64(
+			time.Now().Add(time.Minute).Unix(),
+		),
+		RenewIntervalSeconds: 10,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestAddSessionDuplicateKey makes sure that a new session is rejected with
+// AlreadyExists if its local public key belongs to a different session.
+func TestAddSessionDuplicateKey(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	sess := newTestSession(t, "original", session.TypeUIPassword)
+	require.NoError(t, s.storeAndStartSession(sess, 0))
+
+	colliding := newTestSession(t, "colliding", session.TypeUIPassword)
+	colliding.LocalPublicKey = sess.LocalPublicKey
+	colliding.LocalPrivateKey = sess.LocalPrivateKey
+	colliding.Revision = sess.Revision
+
+	err := s.storeAndStartSession(colliding, 0)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+// TestPairingSecretMnemonicGolden pins the mnemonic of a fixed pairing secret,
+// so a change of the word list or its order, which would break clients that
+// stored the mnemonic of a session, doesn't go unnoticed.
+func TestPairingSecretMnemonicGolden(t *testing.T) {
+	sess := newTestSession(t, "golden", session.TypeUIPassword)
+	sess.PairingSecret = [mailbox.NumPasswordBytes]byte{
+		0x01, 0x12, 0x23, 0x34, 0x45, 0x56, 0x67, 0x78, 0x89, 0x9a,
+		0xab, 0xbc, 0xcd, 0xde,
+	}
+
+	const golden = "absurd much snack melt grid rough chapter fever " +
+		"rubber humble"
+
+	require.Equal(t, session.MnemonicVersionMailbox, sess.MnemonicVersion)
+	mnemonic, warning := pairingSecretMnemonic(sess)
+	require.Equal(t, golden, mnemonic)
+	require.Empty(t, warning)
+
+	rpcSess, err := marshalRPCSession(sess)
+	require.NoError(t, err)
+	require.Equal(t, golden, rpcSess.PairingSecretMnemonic)
+	require.EqualValues(
+		t, session.MnemonicVersionMailbox, rpcSess.MnemonicVersion,
+	)
+
+	// A session with a mnemonic scheme we don't know doesn't get a
+	// mnemonic at all rather than one its client can't use.
+	sess.MnemonicVersion = session.MnemonicVersionMailbox + 1
+	mnemonic, warning = pairingSecretMnemonic(sess)
+	require.Empty(t, mnemonic)
+	require.NotEmpty(t, warning)
+}
+
+// TestGetSessionMnemonic makes sure that the mnemonic of a session is only
+// returned as long as the session wasn't paired yet.
+func TestGetSessionMnemonic(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	sess := newTestSession(t, "mnemonic", session.TypeUIPassword)
+	require.NoError(t, s.db.StoreSession(sess))
+
+	req := &litrpc.GetSessionMnemonicRequest{
+		LocalPublicKey: sess.LocalPublicKey.SerializeCompressed(),
+	}
+	resp, err := s.GetSessionMnemonic(ctx, req)
+	require.NoError(t, err)
+	mnemonic, _ := pairingSecretMnemonic(sess)
+	require.Equal(t, mnemonic, resp.PairingSecretMnemonic)
+	require.Empty(t, resp.PairingSecret)
+
+	req.IncludePairingSecret = true
+	resp, err = s.GetSessionMnemonic(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, sess.PairingSecret[:], resp.PairingSecret)
+
+	// Once the session is paired, the mnemonic is no longer needed.
+	sess.State = session.StateInUse
+	require.NoError(t, s.db.StoreSession(sess))
+
+	_, err = s.GetSessionMnemonic(ctx, req)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	unknown := newTestSession(t, "unknown", session.TypeUIPassword)
+	_, err = s.GetSessionMnemonic(ctx, &litrpc.GetSessionMnemonicRequest{
+		LocalPublicKey: unknown.LocalPublicKey.SerializeCompressed(),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestAddSessions makes sure that a batch of sessions is added independently,
+// so invalid requests only fail themselves, and that the limit of active
+// sessions is respected across the batch.
+func TestAddSessions(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.MaxActiveSessions = 2
+
+	uiType := litrpc.SessionType_TYPE_UI_PASSWORD
+	newReq := func(label string,
+		expiry time.Time) *litrpc.AddSessionRequest {
+
+		return &litrpc.AddSessionRequest{
+			Label:                  label,
+			SessionType:            uiType,
+			ExpiryTimestampSeconds: uint64(expiry.Unix()),
+			MailboxServerAddr:      "localhost:1234",
+		}
+	}
+	valid := time.Now().Add(time.Hour)
+
+	resp, err := s.AddSessions(
+		context.Background(), &litrpc.AddSessionsRequest{
+			Sessions: []*litrpc.AddSessionRequest{
+				newReq("first", valid),
+				newReq("expired", time.Now().Add(-time.Hour)),
+				newReq("second", valid),
+				newReq("third", valid),
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 4)
+
+	require.Empty(t, resp.Results[0].Error)
+	require.Equal(t, "first", resp.Results[0].Session.Label)
+
+	require.NotEmpty(t, resp.Results[1].Error)
+	require.Nil(t, resp.Results[1].Session)
+
+	require.Empty(t, resp.Results[2].Error)
+	require.Equal(t, "second", resp.Results[2].Session.Label)
+
+	// The third session exceeds the limit of active sessions.
+	require.Contains(t, resp.Results[3].Error, "maximum number")
+	require.Nil(t, resp.Results[3].Session)
+
+	sessions, err := s.db.ListSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+}
+
+// TestActiveSessionLimitStates makes sure that sessions that aren't served
+// right now but aren't revoked or expired either still count towards the limit
+// of active sessions.
+func TestActiveSessionLimitStates(t *testing.T) {
+	for _, state := range []session.State{
+		session.StatePendingApproval, session.StateSuspended,
+		session.StateQuarantined,
+	} {
+		s := newTestSessionRpcServer(t)
+		s.cfg.MaxActiveSessions = 1
+
+		sess := newTestSession(t, "inactive", session.TypeUIPassword)
+		sess.State = state
+		require.NoError(t, s.db.StoreSession(sess))
+
+		req := &litrpc.AddSessionRequest{
+			Label:       "new",
+			SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		}
+		_, err := s.AddSession(context.Background(), req)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	}
+}
+
+// hangingSessionServer is a mailboxSessionServer that doesn't return from
+// StartSession until it is released.
+type hangingSessionServer struct {
+	*mockSessionServer
+
+	release chan struct{}
+}
+
+// StartSession blocks until the server is released and then starts the
+// session.
+func (h *hangingSessionServer) StartSession(sess *session.Session,
+	authData []byte, timeout time.Duration) (chan struct{}, error) {
+
+	<-h.release
+	return h.mockSessionServer.StartSession(sess, authData, timeout)
+}
+
+// silentListener starts a TCP listener that accepts connections but never
+// answers, like a mailbox server that never completes the handshake. Its
+// address is returned.
+func silentListener(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var (
+		connsMtx sync.Mutex
+		conns    []net.Conn
+	)
+	t.Cleanup(func() {
+		_ = listener.Close()
+
+		connsMtx.Lock()
+		defer connsMtx.Unlock()
+
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+	})
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			connsMtx.Lock()
+			conns = append(conns, conn)
+			connsMtx.Unlock()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+// TestStartSessionTimeout makes sure that adding a session fails with
+// DeadlineExceeded if none of its mailbox servers completes the handshake in
+// time and that the session isn't left running then.
+func TestStartSessionTimeout(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.StartTimeout = 50 * time.Millisecond
+
+	sessionServer := session.NewServer(
+		func(opts ...grpc.ServerOption) *grpc.Server {
+			return grpc.NewServer(opts...)
+		},
+	)
+	t.Cleanup(sessionServer.Stop)
+	s.sessionServer = sessionServer
+
+	addr := silentListener(t)
+	newReq := func(label string) *litrpc.AddSessionRequest {
+		return &litrpc.AddSessionRequest{
+			Label:       label,
+			SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: addr,
+		}
+	}
+
+	_, err := s.AddSession(context.Background(), newReq("default"))
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// The configured timeout can be overwritten for a single session.
+	s.cfg.StartTimeout = 0
+	req := newReq("override")
+	req.StartTimeoutSeconds = 1
+
+	start := time.Now()
+	_, err = s.AddSession(context.Background(), req)
+	require.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	require.GreaterOrEqual(t, time.Since(start), time.Second)
+
+	// Neither of the timed out sessions is left running or usable.
+	sessions, err := s.db.ListSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	for _, sess := range sessions {
+		pubKey := sess.LocalPublicKey
+		require.Nil(t, sessionServer.ConnectionInfo(pubKey))
+		require.False(t, s.isActive(pubKey))
+		require.Equal(t, session.StateRevoked, sess.State)
+	}
+}
+
+// TestListSessionEvents makes sure that the lifecycle actions of a session are
+// recorded and returned by ListSessionEvents.
+func TestListSessionEvents(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	pubKey := addTestUISession(t, s, "events").LocalPublicKey
+	addTestUISession(t, s, "other")
+
+	_, err := s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: pubKey,
+	})
+	require.NoError(t, err)
+
+	// The session is stopped in the background, so we wait until all
+	// events were recorded.
+	var events []*litrpc.SessionEvent
+	require.Eventually(t, func() bool {
+		resp, err := s.ListSessionEvents(
+			ctx, &litrpc.ListSessionEventsRequest{
+				LocalPublicKey: pubKey,
+			},
+		)
+		require.NoError(t, err)
+
+		events = resp.Events
+		return len(events) == 4
+	}, time.Second, 10*time.Millisecond)
+
+	require.Equal(t, litrpc.SessionEventType_EVENT_CREATED, events[0].Type)
+	require.Equal(t, litrpc.SessionEventType_EVENT_STARTED, events[1].Type)
+
+	types := []litrpc.SessionEventType{events[2].Type, events[3].Type}
+	require.ElementsMatch(t, []litrpc.SessionEventType{
+		litrpc.SessionEventType_EVENT_STOPPED,
+		litrpc.SessionEventType_EVENT_REVOKED,
+	}, types)
+	for _, event := range events {
+		require.Equal(t, pubKey, event.LocalPublicKey)
+	}
+
+	// A time range in the past doesn't select any events.
+	past, err := s.ListSessionEvents(ctx, &litrpc.ListSessionEventsRequest{
+		EndTimestampSeconds: uint64(time.Now().Add(-time.Hour).Unix()),
+	})
+	require.NoError(t, err)
+	require.Empty(t, past.Events)
+
+	all, err := s.ListSessionEvents(ctx, &litrpc.ListSessionEventsRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Events, 6)
+}
+
+// TestCustomSession makes sure that custom sessions can be added, that their
+// macaroon gets exactly the requested permissions and that a custom session
+// without any permissions is rejected.
+func TestCustomSession(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	var recipes []*session.MacaroonRecipe
+	s.superMacBaker = func(_ context.Context, _ uint64,
+		recipe *session.MacaroonRecipe) (string, error) {
+
+		recipes = append(recipes, recipe)
+		return "mac", nil
+	}
+
+	custom := litrpc.SessionType_TYPE_MACAROON_CUSTOM
+	newReq := func(
+		perms ...*litrpc.MacaroonPermission) *litrpc.AddSessionRequest {
+
+		return &litrpc.AddSessionRequest{
+			Label:       "custom",
+			SessionType: custom,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr:         "localhost:1234",
+			MacaroonCustomPermissions: perms,
+		}
+	}
+
+	// A custom session must grant at least one permission, it doesn't
+	// fall back to the admin permissions.
+	_, err := s.AddSession(ctx, newReq())
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "at least one permission")
+
+	_, err = s.AddSession(ctx, newReq(&litrpc.MacaroonPermission{
+		Entity: "offchain",
+	}))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Empty(t, recipes)
+
+	resp, err := s.AddSession(ctx, newReq(&litrpc.MacaroonPermission{
+		Entity: "offchain", Action: "write",
+	}))
+	require.NoError(t, err)
+	require.Equal(t, custom, resp.Session.SessionType)
+
+	require.Len(t, recipes, 1)
+	require.Equal(t, []bakery.Op{{
+		Entity: "offchain", Action: "write",
+	}}, recipes[0].Permissions)
+
+	// Explicit permissions are only allowed for custom sessions.
+	req := newReq(&litrpc.MacaroonPermission{
+		Entity: "offchain", Action: "write",
+	})
+	req.SessionType = litrpc.SessionType_TYPE_MACAROON_ADMIN
+	_, err = s.AddSession(ctx, req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The session type survives a round trip through the DB.
+	list, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Sessions, 1)
+	require.Equal(t, custom, list.Sessions[0].SessionType)
+}
+
+// failingStore is a session store whose StoreSession always fails with the
+// given error, like a database on a full disk.
+type failingStore struct {
+	sessionStore
+
+	storeErr error
+}
+
+// StoreSession returns the configured error.
+func (f *failingStore) StoreSession(*session.Session) error {
+	return f.storeErr
+}
+
+// TestAddSessionStoreFailure makes sure that a failure to store a new session
+// is reported with a clear error code and that no session is left running.
+func TestAddSessionStoreFailure(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mock := s.sessionServer.(*mockSessionServer)
+
+	fullErr := &os.PathError{
+		Op: "write", Path: "sessions.db", Err: syscall.ENOSPC,
+	}
+	tests := []struct {
+		name     string
+		storeErr error
+		code     codes.Code
+	}{{
+		name:     "disk full",
+		storeErr: fullErr,
+		code:     codes.ResourceExhausted,
+	}, {
+		name:     "read-only",
+		storeErr: bbolt.ErrDatabaseReadOnly,
+		code:     codes.Internal,
+	}, {
+		name:     "other",
+		storeErr: fmt.Errorf("unexpected"),
+		code:     codes.Internal,
+	}}
+	uiType := litrpc.SessionType_TYPE_UI_PASSWORD
+	expiry := uint64(time.Now().Add(time.Hour).Unix())
+	db := s.db
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			s.db = &failingStore{
+				sessionStore: db,
+				storeErr:     test.storeErr,
+			}
+
+			req := &litrpc.AddSessionRequest{
+				Label:                  test.name,
+				SessionType:            uiType,
+				ExpiryTimestampSeconds: expiry,
+				MailboxServerAddr:      "localhost:1234",
+			}
+			_, err := s.AddSession(context.Background(), req)
+			require.Equal(t, test.code, status.Code(err))
+		})
+	}
+
+	mock.mu.Lock()
+	require.Empty(t, mock.active)
+	mock.mu.Unlock()
+	require.Empty(t, activeKeys(s))
+
+	// No session goroutine may have been started.
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("session goroutine still running")
+	}
+}
+
+// callerContext returns a context of an incoming RPC call that authenticates
+// with a macaroon with the given ID.
+func callerContext(t *testing.T, id string) context.Context {
+	mac, err := macaroon.New(
+		[]byte("root key"), []byte(id), "lnd", macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+
+	macBytes, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	return metadata.NewIncomingContext(
+		context.Background(), metadata.Pairs(
+			HeaderMacaroon, hex.EncodeToString(macBytes),
+		),
+	)
+}
+
+// TestListSessionsMineOnly makes sure that sessions are attributed to the
+// caller that created them and that callers can list only their own sessions.
+func TestListSessionsMineOnly(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	alice := callerContext(t, "alice")
+	bob := callerContext(t, "bob")
+
+	newReq := func(label string) *litrpc.AddSessionRequest {
+		return &litrpc.AddSessionRequest{
+			Label:       label,
+			SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		}
+	}
+
+	for _, label := range []string{"alice 1", "alice 2"} {
+		_, err := s.AddSession(alice, newReq(label))
+		require.NoError(t, err)
+	}
+	_, err := s.AddSession(bob, newReq("bob"))
+	require.NoError(t, err)
+
+	// A session can also be attributed to an explicit owner.
+	explicit := newReq("explicit")
+	explicit.OwnerId = "tenant"
+	_, err = s.AddSession(bob, explicit)
+	require.NoError(t, err)
+
+	listLabels := func(ctx context.Context,
+		req *litrpc.ListSessionsRequest) []string {
+
+		resp, err := s.ListSessions(ctx, req)
+		require.NoError(t, err)
+
+		var labels []string
+		for _, sess := range resp.Sessions {
+			require.NotEmpty(t, sess.OwnerId)
+			labels = append(labels, sess.Label)
+		}
+
+		return labels
+	}
+
+	mineOnly := &litrpc.ListSessionsRequest{MineOnly: true}
+	require.ElementsMatch(
+		t, []string{"alice 1", "alice 2"}, listLabels(alice, mineOnly),
+	)
+	require.ElementsMatch(t, []string{"bob"}, listLabels(bob, mineOnly))
+	require.ElementsMatch(t, []string{"explicit"}, listLabels(
+		bob, &litrpc.ListSessionsRequest{
+			MineOnly: true,
+			OwnerId:  "tenant",
+		},
+	))
+
+	// Without the flag, all sessions are listed.
+	require.Len(t, listLabels(bob, &litrpc.ListSessionsRequest{}), 4)
+
+	// Callers without a macaroon can't be identified.
+	_, err = s.ListSessions(context.Background(), mineOnly)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestRevokeSessionStopRetry makes sure that stopping the mailbox connection
+// of a revoked session is retried after transient errors.
+func TestRevokeSessionStopRetry(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mock := s.sessionServer.(*mockSessionServer)
+
+	sess := addTestUISession(t, s, "retry")
+	pubKey, err := btcec.ParsePubKey(sess.LocalPublicKey, btcec.S256())
+	require.NoError(t, err)
+
+	mock.mu.Lock()
+	mock.stopErrs = []error{
+		fmt.Errorf("transient"), fmt.Errorf("transient"),
+	}
+	mock.mu.Unlock()
+
+	_, err = s.RevokeSession(
+		context.Background(), &litrpc.RevokeSessionRequest{
+			LocalPublicKey: sess.LocalPublicKey,
+		},
+	)
+	require.NoError(t, err)
+
+	mock.mu.Lock()
+	require.Empty(t, mock.stopErrs)
+	mock.mu.Unlock()
+	require.False(t, mock.isActive(pubKey))
+
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateRevoked, stored.State)
+
+	// A canceled request stops retrying, but the session stays revoked.
+	other := addTestUISession(t, s, "canceled")
+	otherKey, err := btcec.ParsePubKey(other.LocalPublicKey, btcec.S256())
+	require.NoError(t, err)
+
+	mock.mu.Lock()
+	mock.stopErrs = []error{fmt.Errorf("transient")}
+	mock.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: other.LocalPublicKey,
+	})
+	require.NoError(t, err)
+	require.True(t, mock.isActive(otherKey))
+
+	stored, err = s.db.GetSession(otherKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateRevoked, stored.State)
+}
+
+// TestSingleUseSession makes sure that a single-use session is revoked once its
+// mailbox connection ends, while a normal session and a paused single-use
+// session are left untouched.
+func TestSingleUseSession(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mock := s.sessionServer.(*mockSessionServer)
+	ctx := context.Background()
+
+	addSession := func(label string, singleUse bool) *btcec.PublicKey {
+		resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:       label,
+			SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+			SingleUse:         singleUse,
+		})
+		require.NoError(t, err)
+		require.Equal(t, singleUse, resp.Session.SingleUse)
+
+		pubKey, err := btcec.ParsePubKey(
+			resp.Session.LocalPublicKey, btcec.S256(),
+		)
+		require.NoError(t, err)
+
+		return pubKey
+	}
+
+	singleKey := addSession("single", true)
+	normalKey := addSession("normal", false)
+
+	// The flag is persisted with the session.
+	stored, err := s.db.GetSession(singleKey)
+	require.NoError(t, err)
+	require.True(t, stored.SingleUse)
+
+	// Simulate both clients disconnecting by ending their mailbox
+	// connections behind the server's back.
+	require.NoError(t, mock.StopSession(singleKey))
+	require.NoError(t, mock.StopSession(normalKey))
+
+	require.Eventually(t, func() bool {
+		stored, err := s.db.GetSession(singleKey)
+		require.NoError(t, err)
+
+		return stored.State == session.StateRevoked
+	}, time.Second, 10*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		return !s.isActive(normalKey)
+	}, time.Second, 10*time.Millisecond)
+
+	stored, err = s.db.GetSession(normalKey)
+	require.NoError(t, err)
+	require.NotEqual(t, session.StateRevoked, stored.State)
+
+	// Pausing a single-use session doesn't count as a disconnect.
+	pausedKey := addSession("paused", true)
+	_, err = s.PauseAllSessions(ctx, &litrpc.PauseAllSessionsRequest{})
+	require.NoError(t, err)
+
+	s.stop()
+	stored, err = s.db.GetSession(pausedKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateCreated, stored.State)
+}
+
+// TestValidatePermissions makes sure that known and unknown permissions are
+// told apart and reported in the order they were requested.
+func TestValidatePermissions(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	perm := func(entity, action string) *litrpc.MacaroonPermission {
+		return &litrpc.MacaroonPermission{
+			Entity: entity,
+			Action: action,
+		}
+	}
+
+	resp, err := s.ValidatePermissions(
+		context.Background(), &litrpc.ValidatePermissionsRequest{
+			Permissions: []*litrpc.MacaroonPermission{
+				perm("offchain", "read"),
+				perm("bogus", "read"),
+				perm("onchain", "write"),
+				perm("offchain", "read"),
+				perm("offchain", "fly"),
+				perm("bogus", "read"),
+				perm("", ""),
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, []*litrpc.MacaroonPermission{
+		perm("offchain", "read"), perm("onchain", "write"),
+	}, resp.ValidPermissions)
+	require.Equal(t, []*litrpc.MacaroonPermission{
+		perm("bogus", "read"), perm("offchain", "fly"), perm("", ""),
+	}, resp.UnknownPermissions)
+
+	// Nothing is created as a side effect.
+	sessions, err := s.db.ListSessions()
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+// TestSessionStartingState makes sure that a session is reported as starting
+// while its mailbox connection is being started and goes back to the created
+// state once it is ready for pairing.
+func TestSessionStartingState(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	hanging := &hangingSessionServer{
+		mockSessionServer: newMockSessionServer(),
+		release:           make(chan struct{}),
+	}
+	s.sessionServer = hanging
+
+	ctx := context.Background()
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:       "starting",
+			SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		})
+		errChan <- err
+	}()
+
+	listState := func() litrpc.SessionState {
+		resp, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{})
+		require.NoError(t, err)
+		if len(resp.Sessions) != 1 {
+			return litrpc.SessionState_STATE_REVOKED
+		}
+
+		return resp.Sessions[0].SessionState
+	}
+
+	startingState := litrpc.SessionState_STATE_STARTING
+	require.Eventually(t, func() bool {
+		return listState() == startingState
+	}, time.Second, 10*time.Millisecond)
+
+	close(hanging.release)
+	require.NoError(t, <-errChan)
+	require.Equal(t, litrpc.SessionState_STATE_CREATED, listState())
+}
+
+// TestRefreshSessionMacaroon makes sure that a new macaroon is baked for a
+// running session and handed to its mailbox connection without restarting it.
+func TestRefreshSessionMacaroon(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mock := s.sessionServer.(*mockSessionServer)
+
+	var (
+		macMtx sync.Mutex
+		mac    = "old-mac"
+	)
+	s.superMacBaker = func(context.Context, uint64,
+		*session.MacaroonRecipe) (string, error) {
+
+		macMtx.Lock()
+		defer macMtx.Unlock()
+
+		return mac, nil
+	}
+
+	sess := newTestSession(t, "refresh", session.TypeMacaroonAdmin)
+	require.NoError(t, s.storeAndStartSession(sess, 0))
+
+	id := string(sess.LocalPublicKey.SerializeCompressed())
+	mock.mu.Lock()
+	quit := mock.active[id]
+	require.Contains(t, string(mock.authData[id]), "old-mac")
+	mock.mu.Unlock()
+
+	// The node's macaroon root key was rotated, so the baker now returns
+	// a different macaroon.
+	macMtx.Lock()
+	mac = "new-mac"
+	macMtx.Unlock()
+
+	ctx := context.Background()
+	pubKey := sess.LocalPublicKey.SerializeCompressed()
+	_, err := s.RefreshSessionMacaroon(
+		ctx, &litrpc.RefreshSessionMacaroonRequest{
+			LocalPublicKey: pubKey,
+		},
+	)
+	require.NoError(t, err)
+
+	mock.mu.Lock()
+	require.Contains(t, string(mock.authData[id]), "new-mac")
+	require.Equal(t, quit, mock.active[id])
+	mock.mu.Unlock()
+
+	select {
+	case <-quit:
+		t.Fatalf("session was restarted")
+	default:
+	}
+
+	// A UI password session doesn't have a macaroon to refresh.
+	uiSess := addTestUISession(t, s, "ui")
+	_, err = s.RefreshSessionMacaroon(
+		ctx, &litrpc.RefreshSessionMacaroonRequest{
+			LocalPublicKey: uiSess.LocalPublicKey,
+		},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A session that isn't running can't be refreshed either.
+	stopped := newTestSession(t, "stopped", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(stopped))
+	pubKey = stopped.LocalPublicKey.SerializeCompressed()
+	_, err = s.RefreshSessionMacaroon(
+		ctx, &litrpc.RefreshSessionMacaroonRequest{
+			LocalPublicKey: pubKey,
+		},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// TestGetSessionConnectURI makes sure that the connect URI of a session parses
+// back to the session's fields and only contains the pairing secret if it was
+// requested and isn't suppressed.
+func TestGetSessionConnectURI(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	sess := newTestSession(t, "uri", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(sess))
+	pubKey := sess.LocalPublicKey.SerializeCompressed()
+
+	resp, err := s.GetSessionConnectURI(
+		ctx, &litrpc.GetSessionConnectURIRequest{
+			LocalPublicKey:       pubKey,
+			IncludePairingSecret: true,
+		},
+	)
+	require.NoError(t, err)
+
+	uri, err := session.ParseConnectURI(resp.Uri)
+	require.NoError(t, err)
+	require.Equal(t, sess.ServerAddr, uri.MailboxServerAddr)
+	require.Equal(t, sess.PairingSecret[:], uri.PairingSecret)
+	require.Equal(t, sess.DevServer, uri.DevServer)
+
+	// Without the flag, the secret is redacted.
+	resp, err = s.GetSessionConnectURI(
+		ctx, &litrpc.GetSessionConnectURIRequest{
+			LocalPublicKey: pubKey,
+		},
+	)
+	require.NoError(t, err)
+
+	uri, err = session.ParseConnectURI(resp.Uri)
+	require.NoError(t, err)
+	require.Nil(t, uri.PairingSecret)
+
+	// A suppressed secret is never put into the URI.
+	suppressed := newTestSession(t, "suppressed", session.TypeMacaroonAdmin)
+	suppressed.SuppressPairingSecret = true
+	require.NoError(t, s.db.StoreSession(suppressed))
+
+	pubKey = suppressed.LocalPublicKey.SerializeCompressed()
+	_, err = s.GetSessionConnectURI(
+		ctx, &litrpc.GetSessionConnectURIRequest{
+			LocalPublicKey:       pubKey,
+			IncludePairingSecret: true,
+		},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// TestGetSessionPairingQR makes sure that the QR payload of a session is its
+// canonical connect URI and that already paired sessions are only included on
+// request.
+func TestGetSessionPairingQR(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	sess := newTestSession(t, "qr", session.TypeMacaroonAdmin)
+	sess.DevServer = true
+	require.NoError(t, s.db.StoreSession(sess))
+	pubKey := sess.LocalPublicKey.SerializeCompressed()
+
+	resp, err := s.GetSessionPairingQR(
+		ctx, &litrpc.GetSessionPairingQRRequest{
+			LocalPublicKey: pubKey,
+		},
+	)
+	require.NoError(t, err)
+
+	expected := fmt.Sprintf("lnc://%s?dev=true&secret=%x", sess.ServerAddr,
+		sess.PairingSecret[:])
+	require.Equal(t, expected, resp.Payload)
+
+	uri, err := session.ParseConnectURI(resp.Payload)
+	require.NoError(t, err)
+	require.Equal(t, sess.ServerAddr, uri.MailboxServerAddr)
+	require.Equal(t, sess.PairingSecret[:], uri.PairingSecret)
+	require.True(t, uri.DevServer)
+	require.Equal(t, resp.Payload, uri.String())
+
+	// Once the session is paired, the payload is only returned if
+	// explicitly requested.
+	err = s.db.UpdateSessionState(sess.LocalPublicKey, session.StateInUse)
+	require.NoError(t, err)
+
+	_, err = s.GetSessionPairingQR(ctx, &litrpc.GetSessionPairingQRRequest{
+		LocalPublicKey: pubKey,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	resp, err = s.GetSessionPairingQR(
+		ctx, &litrpc.GetSessionPairingQRRequest{
+			LocalPublicKey: pubKey,
+			IncludePaired:  true,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, expected, resp.Payload)
+
+	// A suppressed secret is never put into the payload.
+	suppressed := newTestSession(t, "suppressed", session.TypeMacaroonAdmin)
+	suppressed.SuppressPairingSecret = true
+	require.NoError(t, s.db.StoreSession(suppressed))
+
+	_, err = s.GetSessionPairingQR(ctx, &litrpc.GetSessionPairingQRRequest{
+		LocalPublicKey: suppressed.LocalPublicKey.SerializeCompressed(),
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// TestSessionTextLength makes sure that labels and descriptions are limited to
+// the configured number of characters, counting multi-byte characters once.
+func TestSessionTextLength(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.MaxLabelLength = 5
+	s.cfg.MaxDescriptionLength = 8
+	ctx := context.Background()
+
+	addSession := func(label, description string) error {
+		_, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:       label,
+			Description: description,
+			SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		})
+		return err
+	}
+
+	// Each of these characters takes up multiple bytes but only counts as
+	// a single character.
+	require.NoError(t, addSession("äöüß€", "日本語のテキスト"))
+	require.NoError(t, addSession("abcde", "12345678"))
+
+	err := addSession("abcdef", "")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = addSession("äöüß€x", "")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = addSession("label", "日本語のテキストだ")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Updating the description is limited in the same way.
+	sess := newTestSession(t, "desc", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(sess))
+	pubKey := sess.LocalPublicKey.SerializeCompressed()
+
+	_, err = s.UpdateSessionDescription(
+		ctx, &litrpc.UpdateSessionDescriptionRequest{
+			LocalPublicKey: pubKey,
+			Description:    "€€€€€€€€",
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = s.UpdateSessionDescription(
+		ctx, &litrpc.UpdateSessionDescriptionRequest{
+			LocalPublicKey: pubKey,
+			Description:    "€€€€€€€€€",
+		},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	stored, err := s.db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, "€€€€€€€€", stored.Description)
+}
+
+// TestSessionLabelPattern makes sure that only labels matching the configured
+// pattern as a whole are accepted.
+func TestSessionLabelPattern(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	_, err := parseLabelPattern("team-[a-z")
+	require.Error(t, err)
+
+	s.cfg.LabelPattern = `team-[a-z]+-\d+`
+	s.cfg.labelPattern, err = parseLabelPattern(s.cfg.LabelPattern)
+	require.NoError(t, err)
+
+	addSession := func(label string) error {
+		_, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:       label,
+			SessionType: litrpc.SessionType_TYPE_UI_PASSWORD,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		})
+		return err
+	}
+
+	require.NoError(t, addSession("team-ops-1"))
+
+	// The pattern has to match the whole label, not just a part of it.
+	invalid := []string{"ops", "team-ops-1 copy", "my-team-ops-1"}
+	for _, label := range invalid {
+		err := addSession(label)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Contains(t, err.Error(), s.cfg.LabelPattern)
+	}
+
+	// Without a pattern, any label is accepted again.
+	s.cfg.LabelPattern = ""
+	s.cfg.labelPattern, err = parseLabelPattern(s.cfg.LabelPattern)
+	require.NoError(t, err)
+	require.Nil(t, s.cfg.labelPattern)
+	require.NoError(t, addSession("ops"))
+}
+
+// TestListSessionsLabelQuery makes sure that sessions can be searched by a
+// case-insensitive substring of their label and that the search composes with
+// the other filters.
+func TestListSessionsLabelQuery(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	now := time.Now()
+	sessions := map[string]time.Duration{
+		"Alice's phone":  time.Hour,
+		"laptop (ALICE)": 48 * time.Hour,
+		"bob":            time.Hour,
+	}
+	for label, validity := range sessions {
+		sess, err := session.NewSession(
+			label, session.TypeMacaroonAdmin, now.Add(validity),
+			"mailbox.terminal.lightning.today:443", false, nil,
+			nil,
+		)
+		require.NoError(t, err)
+		require.NoError(t, s.db.StoreSession(sess))
+	}
+
+	tests := []struct {
+		name     string
+		req      *litrpc.ListSessionsRequest
+		expected []string
+	}{{
+		name:     "no query",
+		req:      &litrpc.ListSessionsRequest{},
+		expected: []string{"Alice's phone", "laptop (ALICE)", "bob"},
+	}, {
+		name: "case-insensitive",
+		req: &litrpc.ListSessionsRequest{
+			LabelQuery: "alice",
+		},
+		expected: []string{"Alice's phone", "laptop (ALICE)"},
+	}, {
+		name: "substring",
+		req: &litrpc.ListSessionsRequest{
+			LabelQuery: "TOP",
+		},
+		expected: []string{"laptop (ALICE)"},
+	}, {
+		name: "combined with expiry",
+		req: &litrpc.ListSessionsRequest{
+			LabelQuery:   "alice",
+			ExpiryBefore: uint64(now.Add(2 * time.Hour).Unix()),
+		},
+		expected: []string{"Alice's phone"},
+	}, {
+		name: "no match",
+		req: &litrpc.ListSessionsRequest{
+			LabelQuery: "carol",
+		},
+		expected: []string{},
+	}, {
+		name: "not a regex",
+		req: &litrpc.ListSessionsRequest{
+			LabelQuery: "a.*e",
+		},
+		expected: []string{},
+	}}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := s.ListSessions(
+				context.Background(), test.req,
+			)
+			require.NoError(t, err)
+
+			labels := make([]string, 0, len(resp.Sessions))
+			for _, sess := range resp.Sessions {
+				labels = append(labels, sess.Label)
+			}
+			require.ElementsMatch(t, test.expected, labels)
+			require.EqualValues(t, len(test.expected), resp.Total)
+		})
+	}
+}
+
+// TestListSessionsCountOnly makes sure that ListSessions only returns the
+// number of matching sessions if requested, honoring all filters.
+func TestListSessionsCountOnly(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	for _, label := range []string{"alice", "alice-2", "bob"} {
+		sess := newTestSession(t, label, session.TypeMacaroonAdmin)
+		sess.GroupID = "customer"
+		require.NoError(t, s.db.StoreSession(sess))
+	}
+	other := newTestSession(t, "alice-3", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(other))
+
+	resp, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{
+		CountOnly: true,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 4, resp.Total)
+	require.Empty(t, resp.Sessions)
+
+	resp, err = s.ListSessions(ctx, &litrpc.ListSessionsRequest{
+		LabelQuery: "alice",
+		GroupId:    "customer",
+		CountOnly:  true,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, resp.Total)
+	require.Empty(t, resp.Sessions)
+}
+
+// concurrencySessionServer is a mailboxSessionServer whose StartSession takes
+// a while and that records how many sessions are started at the same time and
+// in which order.
+type concurrencySessionServer struct {
+	*mockSessionServer
+
+	// delay is the time each StartSession call takes. If release is set,
+	// the calls block until it is closed instead.
+	delay   time.Duration
+	release chan struct{}
+
+	countMtx   sync.Mutex
+	running    int
+	maxRunning int
+	numStarted int
+	startOrder []string
+}
+
+// StartSession starts the session after the configured delay or once the
+// server is released.
+func (c *concurrencySessionServer) StartSession(sess *session.Session,
+	authData []byte, timeout time.Duration) (chan struct{}, error) {
+
+	c.countMtx.Lock()
+	c.running++
+	c.numStarted++
+	c.startOrder = append(c.startOrder, sess.Label)
+	if c.running > c.maxRunning {
+		c.maxRunning = c.running
+	}
+	release := c.release
+	c.countMtx.Unlock()
+
+	defer func() {
+		c.countMtx.Lock()
+		c.running--
+		c.countMtx.Unlock()
+	}()
+
+	if release != nil {
+		<-release
+	} else {
+		time.Sleep(c.delay)
+	}
+
+	return c.mockSessionServer.StartSession(sess, authData, timeout)
+}
+
+// numStartedSessions returns the number of StartSession calls so far.
+func (c *concurrencySessionServer) numStartedSessions() int {
+	c.countMtx.Lock()
+	defer c.countMtx.Unlock()
+
+	return c.numStarted
+}
+
+// TestResumeSessionsConcurrency makes sure that no more than the configured
+// number of sessions are resumed at the same time, that all of them are
+// eventually resumed and that stopping the server aborts the queued resumes.
+func TestResumeSessionsConcurrency(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.StartupConcurrency = 2
+
+	server := &concurrencySessionServer{
+		mockSessionServer: newMockSessionServer(),
+		delay:             20 * time.Millisecond,
+	}
+	s.sessionServer = server
+
+	sessions := make([]*session.Session, 7)
+	for i := range sessions {
+		sess := newTestSession(
+			t, fmt.Sprintf("session %d", i),
+			session.TypeMacaroonAdmin,
+		)
+		require.NoError(t, s.db.StoreSession(sess))
+		sessions[i] = sess
+	}
+
+	s.resumeSessions(sessions, 0)
+	require.Len(t, activeKeys(s), len(sessions))
+	require.Equal(t, len(sessions), server.numStartedSessions())
+
+	server.countMtx.Lock()
+	require.Equal(t, 2, server.maxRunning)
+	server.numStarted = 0
+	server.release = make(chan struct{})
+	server.countMtx.Unlock()
+
+	_, err := s.PauseAllSessions(
+		context.Background(), &litrpc.PauseAllSessionsRequest{},
+	)
+	require.NoError(t, err)
+
+	// Once the server is stopped, the queued sessions aren't resumed
+	// anymore, only the ones that were already being started finish.
+	done := make(chan struct{})
+	go func() {
+		s.resumeSessions(sessions, 0)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		return server.numStartedSessions() == 2
+	}, time.Second, time.Millisecond)
+
+	s.stop()
+	close(server.release)
+	<-done
+	require.Equal(t, 2, server.numStartedSessions())
+}
+
+// TestResumeSessionsPriority makes sure that sessions with a higher priority
+// are resumed first and that sessions with the same priority keep their order.
+func TestResumeSessionsPriority(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.StartupConcurrency = 1
+
+	server := &concurrencySessionServer{
+		mockSessionServer: newMockSessionServer(),
+	}
+	s.sessionServer = server
+
+	var sessions []*session.Session
+	addSession := func(label string, typ session.Type, priority uint32) {
+		sess := newTestSession(t, label, typ)
+		sess.Priority = priority
+		require.NoError(t, s.db.StoreSession(sess))
+		sessions = append(sessions, sess)
+	}
+	addSession("readonly 1", session.TypeMacaroonReadonly, 0)
+	addSession("admin", session.TypeMacaroonAdmin, 10)
+	addSession("readonly 2", session.TypeMacaroonReadonly, 0)
+	addSession("readonly 3", session.TypeMacaroonReadonly, 5)
+
+	s.resumeSessions(sessions, 0)
+	require.Equal(t, []string{
+		"admin", "readonly 3", "readonly 1", "readonly 2",
+	}, server.startOrder)
+
+	// The given slice itself isn't reordered.
+	require.Equal(t, "readonly 1", sessions[0].Label)
+}
+
+// TestResumeSessionsFailure makes sure that a session whose mailbox server
+// can't be reached on startup doesn't keep the other sessions from being
+// resumed and that its failure is recorded as its resume status.
+func TestResumeSessionsFailure(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.StartupConcurrency = 1
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	sessions := make([]*session.Session, 3)
+	for i := range sessions {
+		sess := newTestSession(
+			t, fmt.Sprintf("session %d", i),
+			session.TypeMacaroonAdmin,
+		)
+		require.NoError(t, s.db.StoreSession(sess))
+		sessions[i] = sess
+	}
+
+	mockServer.mu.Lock()
+	mockServer.startErrs = []error{
+		status.Error(codes.DeadlineExceeded, "mailbox unreachable"),
+	}
+	mockServer.mu.Unlock()
+
+	s.resumeSessions(sessions, time.Second)
+
+	require.False(t, s.isActive(sessions[0].LocalPublicKey))
+	require.True(t, s.isActive(sessions[1].LocalPublicKey))
+	require.True(t, s.isActive(sessions[2].LocalPublicKey))
+
+	failed, err := s.db.GetSession(sessions[0].LocalPublicKey)
+	require.NoError(t, err)
+	require.Contains(t, failed.LastResumeError, "mailbox unreachable")
+}
+
+// TestGetServerStatus makes sure that the session goroutines are accounted for
+// and that none of them are left behind after sessions were added and revoked.
+func TestGetServerStatus(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	serverStatus := func() *litrpc.GetServerStatusResponse {
+		resp, err := s.GetServerStatus(
+			ctx, &litrpc.GetServerStatusRequest{},
+		)
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	for i := 0; i < 5; i++ {
+		sess := addTestUISession(t, s, fmt.Sprintf("cycle %d", i))
+
+		require.Eventually(t, func() bool {
+			return serverStatus().SessionGoroutines == 1
+		}, time.Second, time.Millisecond)
+
+		resp := serverStatus()
+		require.EqualValues(t, 1, resp.WaitGroupGoroutines)
+		require.EqualValues(t, 1, resp.RunningSessions)
+		require.EqualValues(t, 1, resp.DbActiveSessions)
+
+		_, err := s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+			LocalPublicKey: sess.LocalPublicKey,
+		})
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			resp := serverStatus()
+			return resp.SessionGoroutines == 0 &&
+				resp.WaitGroupGoroutines == 0 &&
+				resp.RunningSessions == 0
+		}, time.Second, time.Millisecond)
+		require.Zero(t, serverStatus().DbActiveSessions)
+	}
+
+	// A suspended session isn't running but still counts as active, just
+	// like it does for the limit of active sessions.
+	suspended := newTestSession(t, "suspended", session.TypeUIPassword)
+	suspended.State = session.StateSuspended
+	require.NoError(t, s.db.StoreSession(suspended))
+
+	resp := serverStatus()
+	require.Zero(t, resp.RunningSessions)
+	require.EqualValues(t, 1, resp.DbActiveSessions)
+}
+
+// TestInactivityExpiry makes sure that a session with an inactivity expiry only
+// expires once no client connected to it for the configured duration and that
+// its absolute expiry is still a hard deadline.
+func TestInactivityExpiry(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mock := s.sessionServer.(*mockSessionServer)
+
+	isRevoked := func(sess *session.Session) bool {
+		stored, err := s.db.GetSession(sess.LocalPublicKey)
+		require.NoError(t, err)
+
+		return stored.State == session.StateRevoked
+	}
+
+	sess := newTestSession(t, "inactive", session.TypeMacaroonAdmin)
+	sess.InactivityExpiry = 100 * time.Millisecond
+	require.NoError(t, s.storeAndStartSession(sess, 0))
+
+	// As long as clients keep connecting, the session doesn't expire.
+	for i := 0; i < 10; i++ {
+		time.Sleep(30 * time.Millisecond)
+		mock.connect(sess.LocalPublicKey)
+	}
+	require.False(t, isRevoked(sess))
+	require.True(t, mock.isActive(sess.LocalPublicKey))
+
+	// Once the clients stop connecting, the session expires.
+	require.Eventually(t, func() bool {
+		return isRevoked(sess)
+	}, time.Second, 10*time.Millisecond)
+	require.False(t, mock.isActive(sess.LocalPublicKey))
+
+	// The absolute expiry caps the inactivity expiry, even if the session
+	// is in use.
+	capped := newTestSession(t, "capped", session.TypeMacaroonAdmin)
+	capped.Expiry = time.Now().Add(200 * time.Millisecond)
+	capped.InactivityExpiry = time.Hour
+	require.NoError(t, s.storeAndStartSession(capped, 0))
+
+	stopConnecting := make(chan struct{})
+	defer close(stopConnecting)
+	go func() {
+		for {
+			select {
+			case <-time.After(20 * time.Millisecond):
+				mock.connect(capped.LocalPublicKey)
+
+			case <-stopConnecting:
+				return
+			}
+		}
+	}()
+
+	require.Eventually(t, func() bool {
+		return isRevoked(capped)
+	}, time.Second, 10*time.Millisecond)
+}
+
+// TestRevokeExpiredSessions makes sure that all sessions past their expiry are
+// revoked, regardless of their state, while all other sessions are left alone.
+func TestRevokeExpiredSessions(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	storeSession := func(label string, expiry time.Time,
+		state session.State) *session.Session {
+
+		sess := newTestSession(t, label, session.TypeMacaroonAdmin)
+		sess.Expiry = expiry
+		require.NoError(t, s.db.StoreSession(sess))
+		require.NoError(t, s.db.UpdateSessionState(
+			sess.LocalPublicKey, state,
+		))
+
+		return sess
+	}
+
+	past := time.Now().Add(-time.Hour)
+	expired := []*session.Session{
+		storeSession("created", past, session.StateCreated),
+		storeSession("in use", past, session.StateInUse),
+		storeSession("expired", past, session.StateExpired),
+	}
+	storeSession("revoked", past, session.StateRevoked)
+	active := storeSession(
+		"active", time.Now().Add(time.Hour), session.StateCreated,
+	)
+
+	resp, err := s.RevokeExpiredSessions(
+		ctx, &litrpc.RevokeExpiredSessionsRequest{},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, len(expired), resp.NumRevoked)
+
+	for _, sess := range expired {
+		stored, err := s.db.GetSession(sess.LocalPublicKey)
+		require.NoError(t, err)
+		require.Equal(t, session.StateRevoked, stored.State, sess.Label)
+	}
+
+	stored, err := s.db.GetSession(active.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateCreated, stored.State)
+
+	// Running it again doesn't find anything left to revoke.
+	resp, err = s.RevokeExpiredSessions(
+		ctx, &litrpc.RevokeExpiredSessionsRequest{},
+	)
+	require.NoError(t, err)
+	require.Zero(t, resp.NumRevoked)
+}
+
+// TestCustomSessionMacaroonRootKeyID makes sure that an explicit macaroon root
+// key ID is validated, used to bake the macaroon of a custom session and
+// persisted.
+func TestCustomSessionMacaroonRootKeyID(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	var rootKeyIDs []uint64
+	s.superMacBaker = func(_ context.Context, rootKeyID uint64,
+		_ *session.MacaroonRecipe) (string, error) {
+
+		rootKeyIDs = append(rootKeyIDs, rootKeyID)
+		return "mac", nil
+	}
+
+	rootKeyID := session.NewSuperMacaroonRootKeyID([4]byte{1, 2, 3, 4})
+	newReq := func(typ litrpc.SessionType,
+		id uint64) *litrpc.AddSessionRequest {
+
+		req := &litrpc.AddSessionRequest{
+			Label:       typ.String(),
+			SessionType: typ,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+			MacaroonRootKeyId: id,
+		}
+		if typ == litrpc.SessionType_TYPE_MACAROON_CUSTOM {
+			req.MacaroonCustomPermissions = infoReadPermission()
+		}
+
+		return req
+	}
+	custom := litrpc.SessionType_TYPE_MACAROON_CUSTOM
+
+	// Only root key IDs with the super macaroon prefix are accepted.
+	_, err := s.AddSession(ctx, newReq(custom, 123))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Other session types don't accept an explicit root key ID.
+	req := newReq(litrpc.SessionType_TYPE_MACAROON_ADMIN, rootKeyID)
+	_, err = s.AddSession(ctx, req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Empty(t, rootKeyIDs)
+
+	resp, err := s.AddSession(ctx, newReq(custom, rootKeyID))
+	require.NoError(t, err)
+	require.EqualValues(t, rootKeyID, resp.Session.MacaroonRootKeyId)
+	require.Equal(t, []uint64{rootKeyID}, rootKeyIDs)
+
+	pubKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, rootKeyID, stored.MacaroonRootKey)
+
+	// Without an explicit ID, the root key ID is derived from the key.
+	resp, err = s.AddSession(ctx, newReq(custom, 0))
+	require.NoError(t, err)
+	require.NotEqual(t, rootKeyID, resp.Session.MacaroonRootKeyId)
+	require.True(t, session.IsSuperMacaroonRootKeyID(
+		resp.Session.MacaroonRootKeyId,
+	))
+}
+
+// TestRevokeSessionReason makes sure that the time and reason of a revocation
+// are recorded, returned with the session and not overwritten by revoking the
+// session again.
+func TestRevokeSessionReason(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	sess := addTestUISession(t, s, "revoke reason")
+	require.Zero(t, sess.RevokedAt)
+	require.Empty(t, sess.RevokeReason)
+
+	before := time.Now().Unix()
+	_, err := s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: sess.LocalPublicKey,
+		Reason:         "lost device",
+	})
+	require.NoError(t, err)
+
+	_, err = s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: sess.LocalPublicKey,
+		Reason:         "second attempt",
+	})
+	require.NoError(t, err)
+
+	resp, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 1)
+
+	revoked := resp.Sessions[0]
+	require.Equal(
+		t, litrpc.SessionState_STATE_REVOKED, revoked.SessionState,
+	)
+	require.Equal(t, "lost device", revoked.RevokeReason)
+	require.GreaterOrEqual(t, revoked.RevokedAt, uint64(before))
+	require.LessOrEqual(t, revoked.RevokedAt, uint64(time.Now().Unix()))
+
+	// Sessions that are revoked internally carry their own reason.
+	expired := newTestSession(t, "expired", session.TypeMacaroonAdmin)
+	expired.Expiry = time.Now().Add(-time.Hour)
+	require.NoError(t, s.db.StoreSession(expired))
+
+	_, err = s.RevokeExpiredSessions(
+		ctx, &litrpc.RevokeExpiredSessionsRequest{},
+	)
+	require.NoError(t, err)
+
+	stored, err := s.db.GetSession(expired.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, revokeReasonExpired, stored.RevokeReason)
+	require.False(t, stored.RevokedAt.IsZero())
+}
+
+// TestParseSessionTypeString makes sure that each session type alias is
+// converted to the right RPC type regardless of its case and that unknown
+// aliases are rejected.
+func TestParseSessionTypeString(t *testing.T) {
+	tests := map[string]litrpc.SessionType{
+		"admin":      litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		"readonly":   litrpc.SessionType_TYPE_MACAROON_READONLY,
+		"custom":     litrpc.SessionType_TYPE_MACAROON_CUSTOM,
+		"password":   litrpc.SessionType_TYPE_UI_PASSWORD,
+		"ADMIN":      litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		"ReadOnly":   litrpc.SessionType_TYPE_MACAROON_READONLY,
+		" Password ": litrpc.SessionType_TYPE_UI_PASSWORD,
+	}
+	for alias, expected := range tests {
+		typ, err := ParseSessionTypeString(alias)
+		require.NoError(t, err, alias)
+		require.Equal(t, expected, typ, alias)
+	}
+
+	for _, alias := range []string{
+		"", "superuser", "account", "TYPE_MACAROON_ADMIN",
+	} {
+		_, err := ParseSessionTypeString(alias)
+		require.Error(t, err, alias)
+		require.Contains(t, err.Error(), "supported types are")
+	}
+}
+
+// TestResumeSessionStatus makes sure that the reason a session couldn't be
+// resumed is recorded on the session and cleared again by a successful resume.
+func TestResumeSessionStatus(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	s.superMacBaker = func(context.Context, uint64,
+		*session.MacaroonRecipe) (string, error) {
+
+		return "", fmt.Errorf("lnd unavailable")
+	}
+
+	sess := newTestSession(t, "resume status", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(sess))
+
+	before := time.Now().Unix()
+	require.NoError(t, s.resumeSession(sess, 0))
+	require.False(t, s.isActive(sess.LocalPublicKey))
+
+	resp, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 1)
+	require.Contains(
+		t, resp.Sessions[0].LastResumeError, "lnd unavailable",
+	)
+	require.GreaterOrEqual(
+		t, resp.Sessions[0].LastResumeAttempt, uint64(before),
+	)
+
+	// Once the macaroon can be baked again, the session is resumed and
+	// the error is cleared.
+	s.superMacBaker = func(context.Context, uint64,
+		*session.MacaroonRecipe) (string, error) {
+
+		return "mac", nil
+	}
+
+	stored, err := s.db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.NoError(t, s.resumeSession(stored, 0))
+	require.True(t, s.isActive(sess.LocalPublicKey))
+
+	stored, err = s.db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Empty(t, stored.LastResumeError)
+	require.False(t, stored.LastResumeAttempt.IsZero())
+}
+
+// TestSessionActivation makes sure that a session with an activation time is
+// stored right away but only started once the activation time is reached, and
+// that pausing all sessions cancels pending activations.
+func TestSessionActivation(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	expiry := time.Now().Add(time.Hour)
+	req := &litrpc.AddSessionRequest{
+		Label:                  "scheduled",
+		SessionType:            litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(expiry.Unix()),
+		MailboxServerAddr:      "localhost:1234",
+	}
+
+	// The activation must be before the expiry.
+	req.ActivationTimestampSeconds = uint64(expiry.Unix())
+	_, err := s.AddSession(ctx, req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	req.ActivationTimestampSeconds = uint64(
+		time.Now().Add(30 * time.Minute).Unix(),
+	)
+	resp, err := s.AddSession(ctx, req)
+	require.NoError(t, err)
+	require.Equal(
+		t, litrpc.SessionState_STATE_SCHEDULED,
+		resp.Session.SessionState,
+	)
+	require.Equal(
+		t, req.ActivationTimestampSeconds,
+		resp.Session.ActivationTimestampSeconds,
+	)
+	require.Empty(t, activeKeys(s))
+
+	// A session that activates shortly isn't started before its
+	// activation but right after it.
+	sess := newTestSession(t, "soon", session.TypeMacaroonAdmin)
+	sess.ActivationTime = time.Now().Add(200 * time.Millisecond)
+	require.NoError(t, s.storeAndStartSession(sess, 0))
+	require.False(t, s.isActive(sess.LocalPublicKey))
+
+	require.Eventually(t, func() bool {
+		return s.isActive(sess.LocalPublicKey)
+	}, time.Second, 10*time.Millisecond)
+	require.False(t, time.Now().Before(sess.ActivationTime))
+
+	stored, err := s.db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	rpcSession, err := marshalRPCSession(stored)
+	require.NoError(t, err)
+	require.Equal(
+		t, litrpc.SessionState_STATE_CREATED, rpcSession.SessionState,
+	)
+
+	// Pausing all sessions cancels a pending activation, resuming them
+	// schedules it again.
+	paused := newTestSession(t, "paused", session.TypeMacaroonAdmin)
+	paused.ActivationTime = time.Now().Add(200 * time.Millisecond)
+	require.NoError(t, s.storeAndStartSession(paused, 0))
+
+	_, err = s.PauseAllSessions(ctx, &litrpc.PauseAllSessionsRequest{})
+	require.NoError(t, err)
+
+	time.Sleep(400 * time.Millisecond)
+	require.False(t, s.isActive(paused.LocalPublicKey))
+
+	_, err = s.ResumeAllSessions(ctx, &litrpc.ResumeAllSessionsRequest{})
+	require.NoError(t, err)
+	require.True(t, s.isActive(paused.LocalPublicKey))
+}
+
+// TestImportMacaroonAsSession makes sure that an imported lnd macaroon results
+// in a custom session that is baked with the macaroon's permissions under a new
+// root key and that macaroons with unknown caveats are rejected.
+func TestImportMacaroonAsSession(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	var (
+		rootKeyIDs []uint64
+		recipes    []*session.MacaroonRecipe
+	)
+	s.superMacBaker = func(_ context.Context, rootKeyID uint64,
+		recipe *session.MacaroonRecipe) (string, error) {
+
+		rootKeyIDs = append(rootKeyIDs, rootKeyID)
+		recipes = append(recipes, recipe)
+		return "mac", nil
+	}
+
+	// We use a macaroon with a single permission and a time caveat.
+	permID, err := proto.Marshal(&lnrpc.MacaroonId{
+		Nonce:     []byte("nonce"),
+		StorageId: []byte("0"),
+		Ops: []*lnrpc.Op{{
+			Entity:  "offchain",
+			Actions: []string{"read", "write"},
+		}},
+	})
+	require.NoError(t, err)
+	mac, err := macaroon.New(
+		[]byte("root key"),
+		append([]byte{byte(bakery.LatestVersion)}, permID...), "lnd",
+		macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+	timeCaveat := "time-before 2030-01-01T00:00:00Z"
+	require.NoError(t, mac.AddFirstPartyCaveat([]byte(timeCaveat)))
+
+	newReq := func(
+		mac *macaroon.Macaroon) *litrpc.ImportMacaroonAsSessionRequest {
+
+		macBytes, err := mac.MarshalBinary()
+		require.NoError(t, err)
+
+		return &litrpc.ImportMacaroonAsSessionRequest{
+			Macaroon: hex.EncodeToString(macBytes),
+			Label:    "imported",
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		}
+	}
+
+	resp, err := s.ImportMacaroonAsSession(ctx, newReq(mac))
+	require.NoError(t, err)
+	require.Equal(
+		t, litrpc.SessionType_TYPE_MACAROON_CUSTOM,
+		resp.Session.SessionType,
+	)
+
+	require.Len(t, recipes, 1)
+	require.Equal(t, []bakery.Op{
+		{Entity: "offchain", Action: "read"},
+		{Entity: "offchain", Action: "write"},
+	}, recipes[0].Permissions)
+	require.Len(t, recipes[0].Caveats, 1)
+	require.Equal(t, timeCaveat, string(recipes[0].Caveats[0].Id))
+	require.True(t, session.IsSuperMacaroonRootKeyID(rootKeyIDs[0]))
+	require.EqualValues(t, rootKeyIDs[0], resp.Session.MacaroonRootKeyId)
+
+	// A macaroon with a caveat we don't understand is rejected.
+	require.NoError(t, mac.AddFirstPartyCaveat([]byte("unknown caveat")))
+	_, err = s.ImportMacaroonAsSession(ctx, newReq(mac))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "unsupported caveat")
+
+	_, err = s.ImportMacaroonAsSession(
+		ctx, &litrpc.ImportMacaroonAsSessionRequest{Macaroon: "zz"},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestAddSessionLocalKeySeed makes sure that the local key of a custom session
+// can be derived from a seed, that the same seed can't be used twice and that
+// other session types don't accept a seed.
+func TestAddSessionLocalKeySeed(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	seed := bytes.Repeat([]byte{0xaa}, session.MinKeySeedLen)
+	newReq := func(seed []byte) *litrpc.AddSessionRequest {
+		return &litrpc.AddSessionRequest{
+			Label:       "derived",
+			SessionType: litrpc.SessionType_TYPE_MACAROON_CUSTOM,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr:         "localhost:1234",
+			LocalKeySeed:              seed,
+			MacaroonCustomPermissions: infoReadPermission(),
+		}
+	}
+
+	expectedKey, err := session.DeriveLocalKey(seed)
+	require.NoError(t, err)
+
+	resp, err := s.AddSession(ctx, newReq(seed))
+	require.NoError(t, err)
+	require.Equal(
+		t, expectedKey.PubKey().SerializeCompressed(),
+		resp.Session.LocalPublicKey,
+	)
+
+	// A session with the derived key already exists.
+	_, err = s.AddSession(ctx, newReq(seed))
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// A different seed results in a different key.
+	otherSeed := bytes.Repeat([]byte{0xbb}, session.MinKeySeedLen)
+	resp, err = s.AddSession(ctx, newReq(otherSeed))
+	require.NoError(t, err)
+	require.NotEqual(
+		t, expectedKey.PubKey().SerializeCompressed(),
+		resp.Session.LocalPublicKey,
+	)
+
+	_, err = s.AddSession(ctx, newReq(seed[:10]))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	req := newReq(bytes.Repeat([]byte{0xcc}, session.MinKeySeedLen))
+	req.SessionType = litrpc.SessionType_TYPE_MACAROON_ADMIN
+	_, err = s.AddSession(ctx, req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestListSessionsIncludeRecipe makes sure that the macaroon recipe of a
+// session is only listed if requested and never for UI password sessions.
+func TestListSessionsIncludeRecipe(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	const caveat = "ipaddr 127.0.0.1"
+	custom := newTestSession(t, "custom", session.TypeMacaroonCustom)
+	custom.MacaroonRecipe = &session.MacaroonRecipe{
+		Caveats: []macaroon.Caveat{{Id: []byte(caveat)}},
+	}
+	require.NoError(t, s.db.StoreSession(custom))
+
+	perms := []bakery.Op{{Entity: "info", Action: "read"}}
+	withPerms := newTestSession(t, "perms", session.TypeMacaroonCustom)
+	withPerms.MacaroonRecipe = &session.MacaroonRecipe{Permissions: perms}
+	require.NoError(t, s.db.StoreSession(withPerms))
+
+	ui := newTestSession(t, "ui", session.TypeUIPassword)
+	ui.MacaroonRecipe = &session.MacaroonRecipe{Permissions: perms}
+	require.NoError(t, s.db.StoreSession(ui))
+
+	recipes := func(include bool) map[string]*litrpc.MacaroonRecipe {
+		resp, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{
+			IncludeRecipe: include,
+		})
+		require.NoError(t, err)
+
+		recipes := make(map[string]*litrpc.MacaroonRecipe)
+		for _, sess := range resp.Sessions {
+			recipes[sess.Label] = sess.MacaroonRecipe
+		}
+
+		return recipes
+	}
+
+	listed := recipes(false)
+	require.Len(t, listed, 3)
+	require.Nil(t, listed["custom"])
+	require.Nil(t, listed["perms"])
+	require.Nil(t, listed["ui"])
+
+	listed = recipes(true)
+	require.Nil(t, listed["ui"])
+	require.NotNil(t, listed["custom"])
+	require.Empty(t, listed["custom"].Permissions)
+	require.Equal(t, []string{caveat}, listed["custom"].Caveats)
+	require.Equal(t, []*litrpc.MacaroonPermission{{
+		Entity: "info",
+		Action: "read",
+	}}, listed["perms"].Permissions)
+}
+
+// TestAddSessionPermissionTemplate makes sure that a custom session that
+// references a permission template gets exactly the template's permissions
+// and that unknown templates are rejected.
+func TestAddSessionPermissionTemplate(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	templates, err := parsePermissionTemplates([]string{
+		"invoices=invoices:read,invoices:write",
+		"info=info:read",
+	})
+	require.NoError(t, err)
+	s.cfg.permissionTemplates = templates
+
+	var recipes []*session.MacaroonRecipe
+	s.superMacBaker = func(_ context.Context, _ uint64,
+		recipe *session.MacaroonRecipe) (string, error) {
+
+		recipes = append(recipes, recipe)
+		return "mac", nil
+	}
+
+	newReq := func(typ litrpc.SessionType,
+		template string) *litrpc.AddSessionRequest {
+
+		return &litrpc.AddSessionRequest{
+			Label:       "template",
+			SessionType: typ,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr:  "localhost:1234",
+			PermissionTemplate: template,
+		}
+	}
+
+	custom := litrpc.SessionType_TYPE_MACAROON_CUSTOM
+	_, err = s.AddSession(ctx, newReq(custom, "invoices"))
+	require.NoError(t, err)
+	require.Len(t, recipes, 1)
+	require.Equal(t, []bakery.Op{
+		{Entity: "invoices", Action: "read"},
+		{Entity: "invoices", Action: "write"},
+	}, recipes[0].Permissions)
+
+	_, err = s.AddSession(ctx, newReq(custom, "unknown"))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Templates can't be used for the other session types.
+	readonly := litrpc.SessionType_TYPE_MACAROON_READONLY
+	req := newReq(readonly, "info")
+	_, err = s.AddSession(ctx, req)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := s.ListPermissionTemplates(
+		ctx, &litrpc.ListPermissionTemplatesRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Templates, 2)
+	require.Equal(t, "info", resp.Templates[0].Name)
+	require.Equal(t, "invoices", resp.Templates[1].Name)
+	require.Len(t, resp.Templates[1].Permissions, 2)
+
+	_, err = parsePermissionTemplates([]string{
+		"info=info:read", "info=x:y",
+	})
+	require.Error(t, err)
+	_, err = parsePermissionTemplates([]string{"=info:read"})
+	require.Error(t, err)
+}
+
+// TestSessionExpiryWarning makes sure that subscribers are warned about a
+// running session the configured lead time before it expires, but not about a
+// session that was revoked before the warning was due.
+func TestSessionExpiryWarning(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.ExpiryWarning = 2 * time.Second
+	ctx := context.Background()
+
+	sub, err := s.db.(*session.DB).SubscribeStateChanges()
+	require.NoError(t, err)
+	defer sub.Cancel()
+
+	// Both sessions expire in two to three seconds, so the warning is due
+	// within the first second.
+	expiry := uint64(time.Now().Add(3 * time.Second).Unix())
+	admin := litrpc.SessionType_TYPE_MACAROON_ADMIN
+	addSession := func(label string) *litrpc.Session {
+		resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:                  label,
+			SessionType:            admin,
+			ExpiryTimestampSeconds: expiry,
+			MailboxServerAddr:      "localhost:1234",
+		})
+		require.NoError(t, err)
+
+		return resp.Session
+	}
+	addSession("warned")
+	revoked := addSession("revoked")
+
+	_, err = s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: revoked.LocalPublicKey,
+	})
+	require.NoError(t, err)
+
+	// We collect all updates until the first session is revoked because
+	// it expired.
+	var warnings []string
+	for {
+		var change *session.StateChange
+		select {
+		case update := <-sub.Updates():
+			change = update.(*session.StateChange)
+
+		case <-time.After(10 * time.Second):
+			t.Fatalf("session didn't expire")
+		}
+
+		if change.ExpiringSoon {
+			require.Equal(t, session.StateCreated, change.NewState)
+			warnings = append(warnings, change.Session.Label)
+			continue
+		}
+
+		if change.Session.Label == "warned" &&
+			change.NewState == session.StateRevoked {
+
+			break
+		}
+	}
+
+	require.Equal(t, []string{"warned"}, warnings)
+}
+
+// TestCheckMailboxServer makes sure that a reachable mailbox server is
+// reported as such, that an unreachable address reports the dial error and
+// that no session is created in either case.
+func TestCheckMailboxServer(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	// A TLS server that speaks HTTP/2 is all a gRPC connection needs to
+	// be established.
+	server := httptest.NewUnstartedServer(http.NotFoundHandler())
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	reachableAddr := server.Listener.Addr().String()
+	req := &litrpc.CheckMailboxServerRequest{
+		MailboxServerAddr:  reachableAddr,
+		DevServer:          true,
+		InsecureSkipVerify: true,
+	}
+	resp, err := s.CheckMailboxServer(ctx, req)
+	require.NoError(t, err)
+	require.True(t, resp.Reachable)
+	require.Empty(t, resp.Error)
+
+	// We use the address of a listener that is closed again, so nothing
+	// accepts connections on it.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	unreachableAddr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	resp, err = s.CheckMailboxServer(ctx, &litrpc.CheckMailboxServerRequest{
+		MailboxServerAddr: unreachableAddr,
+		TimeoutSeconds:    1,
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Reachable)
+	require.NotEmpty(t, resp.Error)
+
+	_, err = s.CheckMailboxServer(ctx, &litrpc.CheckMailboxServerRequest{
+		MailboxServerAddr:  reachableAddr,
+		InsecureSkipVerify: true,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	sessions, err := s.db.ListSessions()
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+// TestMigrateSessionMailbox makes sure that migrating a running session to a
+// new mailbox server restarts it on the new server with the same local key and
+// pairing secret.
+func TestMigrateSessionMailbox(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:       "migrate",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr: "old.mailbox:443",
+	})
+	require.NoError(t, err)
+
+	pubKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "old.mailbox:443", mockServer.ActiveServerAddr(pubKey))
+
+	migrated, err := s.MigrateSessionMailbox(
+		ctx, &litrpc.MigrateSessionMailboxRequest{
+			LocalPublicKey:    resp.Session.LocalPublicKey,
+			MailboxServerAddr: "new.mailbox:443",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, resp.Session.LocalPublicKey,
+		migrated.Session.LocalPublicKey,
+	)
+	require.Equal(
+		t, resp.Session.PairingSecret, migrated.Session.PairingSecret,
+	)
+	require.Equal(t, "new.mailbox:443", migrated.Session.MailboxServerAddr)
+
+	require.True(t, s.isActive(pubKey))
+	require.True(t, mockServer.isActive(pubKey))
+	require.Equal(t, "new.mailbox:443", mockServer.ActiveServerAddr(pubKey))
+
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, "new.mailbox:443", stored.ServerAddr)
+
+	// Revoked sessions can't be migrated.
+	_, err = s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: resp.Session.LocalPublicKey,
+	})
+	require.NoError(t, err)
+	_, err = s.MigrateSessionMailbox(
+		ctx, &litrpc.MigrateSessionMailboxRequest{
+			LocalPublicKey:    resp.Session.LocalPublicKey,
+			MailboxServerAddr: "other.mailbox:443",
+		},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+// TestLazyMacaroonBaking makes sure that the macaroon of a session is only
+// baked once a client connects to it in lazy mode and that UI password
+// sessions still get their authentication data right away.
+func TestLazyMacaroonBaking(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.LazyMacaroons = true
+	ctx := context.Background()
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	var numBaked int
+	s.superMacBaker = func(context.Context, uint64,
+		*session.MacaroonRecipe) (string, error) {
+
+		numBaked++
+		return "mac", nil
+	}
+
+	addSession := func(typ litrpc.SessionType) *btcec.PublicKey {
+		resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:       "lazy",
+			SessionType: typ,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		})
+		require.NoError(t, err)
+
+		pubKey, err := btcec.ParsePubKey(
+			resp.Session.LocalPublicKey, btcec.S256(),
+		)
+		require.NoError(t, err)
+
+		return pubKey
+	}
+	authData := func(pubKey *btcec.PublicKey) []byte {
+		mockServer.mu.Lock()
+		defer mockServer.mu.Unlock()
+
+		return mockServer.authData[string(pubKey.SerializeCompressed())]
+	}
+
+	admin := addSession(litrpc.SessionType_TYPE_MACAROON_ADMIN)
+	require.True(t, mockServer.isActive(admin))
+	require.Zero(t, numBaked)
+	require.Nil(t, authData(admin))
+
+	// The macaroon is baked on the first connection only.
+	mockServer.connect(admin)
+	require.Equal(t, 1, numBaked)
+	require.Contains(t, string(authData(admin)), "mac")
+
+	mockServer.connect(admin)
+	require.Equal(t, 1, numBaked)
+
+	ui := addSession(litrpc.SessionType_TYPE_UI_PASSWORD)
+	require.Contains(t, string(authData(ui)), s.basicAuth)
+}
+
+// TestSessionSecondsUntilExpiry makes sure that a marshaled session carries the
+// time left until its expiry, which is zero or negative once it expired.
+func TestSessionSecondsUntilExpiry(t *testing.T) {
+	sess := newTestSession(t, "expiry", session.TypeMacaroonAdmin)
+	sess.Expiry = time.Now().Add(time.Hour)
+
+	rpcSession, err := marshalRPCSession(sess)
+	require.NoError(t, err)
+	require.InDelta(t, 3600, rpcSession.SecondsUntilExpiry, 5)
+
+	sess.Expiry = time.Now().Add(-time.Minute)
+	rpcSession, err = marshalRPCSession(sess)
+	require.NoError(t, err)
+	require.LessOrEqual(t, rpcSession.SecondsUntilExpiry, int64(-59))
+}
+
+// TestRevokeSessionGroup makes sure that revoking a group only revokes and
+// stops the sessions of that group and that sessions can be listed by group.
+func TestRevokeSessionGroup(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	addSession := func(label, groupID string) *btcec.PublicKey {
+		resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:       label,
+			SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+			GroupId:           groupID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, groupID, resp.Session.GroupId)
+
+		pubKey, err := btcec.ParsePubKey(
+			resp.Session.LocalPublicKey, btcec.S256(),
+		)
+		require.NoError(t, err)
+
+		return pubKey
+	}
+
+	alice1 := addSession("alice 1", "alice")
+	alice2 := addSession("alice 2", "alice")
+	bob := addSession("bob", "bob")
+	ungrouped := addSession("ungrouped", "")
+
+	listResp, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{
+		GroupId: "alice",
+	})
+	require.NoError(t, err)
+	require.Len(t, listResp.Sessions, 2)
+	for _, sess := range listResp.Sessions {
+		require.Equal(t, "alice", sess.GroupId)
+	}
+
+	_, err = s.RevokeSessionGroup(ctx, &litrpc.RevokeSessionGroupRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := s.RevokeSessionGroup(
+		ctx, &litrpc.RevokeSessionGroupRequest{
+			GroupId: "alice",
+			Reason:  "offboarded",
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, resp.NumRevoked)
+
+	for _, pubKey := range []*btcec.PublicKey{alice1, alice2} {
+		stored, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+		require.Equal(t, session.StateRevoked, stored.State)
+		require.Equal(t, "offboarded", stored.RevokeReason)
+		require.False(t, mockServer.isActive(pubKey))
+	}
+
+	for _, pubKey := range []*btcec.PublicKey{bob, ungrouped} {
+		stored, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+		require.NotEqual(t, session.StateRevoked, stored.State)
+		require.True(t, mockServer.isActive(pubKey))
+	}
+
+	// Revoking the group again doesn't count the revoked sessions.
+	resp, err = s.RevokeSessionGroup(
+		ctx, &litrpc.RevokeSessionGroupRequest{
+			GroupId: "alice",
+		},
+	)
+	require.NoError(t, err)
+	require.Zero(t, resp.NumRevoked)
+}
+
+// TestAddSessionCustomPermissionLimits makes sure that custom sessions that
+// exceed the permission count limit or grant a denied permission are rejected
+// while the built-in types aren't affected by the limits.
+func TestAddSessionCustomPermissionLimits(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	templates, err := parsePermissionTemplates([]string{
+		"invoices=invoices:read,invoices:write",
+		"info=info:read",
+		"send=info:read,offchain:write",
+	})
+	require.NoError(t, err)
+	s.cfg.permissionTemplates = templates
+	s.cfg.MaxCustomPerms = 1
+	s.cfg.customDeny, err = parsePermissions([]string{"offchain:write"})
+	require.NoError(t, err)
+
+	addSession := func(typ litrpc.SessionType, template string) error {
+		req := &litrpc.AddSessionRequest{
+			Label:       "limits",
+			SessionType: typ,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr:  "localhost:1234",
+			PermissionTemplate: template,
+		}
+		_, err := s.AddSession(ctx, req)
+		return err
+	}
+
+	custom := litrpc.SessionType_TYPE_MACAROON_CUSTOM
+	require.NoError(t, addSession(custom, "info"))
+
+	// Too many permissions.
+	err = addSession(custom, "invoices")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Contains(t, err.Error(), "at most 1 permissions")
+
+	// A custom session without any permissions is rejected outright.
+	err = addSession(custom, "")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A denied permission is rejected even below the count limit.
+	s.cfg.MaxCustomPerms = 0
+	err = addSession(custom, "send")
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Contains(t, err.Error(), "offchain:write")
+	require.NoError(t, addSession(custom, "invoices"))
+
+	// The built-in types aren't restricted.
+	s.cfg.MaxCustomPerms = 1
+	require.NoError(t, addSession(
+		litrpc.SessionType_TYPE_MACAROON_ADMIN, "",
+	))
+	require.NoError(t, addSession(
+		litrpc.SessionType_TYPE_MACAROON_READONLY, "",
+	))
+}
+
+// TestCloneSessionCustomPermissionLimits makes sure that the permissions copied
+// from a custom session are checked against the current limits.
+func TestCloneSessionCustomPermissionLimits(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	perms := []bakery.Op{
+		{Entity: "info", Action: "read"},
+		{Entity: "offchain", Action: "write"},
+	}
+	orig, err := session.NewSession(
+		"orig", session.TypeMacaroonCustom, time.Now().Add(time.Hour),
+		"localhost:1234", false, perms, nil,
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.db.StoreSession(orig))
+
+	req := &litrpc.CloneSessionRequest{
+		LocalPublicKey: orig.LocalPublicKey.SerializeCompressed(),
+	}
+
+	// The limits were tightened after the original was created.
+	s.cfg.MaxCustomPerms = 1
+	_, err = s.CloneSession(ctx, req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Contains(t, err.Error(), "at most 1 permissions")
+
+	s.cfg.MaxCustomPerms = 0
+	s.cfg.customDeny, err = parsePermissions([]string{"offchain:write"})
+	require.NoError(t, err)
+	_, err = s.CloneSession(ctx, req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Contains(t, err.Error(), "offchain:write")
+
+	s.cfg.customDeny = nil
+	_, err = s.CloneSession(ctx, req)
+	require.NoError(t, err)
+
+	// A custom session without any permissions can't be cloned.
+	empty := newTestSession(t, "empty", session.TypeMacaroonCustom)
+	require.NoError(t, s.db.StoreSession(empty))
+	_, err = s.CloneSession(ctx, &litrpc.CloneSessionRequest{
+		LocalPublicKey: empty.LocalPublicKey.SerializeCompressed(),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// mockStateStream is a server stream of session state updates that forwards
+// every sent update to a channel.
+type mockStateStream struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	updates chan *litrpc.SessionStateUpdate
+}
+
+// Send forwards the given update to the updates channel.
+func (m *mockStateStream) Send(update *litrpc.SessionStateUpdate) error {
+	m.updates <- update
+	return nil
+}
+
+// Context returns the context of the stream.
+func (m *mockStateStream) Context() context.Context {
+	return m.ctx
+}
+
+// TestSubscribeSessionStateChangesSnapshot makes sure that a new subscriber
+// that requests a snapshot first receives the current state of every session,
+// then the end of snapshot marker and then the live changes.
+func TestSubscribeSessionStateChangesSnapshot(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	addSession := func(label string) *litrpc.Session {
+		resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:       label,
+			SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		})
+		require.NoError(t, err)
+
+		return resp.Session
+	}
+	existing := addSession("existing")
+
+	stream := &mockStateStream{
+		ctx:     ctx,
+		updates: make(chan *litrpc.SessionStateUpdate, 10),
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- s.SubscribeSessionStateChanges(
+			&litrpc.SubscribeSessionStateChangesRequest{
+				IncludeSnapshot: true,
+			}, stream,
+		)
+	}()
+
+	nextUpdate := func() *litrpc.SessionStateUpdate {
+		select {
+		case update := <-stream.updates:
+			return update
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no session state update received")
+			return nil
+		}
+	}
+
+	update := nextUpdate()
+	require.True(t, update.Snapshot)
+	require.Equal(t, existing.LocalPublicKey, update.Session.LocalPublicKey)
+	require.Equal(
+		t, litrpc.SessionState_STATE_CREATED,
+		update.Session.SessionState,
+	)
+
+	update = nextUpdate()
+	require.True(t, update.SnapshotDone)
+	require.Nil(t, update.Session)
+
+	// Everything after the marker are live changes.
+	added := addSession("added")
+	update = nextUpdate()
+	require.False(t, update.Snapshot)
+	require.True(t, update.Created)
+	require.Equal(t, added.LocalPublicKey, update.Session.LocalPublicKey)
+
+	_, err := s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: existing.LocalPublicKey,
+	})
+	require.NoError(t, err)
+
+	// The new session may still report its start, so we wait for the
+	// revocation.
+	for {
+		update = nextUpdate()
+		require.False(t, update.Snapshot)
+
+		if bytes.Equal(
+			update.Session.LocalPublicKey, existing.LocalPublicKey,
+		) {
+
+			break
+		}
+	}
+	require.Equal(
+		t, litrpc.SessionState_STATE_CREATED, update.PreviousState,
+	)
+	require.Equal(
+		t, litrpc.SessionState_STATE_REVOKED,
+		update.Session.SessionState,
+	)
+
+	cancel()
+	select {
+	case err := <-errChan:
+		require.ErrorIs(t, err, context.Canceled)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("subscription not stopped")
+	}
+}
+
+// slowStateStream is a server stream of session state updates whose Send
+// blocks until the stream is released, like a subscriber that doesn't keep up.
+type slowStateStream struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	sending chan struct{}
+	release chan struct{}
+}
+
+// Send signals that an update is being sent and blocks until the stream is
+// released.
+func (m *slowStateStream) Send(*litrpc.SessionStateUpdate) error {
+	select {
+	case m.sending <- struct{}{}:
+	default:
+	}
+
+	<-m.release
+	return nil
+}
+
+// Context returns the context of the stream.
+func (m *slowStateStream) Context() context.Context {
+	return m.ctx
+}
+
+// TestSubscribeSessionStateChangesSlowSubscriber makes sure that a subscriber
+// that doesn't keep up doesn't block the publisher of state changes and is
+// disconnected once its buffer overflows.
+func TestSubscribeSessionStateChangesSlowSubscriber(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.SubscriberBuffer = 2
+
+	stream := &slowStateStream{
+		ctx:     context.Background(),
+		sending: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- s.SubscribeSessionStateChanges(
+			&litrpc.SubscribeSessionStateChangesRequest{
+				IncludeSnapshot: true,
+			}, stream,
+		)
+	}()
+
+	// Once the end of the empty snapshot is being sent, the subscription
+	// is in place and the subscriber is stuck.
+	select {
+	case <-stream.sending:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("snapshot not sent")
+	}
+
+	// Far more changes than the subscriber buffers are published without
+	// blocking the publisher.
+	published := make(chan error, 1)
+	go func() {
+		for i := 0; i < 10; i++ {
+			sess := newTestSession(
+				t, fmt.Sprintf("session %d", i),
+				session.TypeMacaroonAdmin,
+			)
+			if err := s.db.StoreSession(sess); err != nil {
+				published <- err
+				return
+			}
+		}
+		published <- nil
+	}()
+
+	select {
+	case err := <-published:
+		require.NoError(t, err)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("publisher blocked by slow subscriber")
+	}
+
+	// Once the subscriber continues, it learns that it was disconnected.
+	close(stream.release)
+	select {
+	case err := <-errChan:
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("slow subscriber not disconnected")
+	}
+}
+
+// TestAddSessionDevServer makes sure that sessions with a development mailbox
+// server are only created if they are explicitly allowed.
+func TestAddSessionDevServer(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	req := &litrpc.AddSessionRequest{
+		Label:       "dev",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr:  "localhost:1234",
+		DevServer:          true,
+		InsecureSkipVerify: true,
+	}
+
+	_, err := s.AddSession(ctx, req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Sessions with a production server aren't affected.
+	prodReq := proto.Clone(req).(*litrpc.AddSessionRequest)
+	prodReq.DevServer = false
+	prodReq.InsecureSkipVerify = false
+	_, err = s.AddSession(ctx, prodReq)
+	require.NoError(t, err)
+
+	s.cfg.AllowDevSessions = true
+	resp, err := s.AddSession(ctx, req)
+	require.NoError(t, err)
+	require.True(t, resp.Session.DevServer)
+}
+
+// TestCloneSessionDevServer makes sure that a session with a development
+// mailbox server can only be cloned if such sessions are allowed.
+func TestCloneSessionDevServer(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	orig, err := session.NewSession(
+		"dev", session.TypeMacaroonAdmin, time.Now().Add(time.Hour),
+		"localhost:1234", true, nil, nil,
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.db.StoreSession(orig))
+
+	req := &litrpc.CloneSessionRequest{
+		LocalPublicKey: orig.LocalPublicKey.SerializeCompressed(),
+	}
+	_, err = s.CloneSession(ctx, req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	s.cfg.AllowDevSessions = true
+	resp, err := s.CloneSession(ctx, req)
+	require.NoError(t, err)
+	require.True(t, resp.Session.DevServer)
+}
+
+// TestRotateUIPassword makes sure that rotating the UI password updates the
+// authentication data of the running UI password sessions and the proxy while
+// macaroon sessions aren't touched.
+func TestRotateUIPassword(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	var proxyBasicAuth string
+	s.setProxyBasicAuth = func(basicAuth string) {
+		proxyBasicAuth = basicAuth
+	}
+
+	addSession := func(typ litrpc.SessionType) *btcec.PublicKey {
+		resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:       "rotate",
+			SessionType: typ,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		})
+		require.NoError(t, err)
+
+		pubKey, err := btcec.ParsePubKey(
+			resp.Session.LocalPublicKey, btcec.S256(),
+		)
+		require.NoError(t, err)
+
+		return pubKey
+	}
+	authData := func(pubKey *btcec.PublicKey) []byte {
+		mockServer.mu.Lock()
+		defer mockServer.mu.Unlock()
+
+		return mockServer.authData[string(pubKey.SerializeCompressed())]
+	}
+
+	ui := addSession(litrpc.SessionType_TYPE_UI_PASSWORD)
+	admin := addSession(litrpc.SessionType_TYPE_MACAROON_ADMIN)
+	adminAuthData := authData(admin)
+	require.Contains(t, string(authData(ui)), "dGVzdDp0ZXN0")
+
+	_, err := s.RotateUIPassword(ctx, &litrpc.RotateUIPasswordRequest{
+		NewPassword: "short",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := s.RotateUIPassword(ctx, &litrpc.RotateUIPasswordRequest{
+		NewPassword: "new password",
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.NumUpdated)
+
+	newBasicAuth := uiBasicAuth("new password")
+	require.Equal(t, newBasicAuth, proxyBasicAuth)
+	require.Equal(
+		t, "Authorization: Basic "+newBasicAuth, string(authData(ui)),
+	)
+	require.Equal(t, adminAuthData, authData(admin))
+}
+
+// TestListSessionsSummary makes sure that the session summary carries the
+// public fields of each session but no pairing secret or macaroon recipe.
+func TestListSessionsSummary(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	templates, err := parsePermissionTemplates([]string{
+		"info=info:read",
+	})
+	require.NoError(t, err)
+	s.cfg.permissionTemplates = templates
+
+	resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:       "summary",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_CUSTOM,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr:  "localhost:1234",
+		PermissionTemplate: "info",
+	})
+	require.NoError(t, err)
+
+	summaryResp, err := s.ListSessionsSummary(
+		ctx, &litrpc.ListSessionsSummaryRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, summaryResp.Sessions, 1)
+
+	summary := summaryResp.Sessions[0]
+	require.Equal(t, "summary", summary.Label)
+	require.Equal(t, resp.Session.LocalPublicKey, summary.LocalPublicKey)
+	require.Equal(t, resp.Session.SessionState, summary.SessionState)
+	require.Equal(
+		t, litrpc.SessionType_TYPE_MACAROON_CUSTOM, summary.SessionType,
+	)
+	require.Equal(
+		t, resp.Session.ExpiryTimestampSeconds,
+		summary.ExpiryTimestampSeconds,
+	)
+	require.InDelta(t, time.Now().Unix(), summary.CreatedAt, 5)
+
+	// The message has no field for any secret material and its encoding
+	// doesn't contain the pairing secret.
+	fields := summary.ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		name := string(fields.Get(i).Name())
+		require.NotContains(t, name, "secret")
+		require.NotContains(t, name, "recipe")
+	}
+
+	encoded, err := proto.Marshal(summaryResp)
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(encoded, resp.Session.PairingSecret))
+}
+
+// TestAddSessionRPCEndpoint makes sure that the response of a new macaroon
+// session carries the RPC endpoint its macaroon is valid for while UI password
+// sessions don't get one.
+func TestAddSessionRPCEndpoint(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	addSession := func(typ litrpc.SessionType) *litrpc.AddSessionResponse {
+		resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:       "endpoint",
+			SessionType: typ,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		})
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	resp := addSession(litrpc.SessionType_TYPE_MACAROON_ADMIN)
+	require.Equal(t, "localhost:8443", resp.RpcEndpoint)
+
+	resp = addSession(litrpc.SessionType_TYPE_UI_PASSWORD)
+	require.Empty(t, resp.RpcEndpoint)
+}
+
+// TestAddSessionMailboxConnOptions makes sure that the mailbox connection
+// options of a new session are stored and returned.
+func TestAddSessionMailboxConnOptions(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:       "conn options",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr:        "localhost:1234",
+		KeepaliveIntervalSeconds: 30,
+		HandshakeTimeoutSeconds:  5,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 30, resp.Session.KeepaliveIntervalSeconds)
+	require.EqualValues(t, 5, resp.Session.HandshakeTimeoutSeconds)
+
+	pubKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 30*time.Second, stored.KeepaliveInterval)
+	require.Equal(t, 5*time.Second, stored.HandshakeTimeout)
+}
+
+// TestQuarantineSession makes sure that a quarantined session is stopped and
+// excluded from being resumed until it is restored, which resumes it again.
+func TestQuarantineSession(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:       "quarantine",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr: "localhost:1234",
+		SingleUse:         true,
+	})
+	require.NoError(t, err)
+
+	localPubKey := resp.Session.LocalPublicKey
+	pubKey, err := btcec.ParsePubKey(localPubKey, btcec.S256())
+	require.NoError(t, err)
+	require.True(t, mockServer.isActive(pubKey))
+
+	_, err = s.QuarantineSession(ctx, &litrpc.QuarantineSessionRequest{
+		LocalPublicKey: localPubKey,
+	})
+	require.NoError(t, err)
+	require.False(t, s.isActive(pubKey))
+	require.False(t, mockServer.isActive(pubKey))
+
+	// Even though it is a single-use session, it isn't revoked.
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateQuarantined, stored.State)
+
+	_, err = s.QuarantineSession(ctx, &litrpc.QuarantineSessionRequest{
+		LocalPublicKey: localPubKey,
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Resuming all sessions skips the quarantined one without reporting
+	// an error for it.
+	_, err = s.ResumeAllSessions(ctx, &litrpc.ResumeAllSessionsRequest{})
+	require.NoError(t, err)
+	require.False(t, mockServer.isActive(pubKey))
+
+	stored, err = s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateQuarantined, stored.State)
+	require.Empty(t, stored.LastResumeError)
+
+	listResp, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, listResp.Sessions, 1)
+	require.Equal(
+		t, litrpc.SessionState_STATE_QUARANTINED,
+		listResp.Sessions[0].SessionState,
+	)
+
+	_, err = s.UnquarantineSession(
+		ctx, &litrpc.UnquarantineSessionRequest{
+			LocalPublicKey: localPubKey,
+		},
+	)
+	require.NoError(t, err)
+	require.True(t, s.isActive(pubKey))
+	require.True(t, mockServer.isActive(pubKey))
+
+	stored, err = s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateCreated, stored.State)
+
+	_, err = s.UnquarantineSession(
+		ctx, &litrpc.UnquarantineSessionRequest{
+			LocalPublicKey: localPubKey,
+		},
+	)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	events, err := s.db.ListAuditEvents(&session.AuditEventFilter{
+		LocalPublicKey: pubKey,
+	})
+	require.NoError(t, err)
+
+	var types []session.AuditEventType
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	require.Contains(t, types, session.AuditEventQuarantined)
+	require.Contains(t, types, session.AuditEventUnquarantined)
+}
+
+// TestResetSession makes sure that a session whose pairing got stuck can be
+// reset and restarted with the same pairing secret, while a paired session
+// can't be reset.
+func TestResetSession(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	// The remote of a session that requires approval got stuck waiting
+	// for an approval that never comes.
+	pubKey := addApprovalSession(t, s)
+	done := connectPending(t, s, pubKey)
+
+	before, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+
+	resp, err := s.ResetSession(ctx, &litrpc.ResetSessionRequest{
+		LocalPublicKey: pubKey.SerializeCompressed(),
+	})
+	require.NoError(t, err)
+	require.Equal(
+		t, litrpc.SessionState_STATE_CREATED,
+		resp.Session.SessionState,
+	)
+
+	// The partial connection is torn down and the session is running
+	// again, ready for a new pairing attempt with the same secret.
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("remote still waiting after reset")
+	}
+	require.Nil(t, mockAuthData(s, pubKey))
+	require.True(t, s.isActive(pubKey))
+	require.True(t, mockServer.isActive(pubKey))
+
+	after, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateCreated, after.State)
+	require.Equal(t, before.PairingSecret, after.PairingSecret)
+	require.True(t, after.RequireApproval)
+
+	// The retried pairing can be approved as usual.
+	done = connectPending(t, s, pubKey)
+	_, err = s.ApproveSession(ctx, &litrpc.ApproveSessionRequest{
+		LocalPublicKey: pubKey.SerializeCompressed(),
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("remote not served after approval")
+	}
+	require.NotNil(t, mockAuthData(s, pubKey))
+
+	// A session that is fully paired can't be reset.
+	require.NoError(t, s.db.UpdateSessionState(pubKey, session.StateInUse))
+	_, err = s.ResetSession(ctx, &litrpc.ResetSessionRequest{
+		LocalPublicKey: pubKey.SerializeCompressed(),
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateInUse, stored.State)
+
+	unknown := newTestSession(t, "unknown", session.TypeMacaroonAdmin)
+	_, err = s.ResetSession(ctx, &litrpc.ResetSessionRequest{
+		LocalPublicKey: unknown.LocalPublicKey.SerializeCompressed(),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestRestartSession makes sure that a running session survives a restart of
+// its mailbox connection with the same keys and state, even if the first
+// attempt to start it again fails.
+func TestRestartSession(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	sess := newTestSession(t, "restart", session.TypeMacaroonAdmin)
+	sess.State = session.StateInUse
+	require.NoError(t, s.storeAndStartSession(sess, 0))
+
+	pubKey := sess.LocalPublicKey
+	restart := func() (*litrpc.RestartSessionResponse, error) {
+		return s.RestartSession(ctx, &litrpc.RestartSessionRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+		})
+	}
+	quitChan := func() chan struct{} {
+		mockServer.mu.Lock()
+		defer mockServer.mu.Unlock()
+
+		return mockServer.active[string(pubKey.SerializeCompressed())]
+	}
+
+	checkRestarted := func(resp *litrpc.RestartSessionResponse,
+		oldQuit chan struct{}) {
+
+		require.Equal(
+			t, pubKey.SerializeCompressed(),
+			resp.Session.LocalPublicKey,
+		)
+		require.Equal(
+			t, litrpc.SessionState_STATE_IN_USE,
+			resp.Session.SessionState,
+		)
+
+		// The old mailbox connection was stopped and a new one runs.
+		select {
+		case <-oldQuit:
+		default:
+			t.Fatalf("old connection not stopped")
+		}
+		require.True(t, s.isActive(pubKey))
+		require.True(t, mockServer.isActive(pubKey))
+		require.NotEqual(t, oldQuit, quitChan())
+
+		stored, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+		require.Equal(t, session.StateInUse, stored.State)
+		require.Equal(t, sess.PairingSecret, stored.PairingSecret)
+	}
+
+	oldQuit := quitChan()
+	resp, err := restart()
+	require.NoError(t, err)
+	checkRestarted(resp, oldQuit)
+
+	// If the first attempt to start the session again fails, it is
+	// rolled back to running with a second one.
+	mockServer.mu.Lock()
+	mockServer.startErrs = []error{fmt.Errorf("mailbox unreachable")}
+	mockServer.mu.Unlock()
+
+	oldQuit = quitChan()
+	resp, err = restart()
+	require.NoError(t, err)
+	checkRestarted(resp, oldQuit)
+
+	// If the session can't be started at all, an error is returned but
+	// the session is kept, so it can be resumed later.
+	mockServer.mu.Lock()
+	mockServer.startErrs = []error{
+		fmt.Errorf("mailbox unreachable"),
+		fmt.Errorf("mailbox unreachable"),
+	}
+	mockServer.mu.Unlock()
+
+	_, err = restart()
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateInUse, stored.State)
+
+	// A session that isn't running can't be restarted.
+	_, err = restart()
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	unknown := newTestSession(t, "unknown", session.TypeMacaroonAdmin)
+	_, err = s.RestartSession(ctx, &litrpc.RestartSessionRequest{
+		LocalPublicKey: unknown.LocalPublicKey.SerializeCompressed(),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestListSessionsRunningOnly makes sure that only sessions that a client
+// connected to and whose mailbox connection is live are returned if
+// running_only is set.
+func TestListSessionsRunningOnly(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mock := s.sessionServer.(*mockSessionServer)
+	ctx := context.Background()
+
+	created := newTestSession(t, "created", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(created))
+
+	// A client completes the handshake with this session, which puts it
+	// in use.
+	running := newTestSession(t, "running", session.TypeMacaroonAdmin)
+	require.NoError(t, s.storeAndStartSession(running, 0))
+	mock.connect(running.LocalPublicKey)
+	require.Eventually(t, func() bool {
+		sess, err := s.db.GetSession(running.LocalPublicKey)
+		require.NoError(t, err)
+
+		return sess.State == session.StateInUse
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// This session is still marked as in use, but its connection ended.
+	dead := newTestSession(t, "dead", session.TypeMacaroonAdmin)
+	dead.State = session.StateInUse
+	require.NoError(t, s.db.StoreSession(dead))
+
+	// This session is live but no client connected to it yet.
+	waiting := newTestSession(t, "waiting", session.TypeMacaroonAdmin)
+	require.NoError(t, s.storeAndStartSession(waiting, 0))
+
+	resp, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{
+		RunningOnly: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 1)
+	require.Equal(t, "running", resp.Sessions[0].Label)
+	require.True(t, resp.Sessions[0].IsRunning)
+
+	resp, err = s.ListSessions(ctx, &litrpc.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 4)
+}
+
+// TestResumeInterruptedStart makes sure that a session whose start was
+// interrupted by a crash, after its mailbox connection was started but before
+// its state was restored, is rolled back to its previous state and cleanly
+// started on the next resume.
+func TestResumeInterruptedStart(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	sess := newTestSession(t, "paired", session.TypeMacaroonAdmin)
+	sess.State = session.StateInUse
+	require.NoError(t, s.db.StoreSession(sess))
+
+	// The start of the session is checkpointed, then LiT crashes before
+	// the state is restored. The mailbox connection doesn't survive the
+	// crash.
+	err := s.db.MarkSessionStarting(
+		sess.LocalPublicKey, session.StateInUse,
+	)
+	require.NoError(t, err)
+
+	crashed, err := s.db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateStarting, crashed.State)
+	require.Equal(t, session.StateInUse, crashed.StartingFrom)
+	require.False(t, mockServer.isActive(sess.LocalPublicKey))
+
+	require.NoError(t, s.resumeSession(crashed, 0))
+	require.True(t, mockServer.isActive(sess.LocalPublicKey))
+	require.True(t, s.isActive(sess.LocalPublicKey))
+
+	// The session is back in the state it had before the interrupted
+	// start, instead of being reset to the created state.
+	recovered, err := s.db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateInUse, recovered.State)
+
+	// A start from the created state is rolled back to created as well.
+	created := newTestSession(t, "created", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(created))
+	err = s.db.MarkSessionStarting(
+		created.LocalPublicKey, session.StateCreated,
+	)
+	require.NoError(t, err)
+
+	crashed, err = s.db.GetSession(created.LocalPublicKey)
+	require.NoError(t, err)
+	require.NoError(t, s.resumeSession(crashed, 0))
+
+	recovered, err = s.db.GetSession(created.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateCreated, recovered.State)
+	require.True(t, mockServer.isActive(created.LocalPublicKey))
+}
+
+// TestSessionShortID makes sure that each session has a stable short ID that
+// can be used instead of its local public key to fetch or revoke it.
+func TestSessionShortID(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	sess := addTestUISession(t, s, "short")
+	other := addTestUISession(t, s, "other")
+
+	// The ID is derived from the key, so it's the same on every call.
+	pubKey, err := btcec.ParsePubKey(sess.LocalPublicKey, btcec.S256())
+	require.NoError(t, err)
+	require.Equal(t, session.ShortID(pubKey), sess.SessionId)
+	require.NotEqual(t, sess.SessionId, other.SessionId)
+
+	resp, err := s.GetSession(ctx, &litrpc.GetSessionRequest{
+		SessionId: sess.SessionId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "short", resp.Session.Label)
+	require.Equal(t, sess.SessionId, resp.Session.SessionId)
+
+	// The ID can't be combined with the local public key.
+	_, err = s.GetSession(ctx, &litrpc.GetSessionRequest{
+		LocalPublicKey: sess.LocalPublicKey,
+		SessionId:      sess.SessionId,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.GetSession(ctx, &litrpc.GetSessionRequest{
+		SessionId: "unknown",
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// Sessions can be revoked by their short ID too.
+	_, err = s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		SessionId:      sess.SessionId,
+		LocalPublicKey: sess.LocalPublicKey,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		SessionId: sess.SessionId,
+	})
+	require.NoError(t, err)
+
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateRevoked, stored.State)
+
+	otherKey, err := btcec.ParsePubKey(other.LocalPublicKey, btcec.S256())
+	require.NoError(t, err)
+	stored, err = s.db.GetSession(otherKey)
+	require.NoError(t, err)
+	require.NotEqual(t, session.StateRevoked, stored.State)
+}
+
+// TestGetSessionConnectionInfo makes sure that the details of the live mailbox
+// connection are only returned on request and only for running sessions.
+func TestGetSessionConnectionInfo(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mockServer := s.sessionServer.(*mockSessionServer)
+	ctx := context.Background()
+
+	sess := addTestUISession(t, s, "debug")
+	pubKey, err := btcec.ParsePubKey(sess.LocalPublicKey, btcec.S256())
+	require.NoError(t, err)
+
+	connectedSince := time.Unix(1700000000, 0)
+	mockServer.mu.Lock()
+	mockServer.connInfo[string(sess.LocalPublicKey)] =
+		&session.ConnectionInfo{
+			ConnectedSince: connectedSince,
+			RemoteAddr:     "mailbox.remote:1234",
+			Healthy:        true,
+		}
+	mockServer.mu.Unlock()
+
+	getSession := func(withInfo bool) *litrpc.GetSessionResponse {
+		resp, err := s.GetSession(ctx, &litrpc.GetSessionRequest{
+			LocalPublicKey:        sess.LocalPublicKey,
+			IncludeConnectionInfo: withInfo,
+		})
+		require.NoError(t, err)
+
+		return resp
+	}
+
+	// The details are only included on request.
+	got := getSession(false)
+	require.Equal(t, "debug", got.Session.Label)
+	require.True(t, got.Session.IsRunning)
+	require.Nil(t, got.ConnectionInfo)
+
+	got = getSession(true)
+	require.NotNil(t, got.ConnectionInfo)
+	require.EqualValues(
+		t, connectedSince.Unix(), got.ConnectionInfo.ConnectedSince,
+	)
+	require.Equal(t, "mailbox.remote:1234", got.ConnectionInfo.RemoteAddr)
+	require.True(t, got.ConnectionInfo.Healthy)
+
+	// Once the session stopped running, the details are omitted.
+	require.NoError(t, mockServer.StopSession(pubKey))
+	require.Eventually(t, func() bool {
+		return !s.isActive(pubKey)
+	}, 5*time.Second, 10*time.Millisecond)
+	got = getSession(true)
+	require.False(t, got.Session.IsRunning)
+	require.Nil(t, got.ConnectionInfo)
+
+	// Unknown sessions aren't found.
+	unknown := newTestSession(t, "unknown", session.TypeMacaroonAdmin)
+	_, err = s.GetSession(ctx, &litrpc.GetSessionRequest{
+		LocalPublicKey: unknown.LocalPublicKey.SerializeCompressed(),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestExpiryPolicy makes sure that a running session with a connected client
+// is stopped and revoked at its expiry according to the configured policy.
+func TestExpiryPolicy(t *testing.T) {
+	// startExpiring starts a session with a connected client that expires
+	// shortly.
+	startExpiring := func(t *testing.T, policy string) (*sessionRpcServer,
+		*mockSessionServer, *session.Session) {
+
+		s := newTestSessionRpcServer(t)
+		s.cfg.ExpiryPolicy = policy
+		s.cfg.ExpiryDrainPeriod = time.Hour
+		require.NoError(t, s.cfg.validate())
+		mock := s.sessionServer.(*mockSessionServer)
+
+		sess := newTestSession(t, policy, session.TypeMacaroonAdmin)
+		sess.Expiry = time.Now().Add(200 * time.Millisecond)
+		require.NoError(t, s.storeAndStartSession(sess, 0))
+		mock.connectClient(sess.LocalPublicKey)
+
+		return s, mock, sess
+	}
+
+	isRevoked := func(s *sessionRpcServer, sess *session.Session) bool {
+		stored, err := s.db.GetSession(sess.LocalPublicKey)
+		require.NoError(t, err)
+
+		return stored.State == session.StateRevoked
+	}
+
+	t.Run("hard", func(t *testing.T) {
+		s, mock, sess := startExpiring(t, expiryPolicyHard)
+
+		// The session is revoked even though a client is connected.
+		require.Eventually(t, func() bool {
+			return isRevoked(s, sess)
+		}, 5*time.Second, 10*time.Millisecond)
+		require.False(t, mock.isActive(sess.LocalPublicKey))
+		require.False(t, mock.isDraining(sess.LocalPublicKey))
+	})
+
+	t.Run("drain", func(t *testing.T) {
+		s, mock, sess := startExpiring(t, expiryPolicyDrain)
+
+		// New clients are rejected, but the connected one can finish.
+		require.Eventually(t, func() bool {
+			return mock.isDraining(sess.LocalPublicKey)
+		}, 5*time.Second, 10*time.Millisecond)
+		require.False(t, isRevoked(s, sess))
+		require.True(t, mock.isActive(sess.LocalPublicKey))
+
+		mock.disconnectClient(sess.LocalPublicKey)
+		require.Eventually(t, func() bool {
+			return isRevoked(s, sess)
+		}, 5*time.Second, 10*time.Millisecond)
+		require.False(t, mock.isActive(sess.LocalPublicKey))
+	})
+
+	t.Run("drain period over", func(t *testing.T) {
+		s := newTestSessionRpcServer(t)
+		s.cfg.ExpiryPolicy = expiryPolicyDrain
+		s.cfg.ExpiryDrainPeriod = 100 * time.Millisecond
+		mock := s.sessionServer.(*mockSessionServer)
+
+		sess := newTestSession(t, "drain", session.TypeMacaroonAdmin)
+		sess.Expiry = time.Now().Add(200 * time.Millisecond)
+		require.NoError(t, s.storeAndStartSession(sess, 0))
+		mock.connectClient(sess.LocalPublicKey)
+
+		// The client doesn't disconnect, so it is cut off once the
+		// drain period is over.
+		require.Eventually(t, func() bool {
+			return isRevoked(s, sess)
+		}, 5*time.Second, 10*time.Millisecond)
+		require.True(t, mock.isDraining(sess.LocalPublicKey))
+		require.False(t, mock.isActive(sess.LocalPublicKey))
+	})
+
+	t.Run("warn-only", func(t *testing.T) {
+		s, mock, sess := startExpiring(t, expiryPolicyWarnOnly)
+
+		// The session keeps running and accepting clients past its
+		// expiry.
+		time.Sleep(400 * time.Millisecond)
+		require.False(t, isRevoked(s, sess))
+		require.True(t, mock.isActive(sess.LocalPublicKey))
+		require.False(t, mock.isDraining(sess.LocalPublicKey))
+
+		mock.disconnectClient(sess.LocalPublicKey)
+		require.Eventually(t, func() bool {
+			return isRevoked(s, sess)
+		}, 5*time.Second, 10*time.Millisecond)
+		require.False(t, mock.isActive(sess.LocalPublicKey))
+	})
+}
+
+// TestRenewSessions makes sure that only the non-revoked sessions matching the
+// filter are renewed, that expired sessions are resumed again and that a
+// session violating the duration bounds is reported without affecting the
+// others.
+func TestRenewSessions(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.MaxDuration = 35 * 24 * time.Hour
+	mockServer := s.sessionServer.(*mockSessionServer)
+	ctx := context.Background()
+	now := time.Now()
+
+	storeSession := func(label string, state session.State,
+		expiry time.Time) *session.Session {
+
+		sess := newTestSession(t, label, session.TypeMacaroonAdmin)
+		sess.State = state
+		sess.Expiry = expiry.Truncate(time.Second)
+		require.NoError(t, s.db.StoreSession(sess))
+
+		return sess
+	}
+
+	soon := storeSession(
+		"soon", session.StateCreated, now.Add(48*time.Hour),
+	)
+	edge := storeSession(
+		"edge", session.StateCreated, now.Add(6*24*time.Hour),
+	)
+	expired := storeSession(
+		"expired", session.StateExpired, now.Add(-time.Hour),
+	)
+	revoked := storeSession(
+		"revoked", session.StateRevoked, now.Add(time.Hour),
+	)
+	later := storeSession(
+		"later", session.StateCreated, now.Add(20*24*time.Hour),
+	)
+
+	extension := 30 * 24 * time.Hour
+	weekEnd := now.Add(7 * 24 * time.Hour)
+	_, err := s.RenewSessions(ctx, &litrpc.RenewSessionsRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	resp, err := s.RenewSessions(ctx, &litrpc.RenewSessionsRequest{
+		Filter: &litrpc.ListSessionsRequest{
+			ExpiryBefore: uint64(weekEnd.Unix()),
+		},
+		ExtensionSeconds: uint64(extension.Seconds()),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+
+	results := make(map[string]*litrpc.RenewSessionResult)
+	for _, result := range resp.Results {
+		results[result.Label] = result
+	}
+	require.NotContains(t, results, "revoked")
+	require.NotContains(t, results, "later")
+
+	// The sessions within the bounds were renewed.
+	for _, sess := range []*session.Session{soon, expired} {
+		result := results[sess.Label]
+		require.Empty(t, result.Error)
+		require.Equal(
+			t, uint64(sess.Expiry.Add(extension).Unix()),
+			result.ExpiryTimestampSeconds,
+		)
+
+		stored, err := s.db.GetSession(sess.LocalPublicKey)
+		require.NoError(t, err)
+		require.True(t, stored.Expiry.Equal(sess.Expiry.Add(extension)))
+		require.Equal(t, session.StateCreated, stored.State)
+	}
+	require.True(t, mockServer.isActive(expired.LocalPublicKey))
+
+	// The session whose new expiry exceeds the maximum duration keeps its
+	// old expiry.
+	require.Contains(t, results["edge"].Error, "more than")
+	require.Equal(
+		t, uint64(edge.Expiry.Unix()),
+		results["edge"].ExpiryTimestampSeconds,
+	)
+
+	// The sessions not matching the filter and revoked sessions are left
+	// untouched.
+	for _, sess := range []*session.Session{edge, revoked, later} {
+		stored, err := s.db.GetSession(sess.LocalPublicKey)
+		require.NoError(t, err)
+		require.True(t, stored.Expiry.Equal(sess.Expiry))
+		require.Equal(t, sess.State, stored.State)
+	}
+}
+
+// TestRenewSessionsHardDeadline makes sure that the expiry of a session can be
+// renewed up to its hard deadline but not past it.
+func TestRenewSessionsHardDeadline(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Second)
+	expiry := now.Add(24 * time.Hour)
+	deadline := now.Add(72 * time.Hour)
+
+	// The hard deadline can't be before the expiry.
+	_, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:                  "deadline",
+		SessionType:            litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(expiry.Unix()),
+		MailboxServerAddr:      "localhost:1234",
+		HardDeadline:           uint64(now.Add(time.Hour).Unix()),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	addResp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:                  "deadline",
+		SessionType:            litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(expiry.Unix()),
+		MailboxServerAddr:      "localhost:1234",
+		HardDeadline:           uint64(deadline.Unix()),
+	})
+	require.NoError(t, err)
+	require.Equal(
+		t, uint64(deadline.Unix()), addResp.Session.HardDeadline,
+	)
+
+	renew := func(newExpiry time.Time) *litrpc.RenewSessionResult {
+		resp, err := s.RenewSessions(ctx, &litrpc.RenewSessionsRequest{
+			Filter: &litrpc.ListSessionsRequest{
+				LabelQuery: "deadline",
+			},
+			NewExpiryTimestampSeconds: uint64(newExpiry.Unix()),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 1)
+
+		return resp.Results[0]
+	}
+
+	// A renewal up to the deadline succeeds.
+	result := renew(now.Add(48 * time.Hour))
+	require.Empty(t, result.Error)
+	result = renew(deadline)
+	require.Empty(t, result.Error)
+	require.Equal(
+		t, uint64(deadline.Unix()), result.ExpiryTimestampSeconds,
+	)
+
+	// A renewal past the deadline is rejected and keeps the old expiry.
+	result = renew(deadline.Add(time.Second))
+	require.Contains(t, result.Error, "hard deadline")
+	require.Equal(
+		t, uint64(deadline.Unix()), result.ExpiryTimestampSeconds,
+	)
+
+	pubKey, err := btcec.ParsePubKey(
+		addResp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.True(t, stored.Expiry.Equal(deadline))
+	require.True(t, stored.HardDeadline.Equal(deadline))
+
+	err = s.renewSessionExpiry(stored, deadline.Add(time.Hour))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestGetMailboxServerStats makes sure that the sessions are aggregated per
+// mailbox server address and that only live sessions count as running and
+// connected.
+func TestGetMailboxServerStats(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	const (
+		serverA = "mailbox-a.example.com:443"
+		serverB = "mailbox-b.example.com:443"
+	)
+
+	newSession := func(label, serverAddr string) *session.Session {
+		sess := newTestSession(t, label, session.TypeMacaroonAdmin)
+		sess.ServerAddr = serverAddr
+
+		return sess
+	}
+
+	// Server A has one connected, one running and one stopped session.
+	connected := newSession("connected", serverA)
+	require.NoError(t, s.storeAndStartSession(connected, 0))
+	mockServer.connectClient(connected.LocalPublicKey)
+
+	running := newSession("running", serverA)
+	require.NoError(t, s.storeAndStartSession(running, 0))
+
+	stopped := newSession("stopped", serverA)
+	require.NoError(t, s.db.StoreSession(stopped))
+
+	// Server B only has a revoked session.
+	revoked := newSession("revoked", serverB)
+	require.NoError(t, s.db.StoreSession(revoked))
+	require.NoError(t, s.db.RevokeSession(revoked.LocalPublicKey, ""))
+
+	resp, err := s.GetMailboxServerStats(
+		context.Background(), &litrpc.GetMailboxServerStatsRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Servers, 2)
+
+	statsA := resp.Servers[0]
+	require.Equal(t, serverA, statsA.ServerAddr)
+	require.EqualValues(t, 3, statsA.TotalSessions)
+	require.EqualValues(t, 2, statsA.RunningSessions)
+	require.EqualValues(t, 1, statsA.ConnectedSessions)
+
+	statsB := resp.Servers[1]
+	require.Equal(t, serverB, statsB.ServerAddr)
+	require.EqualValues(t, 1, statsB.TotalSessions)
+	require.Zero(t, statsB.RunningSessions)
+	require.Zero(t, statsB.ConnectedSessions)
+}
+
+// TestSoftRevokeSession makes sure that a softly revoked session can only be
+// restored within its restore window and before its expiry.
+func TestSoftRevokeSession(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("restore within window", func(t *testing.T) {
+		s := newTestSessionRpcServer(t)
+		s.cfg.RestoreWindow = time.Hour
+		mockServer := s.sessionServer.(*mockSessionServer)
+
+		sess := newTestSession(t, "soft", session.TypeMacaroonAdmin)
+		require.NoError(t, s.storeAndStartSession(sess, 0))
+		pubKey := sess.LocalPublicKey
+
+		resp, err := s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+			Reason:         "oops",
+			Soft:           true,
+		})
+		require.NoError(t, err)
+		require.NotZero(t, resp.RestorableUntil)
+		require.False(t, mockServer.isActive(pubKey))
+		require.Eventually(t, func() bool {
+			return !s.isActive(pubKey)
+		}, 5*time.Second, 10*time.Millisecond)
+
+		stored, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+		require.Equal(t, session.StateRevoked, stored.State)
+
+		_, err = s.RestoreSession(ctx, &litrpc.RestoreSessionRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+		})
+		require.NoError(t, err)
+		require.True(t, mockServer.isActive(pubKey))
+
+		stored, err = s.db.GetSession(pubKey)
+		require.NoError(t, err)
+		require.Equal(t, session.StateCreated, stored.State)
+		require.True(t, stored.RevokedAt.IsZero())
+		require.True(t, stored.RestorableUntil.IsZero())
+
+		// The session isn't revoked anymore, so it can't be restored
+		// twice.
+		_, err = s.RestoreSession(ctx, &litrpc.RestoreSessionRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("active session limit", func(t *testing.T) {
+		s := newTestSessionRpcServer(t)
+		s.cfg.RestoreWindow = time.Hour
+		s.cfg.MaxActiveSessions = 1
+
+		sess := newTestSession(t, "soft", session.TypeMacaroonAdmin)
+		require.NoError(t, s.storeAndStartSession(sess, 0))
+		pubKey := sess.LocalPublicKey
+
+		_, err := s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+			Soft:           true,
+		})
+		require.NoError(t, err)
+
+		// Another session takes the only free slot while the first
+		// one is revoked, so restoring it would exceed the limit.
+		other := newTestSession(t, "other", session.TypeMacaroonAdmin)
+		require.NoError(t, s.storeAndStartSession(other, 0))
+
+		_, err = s.RestoreSession(ctx, &litrpc.RestoreSessionRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+		})
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		stored, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+		require.Equal(t, session.StateRevoked, stored.State)
+	})
+
+	t.Run("after window", func(t *testing.T) {
+		s := newTestSessionRpcServer(t)
+
+		sess := newTestSession(t, "late", session.TypeMacaroonAdmin)
+		require.NoError(t, s.db.StoreSession(sess))
+		pubKey := sess.LocalPublicKey
+
+		err := s.db.SoftRevokeSession(
+			pubKey, "", time.Now().Add(-time.Second),
+		)
+		require.NoError(t, err)
+
+		_, err = s.RestoreSession(ctx, &litrpc.RestoreSessionRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		require.Contains(t, err.Error(), "no longer be restored")
+
+		stored, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+		require.Equal(t, session.StateRevoked, stored.State)
+	})
+
+	t.Run("expired session", func(t *testing.T) {
+		s := newTestSessionRpcServer(t)
+
+		sess := newTestSession(t, "expired", session.TypeMacaroonAdmin)
+		sess.Expiry = time.Now().Add(-time.Minute)
+		require.NoError(t, s.db.StoreSession(sess))
+		pubKey := sess.LocalPublicKey
+
+		err := s.db.SoftRevokeSession(
+			pubKey, "", time.Now().Add(time.Hour),
+		)
+		require.NoError(t, err)
+
+		_, err = s.RestoreSession(ctx, &litrpc.RestoreSessionRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		require.Contains(t, err.Error(), "expired")
+
+		stored, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+		require.Equal(t, session.StateRevoked, stored.State)
+	})
+
+	t.Run("hard revocation", func(t *testing.T) {
+		s := newTestSessionRpcServer(t)
+
+		sess := newTestSession(t, "hard", session.TypeMacaroonAdmin)
+		require.NoError(t, s.db.StoreSession(sess))
+		pubKey := sess.LocalPublicKey
+
+		// Soft revocations are disabled without a restore window.
+		_, err := s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+			Soft:           true,
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		// Revoking a softly revoked session again makes the
+		// revocation permanent.
+		err = s.db.SoftRevokeSession(
+			pubKey, "", time.Now().Add(time.Hour),
+		)
+		require.NoError(t, err)
+		_, err = s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+		})
+		require.NoError(t, err)
+
+		_, err = s.RestoreSession(ctx, &litrpc.RestoreSessionRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+// TestGetSessionPermissions makes sure that the permissions of each session
+// type are fully resolved and that UI password sessions are marked as having
+// full UI access.
+func TestGetSessionPermissions(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	customPerms := []bakery.Op{{Entity: "info", Action: "read"}}
+	caveat := macaroon.Caveat{Id: []byte("lnd-custom account acc1")}
+
+	admin := newTestSession(t, "admin", session.TypeMacaroonAdmin)
+	readOnly := newTestSession(t, "readonly", session.TypeMacaroonReadonly)
+	custom := newTestSession(t, "custom", session.TypeMacaroonCustom)
+	custom.MacaroonRecipe = &session.MacaroonRecipe{
+		Permissions: customPerms,
+		Caveats:     []macaroon.Caveat{caveat},
+	}
+	unrestricted := newTestSession(
+		t, "unrestricted", session.TypeMacaroonCustom,
+	)
+	ui := newTestSession(t, "ui", session.TypeUIPassword)
+
+	getPerms := func(sess *session.Session) (
+		*litrpc.GetSessionPermissionsResponse, []bakery.Op) {
+
+		require.NoError(t, s.db.StoreSession(sess))
+
+		resp, err := s.GetSessionPermissions(
+			ctx, &litrpc.GetSessionPermissionsRequest{
+				LocalPublicKey: sess.LocalPublicKey.
+					SerializeCompressed(),
+			},
+		)
+		require.NoError(t, err)
+
+		ops := make([]bakery.Op, len(resp.Permissions))
+		for idx, perm := range resp.Permissions {
+			ops[idx] = bakery.Op{
+				Entity: perm.Entity,
+				Action: perm.Action,
+			}
+		}
+
+		return resp, ops
+	}
+
+	resp, ops := getPerms(admin)
+	require.False(t, resp.FullUiAccess)
+	require.ElementsMatch(t, GetAllPermissions(false), ops)
+	require.Empty(t, resp.Caveats)
+
+	resp, ops = getPerms(readOnly)
+	require.False(t, resp.FullUiAccess)
+	require.ElementsMatch(t, GetAllPermissions(true), ops)
+
+	resp, ops = getPerms(custom)
+	require.False(t, resp.FullUiAccess)
+	require.Equal(t, customPerms, ops)
+	require.Equal(t, []string{string(caveat.Id)}, resp.Caveats)
+
+	// A custom session without explicit permissions doesn't fall back to
+	// the admin permissions.
+	_, ops = getPerms(unrestricted)
+	require.Empty(t, ops)
+
+	resp, ops = getPerms(ui)
+	require.True(t, resp.FullUiAccess)
+	require.Empty(t, ops)
+	require.Empty(t, resp.Caveats)
+	require.Equal(t, &litrpc.UIPasswordAccess{
+		AuthHeader: "Authorization",
+		AuthScheme: "Basic",
+		Subservers: []string{"lnd", "faraday", "loop", "pool", "lit"},
+	}, resp.UiPasswordAccess)
+
+	// Macaroon sessions don't have a UI password access descriptor.
+	resp, _ = getPerms(admin)
+	require.Nil(t, resp.UiPasswordAccess)
+
+	_, err := s.GetSessionPermissions(
+		ctx, &litrpc.GetSessionPermissionsRequest{
+			LocalPublicKey: newTestSession(
+				t, "unknown", session.TypeMacaroonAdmin,
+			).LocalPublicKey.SerializeCompressed(),
+		},
+	)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// TestAddSessionMacaroonExpiry makes sure that the macaroon of a session with a
+// macaroon expiry carries a time-before caveat that expires before the session
+// and that the macaroon expiry can't exceed the session expiry.
+func TestAddSessionMacaroonExpiry(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	var (
+		mu      sync.Mutex
+		recipes []*session.MacaroonRecipe
+	)
+	s.superMacBaker = func(_ context.Context, _ uint64,
+		recipe *session.MacaroonRecipe) (string, error) {
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		recipes = append(recipes, recipe)
+		return "mac", nil
+	}
+
+	expiry := time.Now().Add(24 * time.Hour)
+	addSession := func(typ litrpc.SessionType,
+		macExpiry time.Duration) (*litrpc.AddSessionResponse, error) {
+
+		return s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:                  "macaroon expiry",
+			SessionType:            typ,
+			ExpiryTimestampSeconds: uint64(expiry.Unix()),
+			MailboxServerAddr:      "localhost:1234",
+			MacaroonExpirySeconds:  uint64(macExpiry.Seconds()),
+		})
+	}
+
+	before := time.Now()
+	resp, err := addSession(
+		litrpc.SessionType_TYPE_MACAROON_ADMIN, time.Hour,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 3600, resp.Session.MacaroonExpirySeconds)
+
+	mu.Lock()
+	require.Len(t, recipes, 1)
+	require.Len(t, recipes[0].Caveats, 1)
+	condition := string(recipes[0].Caveats[0].Id)
+	mu.Unlock()
+
+	// The caveat expires an hour after the macaroon was baked, long before
+	// the session itself.
+	const prefix = "time-before "
+	require.True(t, strings.HasPrefix(condition, prefix))
+	macExpiry, err := time.Parse(
+		time.RFC3339Nano, strings.TrimPrefix(condition, prefix),
+	)
+	require.NoError(t, err)
+	require.False(t, macExpiry.Before(before.Add(time.Hour)))
+	require.False(t, macExpiry.After(time.Now().Add(time.Hour)))
+	require.True(t, macExpiry.Before(expiry))
+
+	pubKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, stored.MacaroonExpiry)
+
+	// The macaroon can't outlive the session.
+	_, err = addSession(
+		litrpc.SessionType_TYPE_MACAROON_ADMIN, 48*time.Hour,
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// UI password sessions don't use a macaroon.
+	_, err = addSession(litrpc.SessionType_TYPE_UI_PASSWORD, time.Hour)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestAddSessionMaxAbsoluteExpiry makes sure that no session can be created
+// that expires after the configured absolute maximum expiry.
+func TestAddSessionMaxAbsoluteExpiry(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+	maxExpiry := time.Now().Add(30 * 24 * time.Hour).Truncate(time.Second)
+	s.cfg.MaxAbsoluteExpiry = maxExpiry.Format(time.RFC3339)
+	require.NoError(t, s.cfg.validate())
+
+	adminType := litrpc.SessionType_TYPE_MACAROON_ADMIN
+	addSession := func(expiry time.Time) error {
+		_, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+			Label:                  "absolute expiry",
+			SessionType:            adminType,
+			ExpiryTimestampSeconds: uint64(expiry.Unix()),
+			MailboxServerAddr:      "localhost:1234",
+		})
+
+		return err
+	}
+
+	require.NoError(t, addSession(maxExpiry))
+
+	err := addSession(maxExpiry.Add(time.Second))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), "must not expire after")
+
+	// The absolute expiry must be a valid timestamp.
+	s.cfg.MaxAbsoluteExpiry = "next year"
+	require.Error(t, s.cfg.validate())
+}
+
+// TestListFailedSessions makes sure that only sessions whose last resume
+// attempt failed are listed, together with the reason and time of the attempt.
+func TestListFailedSessions(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	healthy := newTestSession(t, "healthy", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(healthy))
+	require.NoError(t, s.resumeSession(healthy, 0))
+	require.True(t, s.isActive(healthy.LocalPublicKey))
+
+	s.superMacBaker = func(context.Context, uint64,
+		*session.MacaroonRecipe) (string, error) {
+
+		return "", fmt.Errorf("lnd unavailable")
+	}
+
+	broken := newTestSession(t, "broken", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(broken))
+
+	before := time.Now().Unix()
+	require.NoError(t, s.resumeSession(broken, 0))
+	require.False(t, s.isActive(broken.LocalPublicKey))
+
+	resp, err := s.ListFailedSessions(
+		ctx, &litrpc.ListFailedSessionsRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Sessions, 1)
+
+	failed := resp.Sessions[0]
+	require.Equal(
+		t, broken.LocalPublicKey.SerializeCompressed(),
+		failed.LocalPublicKey,
+	)
+	require.Contains(t, failed.LastResumeError, "lnd unavailable")
+	require.GreaterOrEqual(t, failed.LastResumeAttempt, uint64(before))
+	require.False(t, failed.IsRunning)
+}
+
+// TestCorruptedSessionRecord makes sure that a corrupted session record doesn't
+// prevent the other sessions from being resumed on startup and that it is
+// reported by ListFailedSessions.
+func TestCorruptedSessionRecord(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	healthy := newTestSession(t, "healthy", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(healthy))
+
+	corruptKey := []byte("corrupt")
+	err := s.db.(*session.DB).Update(func(tx *bbolt.Tx) error {
+		sessionBucket := tx.Bucket([]byte("session"))
+		require.NotNil(t, sessionBucket)
+
+		return sessionBucket.Put(corruptKey, []byte{0xff, 0xff, 0x01})
+	})
+	require.NoError(t, err)
+
+	// The startup sequence flags the record but resumes all other
+	// sessions.
+	require.NoError(t, s.checkSessionRecords())
+	sessions, err := s.db.ListSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	s.resumeSessions(sessions, 0)
+	require.True(t, s.isActive(healthy.LocalPublicKey))
+
+	resp, err := s.ListFailedSessions(
+		ctx, &litrpc.ListFailedSessionsRequest{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, resp.Sessions)
+	require.Len(t, resp.InvalidRecords, 1)
+	require.Equal(t, corruptKey, resp.InvalidRecords[0].Key)
+	require.NotEmpty(t, resp.InvalidRecords[0].Reason)
+}
+
+// TestRemoteDisplayName makes sure that the display name supplied when adding a
+// session is stored and that a connecting client doesn't change it.
+func TestRemoteDisplayName(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mock := s.sessionServer.(*mockSessionServer)
+	ctx := context.Background()
+
+	resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:       "named",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr: "localhost:1234",
+		RemoteDisplayName: "Alice's phone",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Alice's phone", resp.Session.RemoteDisplayName)
+
+	listResp, err := s.ListSessions(ctx, &litrpc.ListSessionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, listResp.Sessions, 1)
+	require.Equal(
+		t, "Alice's phone", listResp.Sessions[0].RemoteDisplayName,
+	)
+
+	pubKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+	mock.connect(pubKey)
+	require.Eventually(t, func() bool {
+		stored, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+
+		return stored.State == session.StateInUse
+	}, 5*time.Second, 10*time.Millisecond)
+
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, "Alice's phone", stored.RemoteDisplayName)
+}
+
+// TestAddSessionStartFailure makes sure that a session that was stored but
+// couldn't be started is revoked again, so a failed AddSession doesn't leave a
+// usable session behind.
+func TestAddSessionStartFailure(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	mockServer.mu.Lock()
+	mockServer.startErr = fmt.Errorf("mailbox unreachable")
+	mockServer.mu.Unlock()
+
+	_, err := s.AddSession(
+		context.Background(), &litrpc.AddSessionRequest{
+			Label:       "unreachable",
+			SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+		},
+	)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "mailbox unreachable")
+
+	sessions, err := s.db.ListSessions()
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+
+	sess := sessions[0]
+	require.Equal(t, session.StateRevoked, sess.State)
+	require.Equal(t, revokeReasonStartFailed, sess.RevokeReason)
+	require.False(t, s.isActive(sess.LocalPublicKey))
+}
+
+// TestSessionServerNotReady makes sure that RPCs that create or start sessions
+// are rejected with Unavailable until the server is marked as ready, while
+// revoking sessions is still possible.
+func TestSessionServerNotReady(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.ready = 0
+	ctx := context.Background()
+
+	addReq := &litrpc.AddSessionRequest{
+		Label:       "early",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr: "localhost:1234",
+	}
+	_, err := s.AddSession(ctx, addReq)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.Contains(t, err.Error(), "session server not ready")
+
+	_, err = s.ResumeAllSessions(ctx, &litrpc.ResumeAllSessionsRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	sessions, err := s.db.ListSessions()
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+
+	sess := newTestSession(t, "stored", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(sess))
+	_, err = s.RevokeSession(ctx, &litrpc.RevokeSessionRequest{
+		LocalPublicKey: sess.LocalPublicKey.SerializeCompressed(),
+	})
+	require.NoError(t, err)
+
+	s.markReady()
+	_, err = s.AddSession(ctx, addReq)
+	require.NoError(t, err)
+}
+
+// TestNoMacaroonBaker makes sure that a server without a macaroon baker still
+// serves UI password sessions but cleanly rejects macaroon sessions instead of
+// panicking.
+func TestNoMacaroonBaker(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.superMacBaker = nil
+	ctx := context.Background()
+
+	uiSession := addTestUISession(t, s, "ui")
+	uiKey, err := btcec.ParsePubKey(uiSession.LocalPublicKey, btcec.S256())
+	require.NoError(t, err)
+	require.True(t, s.sessionServer.(*mockSessionServer).isActive(uiKey))
+
+	_, err = s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:       "admin",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr: "localhost:1234",
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Contains(t, err.Error(), errNoMacaroonBaker.Error())
+
+	// A stored macaroon session isn't resumed, the reason is recorded
+	// instead.
+	sess := newTestSession(t, "stored", session.TypeMacaroonAdmin)
+	require.NoError(t, s.db.StoreSession(sess))
+	require.NoError(t, s.resumeSession(sess, 0))
+	require.False(
+		t, s.sessionServer.(*mockSessionServer).isActive(
+			sess.LocalPublicKey,
+		),
+	)
+
+	stored, err := s.db.GetSession(sess.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, errNoMacaroonBaker.Error(), stored.LastResumeError)
+
+	_, err = s.sessionAuthData(sess)
+	require.ErrorIs(t, err, errNoMacaroonBaker)
+}
+
+// TestAddSessionCaveat makes sure that a caveat added to a running session is
+// stored in its recipe and included in the macaroon that is re-baked for it.
+func TestAddSessionCaveat(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	var lastRecipe *session.MacaroonRecipe
+	s.superMacBaker = func(_ context.Context, _ uint64,
+		recipe *session.MacaroonRecipe) (string, error) {
+
+		lastRecipe = recipe
+		return fmt.Sprintf("mac-%d", len(recipe.Caveats)), nil
+	}
+
+	resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:       "tightened",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr: "localhost:1234",
+	})
+	require.NoError(t, err)
+
+	pubKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+
+	require.Equal(
+		t, HeaderMacaroon+": mac-0", string(mockAuthData(s, pubKey)),
+	)
+
+	const caveat = "time-before 2030-01-01T00:00:00Z"
+	caveatResp, err := s.AddSessionCaveat(
+		ctx, &litrpc.AddSessionCaveatRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+			Caveat:         caveat,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, []string{caveat}, caveatResp.Session.MacaroonRecipe.Caveats,
+	)
+
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Len(t, stored.MacaroonRecipe.Caveats, 1)
+	require.Equal(t, caveat, string(stored.MacaroonRecipe.Caveats[0].Id))
+
+	// The running session hands out the re-baked macaroon.
+	require.Len(t, lastRecipe.Caveats, 1)
+	require.Equal(t, caveat, string(lastRecipe.Caveats[0].Id))
+	require.Equal(
+		t, HeaderMacaroon+": mac-1", string(mockAuthData(s, pubKey)),
+	)
+
+	// Adding the same caveat again doesn't duplicate it.
+	_, err = s.AddSessionCaveat(ctx, &litrpc.AddSessionCaveatRequest{
+		LocalPublicKey: pubKey.SerializeCompressed(),
+		Caveat:         caveat,
+	})
+	require.NoError(t, err)
+	stored, err = s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Len(t, stored.MacaroonRecipe.Caveats, 1)
+
+	// Malformed caveats and sessions without a macaroon are rejected.
+	_, err = s.AddSessionCaveat(ctx, &litrpc.AddSessionCaveatRequest{
+		LocalPublicKey: pubKey.SerializeCompressed(),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	uiSession := addTestUISession(t, s, "ui")
+	_, err = s.AddSessionCaveat(ctx, &litrpc.AddSessionCaveatRequest{
+		LocalPublicKey: uiSession.LocalPublicKey,
+		Caveat:         caveat,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestAddSessionDuplicatePermissions makes sure that a custom session whose
+// explicit or template permissions contain duplicates gets a recipe with each
+// permission once, in the order of their first occurrence.
+func TestAddSessionDuplicatePermissions(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.MaxCustomPerms = 2
+
+	templates, err := parsePermissionTemplates([]string{
+		"dupes=invoices:read,info:read,invoices:read,info:read",
+	})
+	require.NoError(t, err)
+	s.cfg.permissionTemplates = templates
+
+	var recipe *session.MacaroonRecipe
+	s.superMacBaker = func(_ context.Context, _ uint64,
+		r *session.MacaroonRecipe) (string, error) {
+
+		recipe = r
+		return "mac", nil
+	}
+
+	// The duplicates don't count towards the permission limit either.
+	resp, err := s.AddSession(
+		context.Background(), &litrpc.AddSessionRequest{
+			Label:       "dupes",
+			SessionType: litrpc.SessionType_TYPE_MACAROON_CUSTOM,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr:  "localhost:1234",
+			PermissionTemplate: "dupes",
+		},
+	)
+	require.NoError(t, err)
+
+	expected := []bakery.Op{
+		{Entity: "invoices", Action: "read"},
+		{Entity: "info", Action: "read"},
+	}
+	require.Equal(t, expected, recipe.Permissions)
+
+	pubKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, expected, stored.MacaroonRecipe.Permissions)
+
+	// Duplicates in the explicit permissions and between the explicit
+	// permissions and the template are removed the same way.
+	infoRead := &litrpc.MacaroonPermission{Entity: "info", Action: "read"}
+	_, err = s.AddSession(
+		context.Background(), &litrpc.AddSessionRequest{
+			Label:       "inline dupes",
+			SessionType: litrpc.SessionType_TYPE_MACAROON_CUSTOM,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+			MacaroonCustomPermissions: []*litrpc.MacaroonPermission{
+				infoRead, infoRead,
+			},
+			PermissionTemplate: "dupes",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []bakery.Op{
+		{Entity: "info", Action: "read"},
+		{Entity: "invoices", Action: "read"},
+	}, recipe.Permissions)
+}
+
+// TestChangeSessionType makes sure that a readonly session can be promoted to
+// an admin session while keeping its pairing and that the running session hands
+// out a macaroon with the admin permissions right away.
+func TestChangeSessionType(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	var lastRecipe *session.MacaroonRecipe
+	s.superMacBaker = func(_ context.Context, _ uint64,
+		recipe *session.MacaroonRecipe) (string, error) {
+
+		lastRecipe = recipe
+		return fmt.Sprintf("mac-%d", len(recipe.Permissions)), nil
+	}
+
+	resp, err := s.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:       "promoted",
+		SessionType: litrpc.SessionType_TYPE_MACAROON_READONLY,
+		ExpiryTimestampSeconds: uint64(
+			time.Now().Add(time.Hour).Unix(),
+		),
+		MailboxServerAddr: "localhost:1234",
+	})
+	require.NoError(t, err)
+
+	pubKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+	require.ElementsMatch(
+		t, s.cfg.permissions(true), lastRecipe.Permissions,
+	)
+
+	before, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+
+	changeResp, err := s.ChangeSessionType(
+		ctx, &litrpc.ChangeSessionTypeRequest{
+			LocalPublicKey: pubKey.SerializeCompressed(),
+			SessionType:    litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		changeResp.Session.SessionType,
+	)
+	require.True(t, changeResp.Session.IsRunning)
+
+	// The session keeps its pairing, only its type and macaroon change.
+	stored, err := s.db.GetSession(pubKey)
+	require.NoError(t, err)
+	require.Equal(t, session.TypeMacaroonAdmin, stored.Type)
+	require.Equal(t, before.PairingSecret, stored.PairingSecret)
+	require.True(t, before.LocalPublicKey.IsEqual(stored.LocalPublicKey))
+
+	adminPerms := s.cfg.permissions(false)
+	require.ElementsMatch(t, adminPerms, lastRecipe.Permissions)
+	require.Equal(
+		t, fmt.Sprintf("%s: mac-%d", HeaderMacaroon, len(adminPerms)),
+		string(mockAuthData(s, pubKey)),
+	)
+}
+
+// TestChangeSessionTypeUIPassword makes sure that sessions can't be changed
+// from or to the UI password type, nor to types that need a restriction.
+func TestChangeSessionTypeUIPassword(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	uiSession := addTestUISession(t, s, "password")
+	password, err := btcec.ParsePubKey(
+		uiSession.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+
+	readonly := newTestSession(t, "readonly", session.TypeMacaroonReadonly)
+	require.NoError(t, s.db.StoreSession(readonly))
+
+	changeType := func(pubKey *btcec.PublicKey,
+		typ litrpc.SessionType) error {
+
+		_, err := s.ChangeSessionType(
+			ctx, &litrpc.ChangeSessionTypeRequest{
+				LocalPublicKey: pubKey.SerializeCompressed(),
+				SessionType:    typ,
+			},
+		)
+		return err
+	}
+
+	err = changeType(password, litrpc.SessionType_TYPE_MACAROON_ADMIN)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = changeType(
+		readonly.LocalPublicKey, litrpc.SessionType_TYPE_UI_PASSWORD,
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	custom := litrpc.SessionType_TYPE_MACAROON_CUSTOM
+	err = changeType(readonly.LocalPublicKey, custom)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// None of the rejected changes were applied.
+	stored, err := s.db.GetSession(password)
+	require.NoError(t, err)
+	require.Equal(t, session.TypeUIPassword, stored.Type)
+
+	stored, err = s.db.GetSession(readonly.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, session.TypeMacaroonReadonly, stored.Type)
+}
+
+// TestAddSessionSubserverMethods makes sure that the macaroon of a custom
+// session scoped to a subserver method only grants the permissions of that
+// method and that only methods of enabled subservers are accepted.
+func TestAddSessionSubserverMethods(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	ctx := context.Background()
+
+	var recipe *session.MacaroonRecipe
+	s.superMacBaker = func(_ context.Context, _ uint64,
+		r *session.MacaroonRecipe) (string, error) {
+
+		recipe = r
+		return "mac", nil
+	}
+
+	addSession := func(typ litrpc.SessionType,
+		methods map[string]*litrpc.SubserverMethods) error {
+
+		req := &litrpc.AddSessionRequest{
+			Label:       "scoped",
+			SessionType: typ,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr:   "localhost:1234",
+			LitSubserverMethods: methods,
+		}
+
+		_, err := s.AddSession(ctx, req)
+		return err
+	}
+	loopMethods := func(
+		methods ...string) map[string]*litrpc.SubserverMethods {
+
+		return map[string]*litrpc.SubserverMethods{
+			"loop": {Methods: methods},
+		}
+	}
+
+	custom := litrpc.SessionType_TYPE_MACAROON_CUSTOM
+	require.NoError(t, addSession(custom, loopMethods("LoopOut")))
+
+	expected := loopd.RequiredPermissions["/looprpc.SwapClient/LoopOut"]
+	require.NotEmpty(t, expected)
+	require.ElementsMatch(t, expected, recipe.Permissions)
+
+	// The full URI of a method resolves to the same permissions.
+	recipe = nil
+	require.NoError(t, addSession(
+		custom, loopMethods("/looprpc.SwapClient/LoopOut"),
+	))
+	require.ElementsMatch(t, expected, recipe.Permissions)
+
+	// Unknown methods, sessions of other types and disabled subservers
+	// are rejected.
+	err := addSession(custom, loopMethods("NoSuchMethod"))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	err = addSession(
+		litrpc.SessionType_TYPE_MACAROON_ADMIN, loopMethods("LoopOut"),
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	delete(s.subserverPermissions, "pool")
+	err = addSession(custom, map[string]*litrpc.SubserverMethods{
+		"pool": {Methods: []string{"GetInfo"}},
+	})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -0,0 +1,151 @@
+package terminal
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btclog"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errOutsideAccessWindow is returned if the authentication data of a session is
+// needed while the session is outside of its access windows.
+var errOutsideAccessWindow = errors.New("session is outside of its access " +
+	"windows")
+
+// unmarshalAccessSchedule converts an access schedule of an RPC request into
+// its session counterpart. Nil is returned if no schedule is given.
+func unmarshalAccessSchedule(
+	rpcSchedule *litrpc.AccessSchedule) (*session.AccessSchedule, error) {
+
+	if rpcSchedule == nil {
+		return nil, nil
+	}
+
+	windows := make([]session.AccessWindow, 0, len(rpcSchedule.Windows))
+	for _, window := range rpcSchedule.Windows {
+		windows = append(windows, session.AccessWindow{
+			Weekday: time.Weekday(window.Weekday),
+			Start: time.Duration(
+				window.StartSeconds,
+			) * time.Second,
+			End: time.Duration(window.EndSeconds) * time.Second,
+		})
+	}
+
+	schedule, err := session.NewAccessSchedule(
+		rpcSchedule.Timezone, windows,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"access schedule: %v", err)
+	}
+
+	return schedule, nil
+}
+
+// marshalRPCAccessSchedule converts an access schedule into its RPC
+// counterpart. Nil is returned if there is no schedule.
+func marshalRPCAccessSchedule(
+	schedule *session.AccessSchedule) *litrpc.AccessSchedule {
+
+	if schedule == nil {
+		return nil
+	}
+
+	rpcSchedule := &litrpc.AccessSchedule{
+		Timezone: schedule.Timezone,
+		Windows: make(
+			[]*litrpc.AccessWindow, 0, len(schedule.Windows),
+		),
+	}
+	for _, window := range schedule.Windows {
+		rpcSchedule.Windows = append(
+			rpcSchedule.Windows, &litrpc.AccessWindow{
+				Weekday: uint32(window.Weekday),
+				StartSeconds: uint32(
+					window.Start / time.Second,
+				),
+				EndSeconds: uint32(window.End / time.Second),
+			},
+		)
+	}
+
+	return rpcSchedule
+}
+
+// suspendSession marks the given session that isn't running as suspended and
+// schedules it to be resumed once its next access window opens. A session
+// whose schedule never opens again stays suspended.
+func (s *sessionRpcServer) suspendSession(sess *session.Session,
+	sessLog btclog.Logger) {
+
+	if sess.State != session.StateSuspended {
+		err := s.db.SuspendSession(sess.LocalPublicKey)
+		if err != nil {
+			sessLog.Errorf("Unable to suspend session: %v", err)
+			return
+		}
+	}
+
+	next := sess.AccessSchedule.NextChange(time.Now())
+	if next.IsZero() {
+		sessLog.Infof("Suspended session, no access window opens " +
+			"again")
+		return
+	}
+
+	sessLog.Infof("Suspended session until its next access window "+
+		"opens at %v", next)
+	s.scheduleActivation(sess, next, s.cfg.StartTimeout, sessLog)
+}
+
+// suspendRunningSession stops the mailbox connection of the given running
+// session once its access window closed and suspends it until the next window
+// opens.
+func (s *sessionRpcServer) suspendRunningSession(sess *session.Session,
+	closedSub chan struct{}, sessLog btclog.Logger) {
+
+	// Just like for a paused session, the session is marked inactive
+	// before it is stopped, so single-use sessions aren't revoked.
+	pubKey := sess.LocalPublicKey
+	s.markInactive(pubKey, closedSub)
+	if err := s.sessionServer.StopSession(pubKey); err != nil {
+		sessLog.Debugf("Error stopping session: %v", err)
+	}
+	s.recordAuditEvent(pubKey, session.AuditEventStopped)
+
+	// The session might have changed while it was running, so we suspend
+	// its latest version.
+	current, err := s.db.GetSession(pubKey)
+	if err != nil {
+		sessLog.Errorf("Unable to fetch session to suspend: %v", err)
+		return
+	}
+
+	s.suspendSession(current, sessLog)
+}
+
+// unsuspendSession puts the given suspended session back into the state it can
+// be resumed in. A session a client ever connected to is in use again, all
+// others are ready for pairing.
+func (s *sessionRpcServer) unsuspendSession(sess *session.Session) error {
+	state := session.StateCreated
+	if !sess.FirstConnectedAt.IsZero() {
+		state = session.StateInUse
+	}
+
+	err := s.db.SwapSessionState(
+		sess.LocalPublicKey, session.StateSuspended, state,
+	)
+	if err != nil {
+		return fmt.Errorf("error restoring suspended session: %v", err)
+	}
+	sess.State = state
+
+	return nil
+}
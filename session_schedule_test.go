@@ -0,0 +1,171 @@
+package terminal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// addScheduledSession adds an admin session with the given access windows in
+// UTC and returns its local public key.
+func addScheduledSession(t *testing.T, s *sessionRpcServer, label string,
+	windows ...*litrpc.AccessWindow) *btcec.PublicKey {
+
+	resp, err := s.AddSession(
+		context.Background(), &litrpc.AddSessionRequest{
+			Label:       label,
+			SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(24 * time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+			AccessSchedule: &litrpc.AccessSchedule{
+				Timezone: "UTC",
+				Windows:  windows,
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, resp.Session.AccessSchedule.Windows, len(windows))
+
+	pubKey, err := btcec.ParsePubKey(
+		resp.Session.LocalPublicKey, btcec.S256(),
+	)
+	require.NoError(t, err)
+
+	return pubKey
+}
+
+// TestAccessSchedule makes sure that a session with an access schedule is only
+// served inside its access windows and suspended outside of them.
+func TestAccessSchedule(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	mockServer := s.sessionServer.(*mockSessionServer)
+
+	now := time.Now().UTC()
+	clock := uint32(now.Hour()*3600 + now.Minute()*60 + now.Second())
+	if clock < 3600 || clock > 24*3600-10 {
+		t.Skip("too close to midnight for a window around now")
+	}
+	today := uint32(now.Weekday())
+	tomorrow := (today + 1) % 7
+
+	requireState := func(pubKey *btcec.PublicKey, state session.State) {
+		sess, err := s.db.GetSession(pubKey)
+		require.NoError(t, err)
+		require.Equal(t, state, sess.State)
+	}
+
+	// A session inside its window is served right away.
+	inside := addScheduledSession(t, s, "inside", &litrpc.AccessWindow{
+		Weekday:      today,
+		StartSeconds: clock - 3600,
+		EndSeconds:   24 * 3600,
+	})
+	require.True(t, mockServer.isActive(inside))
+	require.NotNil(t, mockAuthData(s, inside))
+	requireState(inside, session.StateCreated)
+
+	// A session outside of its windows is suspended until tomorrow.
+	outside := addScheduledSession(t, s, "outside", &litrpc.AccessWindow{
+		Weekday:      tomorrow,
+		StartSeconds: 0,
+		EndSeconds:   3600,
+	})
+	require.False(t, mockServer.isActive(outside))
+	requireState(outside, session.StateSuspended)
+
+	s.activeSessionsMtx.Lock()
+	_, scheduled := s.pendingActivations[string(
+		outside.SerializeCompressed(),
+	)]
+	s.activeSessionsMtx.Unlock()
+	require.True(t, scheduled)
+
+	list, err := s.ListSessions(
+		context.Background(), &litrpc.ListSessionsRequest{
+			LabelQuery: "outside",
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, litrpc.SessionState_STATE_SUSPENDED,
+		list.Sessions[0].SessionState,
+	)
+
+	// Once the window of a running session closes, it is torn down and
+	// suspended.
+	closing := addScheduledSession(t, s, "closing", &litrpc.AccessWindow{
+		Weekday:      today,
+		StartSeconds: clock - 3600,
+		EndSeconds:   clock + 2,
+	})
+	require.True(t, mockServer.isActive(closing))
+
+	// The session is only suspended after it was stopped, so we need to
+	// wait for its state as well.
+	require.Eventually(t, func() bool {
+		sess, err := s.db.GetSession(closing)
+		require.NoError(t, err)
+
+		return !mockServer.isActive(closing) && !s.isActive(closing) &&
+			sess.State == session.StateSuspended
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// No macaroon is handed out while the session is suspended.
+	sess, err := s.db.GetSession(closing)
+	require.NoError(t, err)
+	_, err = s.sessionAuthData(sess)
+	require.ErrorIs(t, err, errOutsideAccessWindow)
+
+	// The session is resumed once its window opens again, which is
+	// simulated by giving it a window that covers the rest of the day.
+	sess.AccessSchedule, err = session.NewAccessSchedule(
+		"UTC", []session.AccessWindow{{
+			Weekday: now.Weekday(),
+			End:     24 * time.Hour,
+		}},
+	)
+	require.NoError(t, err)
+	require.NoError(t, s.db.StoreSession(sess))
+
+	sess, err = s.db.GetSession(closing)
+	require.NoError(t, err)
+	s.cancelActivations()
+	require.NoError(t, s.resumeSession(sess, 0))
+	require.True(t, mockServer.isActive(closing))
+	requireState(closing, session.StateCreated)
+}
+
+// TestAccessScheduleInvalid makes sure that a session with an invalid access
+// schedule is rejected.
+func TestAccessScheduleInvalid(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+
+	_, err := s.AddSession(
+		context.Background(), &litrpc.AddSessionRequest{
+			Label:       "invalid",
+			SessionType: litrpc.SessionType_TYPE_MACAROON_ADMIN,
+			ExpiryTimestampSeconds: uint64(
+				time.Now().Add(time.Hour).Unix(),
+			),
+			MailboxServerAddr: "localhost:1234",
+			AccessSchedule: &litrpc.AccessSchedule{
+				Timezone: "UTC",
+				Windows: []*litrpc.AccessWindow{{
+					Weekday:      1,
+					StartSeconds: 7200,
+					EndSeconds:   3600,
+				}},
+			},
+		},
+	)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
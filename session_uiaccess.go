@@ -0,0 +1,48 @@
+package terminal
+
+import (
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+)
+
+// uiPasswordAccess describes the effective access of UI password sessions.
+// Instead of a macaroon, a client of such a session receives the UI password
+// as basic authentication. The RPC proxy exchanges it for the macaroon of the
+// daemon each call is for, so the session has the full access of those
+// macaroons.
+type uiPasswordAccess struct {
+	// authHeader is the metadata header the credentials are passed in.
+	authHeader string
+
+	// authScheme is the authentication scheme of the credentials.
+	authScheme string
+
+	// subservers are the daemons whose RPCs the session can call. They
+	// match the daemons the RPC proxy accepts basic authentication for.
+	subservers []string
+}
+
+// uiPasswordSessionAccess is the effective access of every UI password
+// session.
+var uiPasswordSessionAccess = uiPasswordAccess{
+	authHeader: "Authorization",
+	authScheme: "Basic",
+	subservers: []string{"lnd", "faraday", "loop", "pool", "lit"},
+}
+
+// authData returns the authentication data a client of a UI password session
+// uses for the given basic authentication.
+func (a *uiPasswordAccess) authData(basicAuth string) []byte {
+	return []byte(a.authHeader + ": " + a.authScheme + " " + basicAuth)
+}
+
+// marshalRPC returns the RPC representation of the access.
+func (a *uiPasswordAccess) marshalRPC() *litrpc.UIPasswordAccess {
+	subservers := make([]string, len(a.subservers))
+	copy(subservers, a.subservers)
+
+	return &litrpc.UIPasswordAccess{
+		AuthHeader: a.authHeader,
+		AuthScheme: a.authScheme,
+		Subservers: subservers,
+	}
+}
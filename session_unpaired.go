@@ -0,0 +1,83 @@
+package terminal
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/session"
+)
+
+const (
+	// unpairedScanInterval is the interval at which sessions are checked
+	// for having waited too long to be paired.
+	unpairedScanInterval = time.Minute
+)
+
+// startUnpairedScan starts periodically revoking sessions that weren't paired
+// within the configured unpaired timeout. Nothing is started if the timeout is
+// disabled.
+func (s *sessionRpcServer) startUnpairedScan() {
+	if s.cfg.UnpairedTimeout <= 0 {
+		return
+	}
+
+	s.wg.Add(1)
+	atomic.AddInt64(&s.numWaitGroupGoroutines, 1)
+	go func() {
+		defer func() {
+			atomic.AddInt64(&s.numWaitGroupGoroutines, -1)
+			s.wg.Done()
+		}()
+
+		ticker := time.NewTicker(unpairedScanInterval)
+		defer ticker.Stop()
+
+		for {
+			s.scanUnpairedSessions(time.Now())
+
+			select {
+			case <-ticker.C:
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+}
+
+// scanUnpairedSessions revokes each session that is still waiting to be paired
+// and was created longer than the unpaired timeout before the given time.
+// Sessions a client ever connected to are left alone, as are sessions without a
+// recorded creation time.
+func (s *sessionRpcServer) scanUnpairedSessions(now time.Time) {
+	sessions, err := s.db.ListSessions()
+	if err != nil {
+		log.Errorf("Unable to list sessions for the unpaired timeout: %v",
+			err)
+		return
+	}
+
+	for _, sess := range sessions {
+		if sess.State != session.StateCreated ||
+			!sess.FirstConnectedAt.IsZero() ||
+			sess.CreatedAt.IsZero() {
+
+			continue
+		}
+
+		if now.Sub(sess.CreatedAt) <= s.cfg.UnpairedTimeout {
+			continue
+		}
+
+		sessionLogger(sess).Infof("Revoking session that wasn't "+
+			"paired within %v", s.cfg.UnpairedTimeout)
+
+		err := s.revokeSession(
+			context.Background(), sess.LocalPublicKey,
+			revokeReasonUnpaired,
+		)
+		if err != nil {
+			log.Errorf("Unable to revoke unpaired session: %v", err)
+		}
+	}
+}
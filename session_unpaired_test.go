@@ -0,0 +1,66 @@
+package terminal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+)
+
+// TestScanUnpairedSessions makes sure that the unpaired scan revokes sessions
+// that weren't paired within the timeout but leaves paired and recently created
+// sessions alone.
+func TestScanUnpairedSessions(t *testing.T) {
+	s := newTestSessionRpcServer(t)
+	s.cfg.UnpairedTimeout = time.Hour
+	mock := s.sessionServer.(*mockSessionServer)
+
+	now := time.Now()
+	addSession := func(label string,
+		createdAt time.Time) *session.Session {
+
+		sess := newTestSession(t, label, session.TypeMacaroonAdmin)
+		sess.CreatedAt = createdAt
+		require.NoError(t, s.storeAndStartSession(sess, 0))
+
+		return sess
+	}
+
+	unpaired := addSession("unpaired", now.Add(-2*time.Hour))
+	paired := addSession("paired", now.Add(-2*time.Hour))
+	recent := addSession("recent", now.Add(-30*time.Minute))
+
+	// A client completes the handshake with the paired session, which
+	// records its first connection.
+	mock.connect(paired.LocalPublicKey)
+	require.Eventually(t, func() bool {
+		sess, err := s.db.GetSession(paired.LocalPublicKey)
+		require.NoError(t, err)
+
+		return !sess.FirstConnectedAt.IsZero()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	s.scanUnpairedSessions(now)
+
+	sess, err := s.db.GetSession(unpaired.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateRevoked, sess.State)
+	require.Equal(t, revokeReasonUnpaired, sess.RevokeReason)
+
+	sess, err = s.db.GetSession(recent.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateCreated, sess.State)
+
+	// Once the recent session waited long enough, it is revoked as well.
+	s.scanUnpairedSessions(now.Add(time.Hour))
+
+	sess, err = s.db.GetSession(recent.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateRevoked, sess.State)
+
+	sess, err = s.db.GetSession(paired.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, session.StateInUse, sess.State)
+	require.Empty(t, sess.RevokeReason)
+}
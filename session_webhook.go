@@ -0,0 +1,185 @@
+package terminal
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/subscribe"
+)
+
+const (
+	// webhookRetryDelay is the time we wait before retrying a failed
+	// webhook request.
+	webhookRetryDelay = time.Second
+)
+
+// sessionWebhookPayload is the JSON payload that is POSTed to the webhook URL
+// for each session state change and expiry warning.
+type sessionWebhookPayload struct {
+	LocalPublicKey string `json:"local_public_key"`
+	Label          string `json:"label"`
+	OldState       string `json:"old_state"`
+	NewState       string `json:"new_state"`
+	ExpiringSoon   bool   `json:"expiring_soon,omitempty"`
+	Timestamp      int64  `json:"timestamp"`
+}
+
+// sessionWebhook notifies an external HTTP endpoint about sessions being
+// created, changing their state or expiring soon.
+type sessionWebhook struct {
+	url        string
+	retries    uint32
+	retryDelay time.Duration
+	client     *http.Client
+
+	db *session.DB
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newSessionWebhook creates a new webhook dispatcher for the state changes of
+// the sessions in the given DB.
+func newSessionWebhook(cfg *SessionConfig, db *session.DB) *sessionWebhook {
+	return &sessionWebhook{
+		url:        cfg.WebhookURL,
+		retries:    cfg.WebhookRetries,
+		retryDelay: webhookRetryDelay,
+		client: &http.Client{
+			Timeout: cfg.WebhookTimeout,
+		},
+		db:   db,
+		quit: make(chan struct{}),
+	}
+}
+
+// start subscribes to the session state changes and starts dispatching them.
+func (w *sessionWebhook) start() error {
+	sub, err := w.db.SubscribeStateChanges()
+	if err != nil {
+		return fmt.Errorf("error subscribing to session state "+
+			"changes: %v", err)
+	}
+
+	w.wg.Add(1)
+	go w.run(sub)
+
+	return nil
+}
+
+// stop stops dispatching state changes and waits for a pending request to
+// finish.
+func (w *sessionWebhook) stop() {
+	close(w.quit)
+	w.wg.Wait()
+}
+
+// run dispatches all state changes received from the given subscription until
+// the dispatcher is stopped. The changes are dispatched one by one, so the
+// endpoint receives them in order.
+func (w *sessionWebhook) run(sub *subscribe.Client) {
+	defer w.wg.Done()
+	defer sub.Cancel()
+
+	for {
+		select {
+		case update := <-sub.Updates():
+			change, ok := update.(*session.StateChange)
+			if !ok {
+				continue
+			}
+
+			w.dispatch(change)
+
+		case <-sub.Quit():
+			return
+
+		case <-w.quit:
+			return
+		}
+	}
+}
+
+// dispatch POSTs the given state change to the webhook URL, retrying failed
+// requests up to the configured number of times. Failures are only logged.
+func (w *sessionWebhook) dispatch(change *session.StateChange) {
+	payload, err := marshalWebhookPayload(change)
+	if err != nil {
+		log.Errorf("Unable to create session webhook payload: %v", err)
+		return
+	}
+
+	for attempt := uint32(0); attempt <= w.retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(w.retryDelay):
+			case <-w.quit:
+				return
+			}
+		}
+
+		err = w.post(payload)
+		if err == nil {
+			return
+		}
+
+		log.Warnf("Session webhook request failed (attempt %d of %d): "+
+			"%v", attempt+1, w.retries+1, err)
+	}
+
+	log.Errorf("Dropping session webhook notification after %d attempts",
+		w.retries+1)
+}
+
+// post sends a single webhook request with the given payload.
+func (w *sessionWebhook) post(payload []byte) error {
+	resp, err := w.client.Post(
+		w.url, "application/json", bytes.NewReader(payload),
+	)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// marshalWebhookPayload creates the JSON payload for the given state change.
+// The states are encoded with their RPC names, the old state is left empty for
+// newly created sessions.
+func marshalWebhookPayload(change *session.StateChange) ([]byte, error) {
+	newState, err := marshalRPCState(change.NewState)
+	if err != nil {
+		return nil, err
+	}
+
+	var oldState string
+	if !change.Created {
+		rpcState, err := marshalRPCState(change.PrevState)
+		if err != nil {
+			return nil, err
+		}
+		oldState = rpcState.String()
+	}
+
+	return json.Marshal(&sessionWebhookPayload{
+		LocalPublicKey: hex.EncodeToString(
+			change.Session.LocalPublicKey.SerializeCompressed(),
+		),
+		Label:        change.Session.Label,
+		OldState:     oldState,
+		NewState:     newState.String(),
+		ExpiringSoon: change.ExpiringSoon,
+		Timestamp:    change.Timestamp.Unix(),
+	})
+}
@@ -0,0 +1,83 @@
+package terminal
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSessionWebhook makes sure that session state changes are POSTed to the
+// webhook endpoint and that failed requests are retried.
+func TestSessionWebhook(t *testing.T) {
+	var requests int32
+	payloads := make(chan *sessionWebhookPayload, 10)
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			// Let the very first request fail to trigger a retry.
+			if atomic.AddInt32(&requests, 1) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			var payload sessionWebhookPayload
+			err := json.NewDecoder(r.Body).Decode(&payload)
+			require.NoError(t, err)
+			require.Equal(
+				t, "application/json",
+				r.Header.Get("Content-Type"),
+			)
+
+			payloads <- &payload
+		},
+	))
+	defer server.Close()
+
+	db, err := session.NewDB(t.TempDir(), session.DBFilename)
+	require.NoError(t, err)
+	defer db.Close()
+
+	webhook := newSessionWebhook(&SessionConfig{
+		WebhookURL:     server.URL,
+		WebhookTimeout: time.Second,
+		WebhookRetries: 1,
+	}, db)
+	webhook.retryDelay = time.Millisecond
+	require.NoError(t, webhook.start())
+	defer webhook.stop()
+
+	sess := newTestSession(t, "webhook", session.TypeMacaroonAdmin)
+	require.NoError(t, db.StoreSession(sess))
+	require.NoError(t, db.RevokeSession(sess.LocalPublicKey, ""))
+
+	pubKey := hex.EncodeToString(sess.LocalPublicKey.SerializeCompressed())
+	receive := func() *sessionWebhookPayload {
+		select {
+		case payload := <-payloads:
+			return payload
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no webhook payload received")
+			return nil
+		}
+	}
+
+	created := receive()
+	require.Equal(t, pubKey, created.LocalPublicKey)
+	require.Equal(t, "webhook", created.Label)
+	require.Empty(t, created.OldState)
+	require.Equal(t, "STATE_CREATED", created.NewState)
+	require.NotZero(t, created.Timestamp)
+
+	revoked := receive()
+	require.Equal(t, pubKey, revoked.LocalPublicKey)
+	require.Equal(t, "STATE_CREATED", revoked.OldState)
+	require.Equal(t, "STATE_REVOKED", revoked.NewState)
+
+	require.EqualValues(t, 3, atomic.LoadInt32(&requests))
+}
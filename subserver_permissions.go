@@ -12,9 +12,55 @@ var (
 	// litPermissions is a map of all LiT RPC methods and their required
 	// macaroon permissions to access the session service.
 	litPermissions = map[string][]bakery.Op{
-		"/litrpc.Sessions/AddSession":    {{}},
-		"/litrpc.Sessions/ListSessions":  {{}},
-		"/litrpc.Sessions/RevokeSession": {{}},
+		"/litrpc.Sessions/AddSession":                   {{}},
+		"/litrpc.Sessions/AddSessions":                  {{}},
+		"/litrpc.Sessions/ListSessions":                 {{}},
+		"/litrpc.Sessions/RevokeSession":                {{}},
+		"/litrpc.Sessions/UpdateSessionDescription":     {{}},
+		"/litrpc.Sessions/CloneSession":                 {{}},
+		"/litrpc.Sessions/PauseAllSessions":             {{}},
+		"/litrpc.Sessions/ResumeAllSessions":            {{}},
+		"/litrpc.Sessions/ListSessionTypes":             {{}},
+		"/litrpc.Sessions/RevealPairingSecret":          {{}},
+		"/litrpc.Sessions/ReplaceSession":               {{}},
+		"/litrpc.Sessions/CompactDB":                    {{}},
+		"/litrpc.Sessions/GetSessionMnemonic":           {{}},
+		"/litrpc.Sessions/ListSessionEvents":            {{}},
+		"/litrpc.Sessions/ValidatePermissions":          {{}},
+		"/litrpc.Sessions/RefreshSessionMacaroon":       {{}},
+		"/litrpc.Sessions/GetSessionConnectURI":         {{}},
+		"/litrpc.Sessions/GetServerStatus":              {{}},
+		"/litrpc.Sessions/RevokeExpiredSessions":        {{}},
+		"/litrpc.Sessions/ImportMacaroonAsSession":      {{}},
+		"/litrpc.Sessions/ListPermissionTemplates":      {{}},
+		"/litrpc.Sessions/CheckMailboxServer":           {{}},
+		"/litrpc.Sessions/MigrateSessionMailbox":        {{}},
+		"/litrpc.Sessions/RevokeSessionGroup":           {{}},
+		"/litrpc.Sessions/SubscribeSessionStateChanges": {{}},
+		"/litrpc.Sessions/QuarantineSession":            {{}},
+		"/litrpc.Sessions/UnquarantineSession":          {{}},
+		"/litrpc.Sessions/GetSession":                   {{}},
+		"/litrpc.Sessions/RenewSessions":                {{}},
+		"/litrpc.Sessions/GetMailboxServerStats":        {{}},
+		"/litrpc.Sessions/RestoreSession":               {{}},
+		"/litrpc.Sessions/GetSessionPermissions":        {{}},
+		"/litrpc.Sessions/ListFailedSessions":           {{}},
+		"/litrpc.Sessions/ExportSessionEvents":          {{}},
+		"/litrpc.Sessions/ApproveSession":               {{}},
+		"/litrpc.Sessions/DenySession":                  {{}},
+		"/litrpc.Sessions/GetSessionsCalendar":          {{}},
+		"/litrpc.Sessions/AddSessionCaveat":             {{}},
+		"/litrpc.Sessions/GetSessionPairingQR":          {{}},
+		"/litrpc.Sessions/ChangeSessionType":            {{}},
+		"/litrpc.Sessions/PrepareRevokeSession":         {{}},
+		"/litrpc.Sessions/ResetSession":                 {{}},
+		"/litrpc.Sessions/GetExpiryHistogram":           {{}},
+		"/litrpc.Sessions/RestartSession":               {{}},
+		"/litrpc.Sessions/TestSessionAccess":            {{}},
+		"/litrpc.Sessions/UpdateSessionsMetadata":       {{}},
+		"/litrpc.Sessions/PreviewSessionMacaroon":       {{}},
+		"/litrpc.Sessions/ListSessionsSummary":          {{}},
+		"/litrpc.Sessions/RotateUIPassword":             {{}},
 	}
 
 	// whiteListedMethods is a map of all lnd RPC methods that don't require
@@ -53,6 +99,17 @@ func getSubserverPermissions() map[string][]bakery.Op {
 	return result
 }
 
+// litSubserverPermissions returns the macaroon permissions of the RPC methods
+// of each subserver LiT integrates or proxies, keyed by the name of the
+// subserver and the URI of each method.
+func litSubserverPermissions() map[string]map[string][]bakery.Op {
+	return map[string]map[string][]bakery.Op{
+		"faraday": frdrpc.RequiredPermissions,
+		"loop":    loopd.RequiredPermissions,
+		"pool":    pool.RequiredPermissions,
+	}
+}
+
 // getAllMethodPermissions returns a merged map of lnd's and all subservers'
 // method macaroon permissions.
 func getAllMethodPermissions() map[string][]bakery.Op {
@@ -32,6 +32,7 @@ import (
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/chainreg"
+	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/autopilotrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
@@ -159,6 +160,18 @@ type LightningTerminal struct {
 	sessionDB        *session.DB
 	sessionServer    *session.Server
 	sessionRpcServer *sessionRpcServer
+	sessionWebhook   *sessionWebhook
+
+	// sessionPolicy is consulted before every new session is created.
+	sessionPolicy SessionPolicy
+
+	// recipeInterceptor is called with the recipe of every session
+	// macaroon before it is baked. It may be nil.
+	recipeInterceptor RecipeInterceptor
+
+	// sessionEventHandler is notified about the connection events of
+	// every running session.
+	sessionEventHandler SessionEventHandler
 
 	restHandler http.Handler
 	restCancel  func()
@@ -167,7 +180,9 @@ type LightningTerminal struct {
 // New creates a new instance of the lightning-terminal daemon.
 func New() *LightningTerminal {
 	return &LightningTerminal{
-		lndErrChan: make(chan error, 1),
+		lndErrChan:          make(chan error, 1),
+		sessionPolicy:       permissiveSessionPolicy{},
+		sessionEventHandler: noopSessionEventHandler{},
 	}
 }
 
@@ -203,11 +218,23 @@ func (g *LightningTerminal) Run() error {
 
 	// Create an instance of the local Terminal Connect session store DB.
 	networkDir := path.Join(g.cfg.LitDir, g.cfg.Network)
-	g.sessionDB, err = session.NewDB(networkDir, session.DBFilename)
+	g.sessionDB, err = session.NewEncryptedDB(
+		networkDir, session.DBFilename, g.cfg.Session.encryptionKey,
+	)
 	if err != nil {
 		return fmt.Errorf("error creating session DB: %v", err)
 	}
 
+	// If configured, notify an external endpoint about all session state
+	// changes.
+	if g.cfg.Session.WebhookURL != "" {
+		g.sessionWebhook = newSessionWebhook(g.cfg.Session, g.sessionDB)
+		if err := g.sessionWebhook.start(); err != nil {
+			return fmt.Errorf("error starting session webhook: %v",
+				err)
+		}
+	}
+
 	// Create the gRPC server that handles adding/removing sessions and the
 	// actual mailbox server that spins up the Terminal Connect server
 	// interface.
@@ -235,11 +262,29 @@ func (g *LightningTerminal) Run() error {
 			return grpcServer
 		},
 	)
+	session.SetRedactionMode(g.cfg.Session.logRedaction)
 	g.sessionRpcServer = &sessionRpcServer{
-		basicAuth:     g.rpcProxy.basicAuth,
-		db:            g.sessionDB,
-		sessionServer: g.sessionServer,
-		quit:          make(chan struct{}),
+		cfg:                g.cfg.Session,
+		basicAuth:          g.rpcProxy.getBasicAuth(),
+		setProxyBasicAuth:  g.rpcProxy.setBasicAuth,
+		rpcEndpoint:        toLocalAddress(g.cfg.HTTPSListen),
+		db:                 g.sessionDB,
+		sessionServer:      g.sessionServer,
+		activeSessions:     make(map[string]chan struct{}),
+		pendingActivations: make(map[string]chan struct{}),
+		pendingApprovals:   make(map[string]chan bool),
+		expiryWarnings:     make(map[string]time.Time),
+		quit:               make(chan struct{}),
+		policy:             g.sessionPolicy,
+		addLimiter: newRateLimiter(
+			g.cfg.Session.AddRateLimit, g.cfg.Session.AddRateBurst,
+			clock.NewDefaultClock(),
+		),
+		subserverPermissions: litSubserverPermissions(),
+		recipeInterceptor:    g.recipeInterceptor,
+		revokeConfirmations:  make(map[string]revokeConfirmation),
+		pendingLabels:        make(map[string]struct{}),
+		eventHandler:         g.sessionEventHandler,
 		superMacBaker: func(ctx context.Context, rootKeyID uint64,
 			recipe *session.MacaroonRecipe) (string, error) {
 
@@ -390,16 +435,19 @@ func (g *LightningTerminal) Run() error {
 	// Now start up all previously created sessions. Since the sessions
 	// require a lnd connection in order to bake macaroons, we can only
 	// start up the sessions once the connection to lnd has been
-	// established.
+	// established. The same goes for new sessions, so the session RPCs
+	// that create or start them are only accepted from now on.
+	g.sessionRpcServer.markReady()
+	if err := g.sessionRpcServer.checkSessionRecords(); err != nil {
+		return err
+	}
 	sessions, err := g.sessionDB.ListSessions()
 	if err != nil {
 		return fmt.Errorf("error listing sessions: %v", err)
 	}
-	for _, sess := range sessions {
-		if err := g.sessionRpcServer.resumeSession(sess); err != nil {
-			return fmt.Errorf("error resuming sesion: %v", err)
-		}
-	}
+	g.sessionRpcServer.resumeSessions(sessions, g.cfg.Session.StartTimeout)
+	g.sessionRpcServer.startExpiryScan()
+	g.sessionRpcServer.startUnpairedScan()
 
 	// Now block until we receive an error or the main shutdown signal.
 	select {
@@ -848,6 +896,9 @@ func (g *LightningTerminal) shutdown() error {
 	}
 
 	g.sessionRpcServer.stop()
+	if g.sessionWebhook != nil {
+		g.sessionWebhook.stop()
+	}
 	if err := g.sessionDB.Close(); err != nil {
 		log.Errorf("Error closing session DB: %v", err)
 		returnErr = err